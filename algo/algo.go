@@ -0,0 +1,112 @@
+// Package algo provides container-agnostic operations on sequences, meant to be combined with the
+// All and Values methods of the containers and with list.Collect
+package algo
+
+import "iter"
+
+// Zip returns a sequence pairing the values of a and b in order, ending with the shorter one
+func Zip[A any, B any](a iter.Seq[A], b iter.Seq[B]) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		next, stop := iter.Pull(b)
+		defer stop()
+		for va := range a {
+			vb, ok := next()
+			if !ok || !yield(va, vb) {
+				return
+			}
+		}
+	}
+}
+
+// GroupBy collects the values of seq into groups by the key of each value
+// Every group keeps its values in sequence order
+func GroupBy[T any, K comparable](seq iter.Seq[T], key func(T) K) map[K][]T {
+	groups := make(map[K][]T)
+	for v := range seq {
+		k := key(v)
+		groups[k] = append(groups[k], v)
+	}
+	return groups
+}
+
+// CountBy counts the values of seq by the key of each value
+func CountBy[T any, K comparable](seq iter.Seq[T], key func(T) K) map[K]int {
+	counts := make(map[K]int)
+	for v := range seq {
+		counts[key(v)]++
+	}
+	return counts
+}
+
+// SlidingWindow returns a sequence of every run of size consecutive values of seq, advancing by one
+// Each window is a fresh slice the loop body may keep
+// A sequence shorter than size, or a size below 1, yields no windows
+func SlidingWindow[T any](seq iter.Seq[T], size int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if size < 1 {
+			return
+		}
+		window := make([]T, 0, size)
+		for v := range seq {
+			if len(window) == size {
+				// Start a new slice so the window already yielded stays intact
+				next := make([]T, size-1, size)
+				copy(next, window[1:])
+				window = next
+			}
+			window = append(window, v)
+			if len(window) == size && !yield(window) {
+				return
+			}
+		}
+	}
+}
+
+// Flatten returns a sequence of the values of every sequence of seqs in turn
+func Flatten[T any](seqs iter.Seq[iter.Seq[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for seq := range seqs {
+			for v := range seq {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Take returns a sequence of the first n values of seq
+// Values of n below 1 yield nothing
+func Take[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n < 1 {
+			return
+		}
+		taken := 0
+		for v := range seq {
+			if !yield(v) {
+				return
+			}
+			if taken++; taken == n {
+				return
+			}
+		}
+	}
+}
+
+// Drop returns a sequence of the values of seq after the first n
+// Values of n below 1 yield every value
+func Drop[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		dropped := 0
+		for v := range seq {
+			if dropped < n {
+				dropped++
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package algo
+
+import (
+	"iter"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestZip(t *testing.T) {
+	var got []string
+	for n, s := range Zip(slices.Values([]int{1, 2, 3}), slices.Values([]string{"a", "b"})) {
+		got = append(got, strings.Repeat(s, n))
+	}
+	if !slices.Equal(got, []string{"a", "bb"}) {
+		t.Fatalf("expected [a bb] got %v", got)
+	}
+	for range Zip(slices.Values([]int{1, 2}), slices.Values([]int{3, 4})) {
+		break
+	}
+}
+
+func TestGroupByCountBy(t *testing.T) {
+	words := slices.Values([]string{"go", "rust", "c", "zig", "java", "d"})
+	groups := GroupBy(words, func(s string) int { return len(s) })
+	if !slices.Equal(groups[1], []string{"c", "d"}) || !slices.Equal(groups[4], []string{"rust", "java"}) {
+		t.Fatalf("unexpected groups %v", groups)
+	}
+	counts := CountBy(words, func(s string) bool { return len(s) > 2 })
+	if !maps.Equal(counts, map[bool]int{true: 3, false: 3}) {
+		t.Fatalf("unexpected counts %v", counts)
+	}
+}
+
+func TestSlidingWindow(t *testing.T) {
+	windows := slices.Collect(SlidingWindow(slices.Values([]int{1, 2, 3, 4}), 3))
+	if len(windows) != 2 || !slices.Equal(windows[0], []int{1, 2, 3}) || !slices.Equal(windows[1], []int{2, 3, 4}) {
+		t.Fatalf("unexpected windows %v", windows)
+	}
+	if n := len(slices.Collect(SlidingWindow(slices.Values([]int{1, 2}), 3))); n != 0 {
+		t.Fatalf("expected no windows got %d", n)
+	}
+	if n := len(slices.Collect(SlidingWindow(slices.Values([]int{1, 2}), 0))); n != 0 {
+		t.Fatalf("expected no windows got %d", n)
+	}
+}
+
+func TestFlattenTakeDrop(t *testing.T) {
+	seqs := slices.Values([]iter.Seq[int]{slices.Values([]int{1, 2}), slices.Values([]int(nil)), slices.Values([]int{3, 4, 5})})
+	all := Flatten(seqs)
+	if got := slices.Collect(all); !slices.Equal(got, []int{1, 2, 3, 4, 5}) {
+		t.Fatalf("expected [1 2 3 4 5] got %v", got)
+	}
+	if got := slices.Collect(Take(Drop(all, 1), 3)); !slices.Equal(got, []int{2, 3, 4}) {
+		t.Fatalf("expected [2 3 4] got %v", got)
+	}
+	if got := slices.Collect(Take(all, 0)); len(got) != 0 {
+		t.Fatalf("expected nothing got %v", got)
+	}
+	if got := slices.Collect(Drop(all, -1)); len(got) != 5 {
+		t.Fatalf("expected every value got %v", got)
+	}
+}
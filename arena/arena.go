@@ -0,0 +1,178 @@
+// Package arena provides allocators that node-based containers take their nodes from, so the
+// containers built for one piece of work can share chunked storage and be released together
+package arena
+
+import "reflect"
+
+// DefaultChunkSize is the number of values allocated per chunk when none is given
+const DefaultChunkSize = 64
+
+// Allocator supplies the nodes of containers created with one, such as list.NewLinkedListWithAllocator
+// and tree.NewTreapWithAllocator
+// Alloc returns a pointer to a new zeroed value of type t, wrapped in any; Arena implements it with
+// chunked storage, and other implementations can wrap an Arena to count, cap or trace allocations
+type Allocator interface {
+	Alloc(t reflect.Type) any
+}
+
+// Allocate returns a function handing out zeroed values of type T from a
+// Containers call it once and keep the function, which for an Arena skips the per-type lookup
+func Allocate[T any](a Allocator) func() *T {
+	if ar, ok := a.(*Arena); ok {
+		return allocator[T](ar)
+	}
+	t := reflect.TypeFor[T]()
+	return func() *T {
+		return a.Alloc(t).(*T)
+	}
+}
+
+// Arena hands out values carved from large chunks and releases them all at once on Reset
+// One arena can serve several containers and value types, keeping separate chunks per type
+// It is meant for build-then-discard workloads where per-value allocations dominate GC cost
+// It is not safe for concurrent use
+type Arena struct {
+	chunkSize int
+	stores    map[reflect.Type]store
+}
+
+// store holds the chunks of one value type
+type store interface {
+	alloc() any
+	len() int
+	reset()
+}
+
+// New creates a new arena allocating chunkSize values of a type at a time
+// Values of chunkSize below 1 fall back to DefaultChunkSize
+func New(chunkSize int) *Arena {
+	if chunkSize < 1 {
+		chunkSize = DefaultChunkSize
+	}
+	return &Arena{chunkSize: chunkSize, stores: make(map[reflect.Type]store)}
+}
+
+// Alloc returns a pointer to a zeroed value of type t owned by the arena, wrapped in any
+// The pointer stays valid until the next Reset
+// Allocate is faster for types known at compile time
+func (a *Arena) Alloc(t reflect.Type) any {
+	s, ok := a.stores[t]
+	if !ok {
+		s = &reflectStore{typ: t, chunkSize: a.chunkSize}
+		a.stores[t] = s
+	}
+	return s.alloc()
+}
+
+// Len returns the number of values handed out since the last Reset
+func (a *Arena) Len() int {
+	n := 0
+	for _, s := range a.stores {
+		n += s.len()
+	}
+	return n
+}
+
+// Reset releases every value at once, zeroing them so the chunks can be reused
+// Containers that allocated from the arena must be discarded or cleared first
+func (a *Arena) Reset() {
+	for _, s := range a.stores {
+		s.reset()
+	}
+}
+
+// allocator returns the allocation function of the arena's store for T
+func allocator[T any](a *Arena) func() *T {
+	t := reflect.TypeFor[T]()
+	switch s := a.stores[t].(type) {
+	case nil:
+		typed := &typedStore[T]{chunkSize: a.chunkSize}
+		a.stores[t] = typed
+		return typed.next
+	case *typedStore[T]:
+		return s.next
+	default:
+		// The type was first allocated through Alloc
+		return func() *T {
+			return s.alloc().(*T)
+		}
+	}
+}
+
+type typedStore[T any] struct {
+	chunks    [][]T
+	chunk     int
+	used      int
+	chunkSize int
+}
+
+func (s *typedStore[T]) next() *T {
+	if s.chunk == len(s.chunks) {
+		s.chunks = append(s.chunks, make([]T, s.chunkSize))
+	}
+
+	v := &s.chunks[s.chunk][s.used]
+	s.used++
+	if s.used == s.chunkSize {
+		s.chunk++
+		s.used = 0
+	}
+	return v
+}
+
+func (s *typedStore[T]) alloc() any {
+	return s.next()
+}
+
+func (s *typedStore[T]) len() int {
+	return s.chunk*s.chunkSize + s.used
+}
+
+func (s *typedStore[T]) reset() {
+	for i := 0; i < s.chunk; i++ {
+		clear(s.chunks[i])
+	}
+	if s.chunk < len(s.chunks) {
+		clear(s.chunks[s.chunk][:s.used])
+	}
+	s.chunk = 0
+	s.used = 0
+}
+
+// reflectStore is the store for types only ever allocated through Alloc
+type reflectStore struct {
+	typ       reflect.Type
+	chunks    []reflect.Value
+	chunk     int
+	used      int
+	chunkSize int
+}
+
+func (s *reflectStore) alloc() any {
+	if s.chunk == len(s.chunks) {
+		s.chunks = append(s.chunks, reflect.MakeSlice(reflect.SliceOf(s.typ), s.chunkSize, s.chunkSize))
+	}
+
+	v := s.chunks[s.chunk].Index(s.used).Addr().Interface()
+	s.used++
+	if s.used == s.chunkSize {
+		s.chunk++
+		s.used = 0
+	}
+	return v
+}
+
+func (s *reflectStore) len() int {
+	return s.chunk*s.chunkSize + s.used
+}
+
+func (s *reflectStore) reset() {
+	for i := 0; i < s.chunk; i++ {
+		s.chunks[i].Clear()
+	}
+	if s.chunk < len(s.chunks) {
+		s.chunks[s.chunk].Slice(0, s.used).Clear()
+	}
+	s.chunk = 0
+	s.used = 0
+}
@@ -0,0 +1,111 @@
+package arena
+
+import (
+	"reflect"
+	"testing"
+)
+
+type item struct {
+	value int
+	next  *item
+}
+
+func TestArenaAlloc(t *testing.T) {
+	a := New(4)
+	alloc := Allocate[item](a)
+	var prev *item
+	for i := 0; i < 10; i++ {
+		it := alloc()
+		if it.value != 0 || it.next != nil {
+			t.Fatalf("expected zeroed value got %+v", *it)
+		}
+		it.value = i
+		it.next = prev
+		prev = it
+	}
+	if a.Len() != 10 {
+		t.Fatalf("expected 10 allocations got %d", a.Len())
+	}
+	if s := a.stores[reflect.TypeFor[item]()].(*typedStore[item]); len(s.chunks) != 3 {
+		t.Fatalf("expected 3 chunks got %d", len(s.chunks))
+	}
+	for i := 9; i >= 0; i-- {
+		if prev.value != i {
+			t.Fatalf("expected %d got %d", i, prev.value)
+		}
+		prev = prev.next
+	}
+}
+
+func TestArenaResetReusesChunks(t *testing.T) {
+	a := New(0)
+	alloc := Allocate[item](a)
+	for i := 0; i < DefaultChunkSize+1; i++ {
+		alloc().value = i + 1
+	}
+	a.Reset()
+	if a.Len() != 0 {
+		t.Fatalf("expected 0 allocations after reset got %d", a.Len())
+	}
+	for i := 0; i < DefaultChunkSize+1; i++ {
+		if it := alloc(); it.value != 0 {
+			t.Fatalf("expected zeroed value after reset got %d", it.value)
+		}
+	}
+	if s := a.stores[reflect.TypeFor[item]()].(*typedStore[item]); len(s.chunks) != 2 {
+		t.Fatalf("expected chunks to be reused, got %d chunks", len(s.chunks))
+	}
+}
+
+func TestArenaSharedAcrossTypes(t *testing.T) {
+	a := New(2)
+	items, ints := Allocate[item](a), Allocate[int](a)
+	items().value = 1
+	*ints() = 2
+	*ints() = 3
+
+	// Alloc serves types it has not seen before as well as typed stores
+	s := a.Alloc(reflect.TypeFor[string]()).(*string)
+	*s = "x"
+	if p := a.Alloc(reflect.TypeFor[int]()).(*int); *p != 0 {
+		t.Fatalf("expected zeroed value got %d", *p)
+	}
+	if a.Len() != 5 {
+		t.Fatalf("expected 5 allocations got %d", a.Len())
+	}
+	a.Reset()
+	if a.Len() != 0 || *s != "" {
+		t.Fatalf("expected every store reset got %d %q", a.Len(), *s)
+	}
+}
+
+// countingAllocator is a custom Allocator wrapping an arena
+type countingAllocator struct {
+	*Arena
+	calls int
+}
+
+func (c *countingAllocator) Alloc(t reflect.Type) any {
+	c.calls++
+	return c.Arena.Alloc(t)
+}
+
+func TestAllocateCustomAllocator(t *testing.T) {
+	c := &countingAllocator{Arena: New(4)}
+	alloc := Allocate[item](c)
+	for i := 0; i < 5; i++ {
+		if it := alloc(); it.value != 0 {
+			t.Fatalf("expected zeroed value got %d", it.value)
+		}
+	}
+	if c.calls != 5 || c.Len() != 5 {
+		t.Fatalf("expected 5 allocations through the wrapper got %d %d", c.calls, c.Len())
+	}
+
+	// A type first seen through Alloc keeps its store when later allocated through Allocate
+	direct := Allocate[item](c.Arena)
+	direct().value = 1
+	if c.Len() != 6 {
+		t.Fatalf("expected 6 allocations got %d", c.Len())
+	}
+}
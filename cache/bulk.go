@@ -0,0 +1,88 @@
+package cache
+
+// GetMany returns the values stored for the keys present, bumping the access frequency of each
+// Returns the keys that are not present, in the order given
+func (c *LFUCache[K, V]) GetMany(keys []K) (map[K]V, []K) {
+	found := make(map[K]V, len(keys))
+	var missing []K
+	for _, key := range keys {
+		if v, ok := c.Get(key); ok {
+			found[key] = v
+		} else {
+			missing = append(missing, key)
+		}
+	}
+	return found, missing
+}
+
+// PutMany stores every entry of entries like Put, in no particular order
+func (c *LFUCache[K, V]) PutMany(entries map[K]V) {
+	for key, value := range entries {
+		c.Put(key, value)
+	}
+}
+
+// GetMany returns the values stored for the keys present, refreshing each entry and its group
+// Returns the keys that are not present, in the order given
+func (c *GroupedLRUCache[G, K, V]) GetMany(keys []K) (map[K]V, []K) {
+	found := make(map[K]V, len(keys))
+	var missing []K
+	for _, key := range keys {
+		if v, ok := c.Get(key); ok {
+			found[key] = v
+		} else {
+			missing = append(missing, key)
+		}
+	}
+	return found, missing
+}
+
+// PutMany stores every entry of entries in group like Put, in no particular order
+func (c *GroupedLRUCache[G, K, V]) PutMany(group G, entries map[K]V) {
+	for key, value := range entries {
+		c.Put(group, key, value)
+	}
+}
+
+// GetMany returns the values stored for the keys present and not expired, under a single lock
+// Returns the keys that are not present or have expired, in the order given
+func (c *ExpiringCache[K, V]) GetMany(keys []K) (map[K]V, []K) {
+	found := make(map[K]V, len(keys))
+	var missing []K
+	var expired map[K]V
+
+	c.mu.Lock()
+	now := c.now()
+	for _, key := range keys {
+		e, live := c.load(key, now)
+		switch {
+		case live:
+			found[key] = e.value
+		case e != nil:
+			if expired == nil {
+				expired = make(map[K]V)
+			}
+			expired[key] = e.value
+			missing = append(missing, key)
+		default:
+			missing = append(missing, key)
+		}
+	}
+	c.mu.Unlock()
+
+	// Callbacks run outside the lock so they may use the cache
+	for key, value := range expired {
+		c.notify(key, value)
+	}
+	return found, missing
+}
+
+// PutMany stores every entry of entries with the default TTL under a single lock
+func (c *ExpiringCache[K, V]) PutMany(entries map[K]V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	deadline := c.now().Add(c.defaultTTL)
+	for key, value := range entries {
+		c.entries[key] = &expiringEntry[V]{value: value, ttl: c.defaultTTL, deadline: deadline}
+	}
+}
@@ -0,0 +1,69 @@
+package cache
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestLFUCacheGetPutMany(t *testing.T) {
+	c := NewLFUCache[string, int](3)
+	c.PutMany(map[string]int{"a": 1, "b": 2})
+	found, missing := c.GetMany([]string{"a", "x", "b", "y"})
+	if len(found) != 2 || found["a"] != 1 || found["b"] != 2 {
+		t.Fatalf("expected a and b found got %v", found)
+	}
+	if !slices.Equal(missing, []string{"x", "y"}) {
+		t.Fatalf("expected [x y] missing got %v", missing)
+	}
+	if freq, _ := c.FreqOf("a"); freq != 2 {
+		t.Fatalf("expected GetMany to count as an access got frequency %d", freq)
+	}
+}
+
+func TestGroupedLRUCacheGetPutMany(t *testing.T) {
+	c := NewGroupedLRUCache[string, string, int](4)
+	c.PutMany("acme", map[string]int{"a1": 1, "a2": 2})
+	c.Put("globex", "g1", 3)
+	if c.GroupSize("acme") != 2 {
+		t.Fatalf("expected 2 entries in acme got %d", c.GroupSize("acme"))
+	}
+
+	// Reading acme makes it the most recently used group
+	found, missing := c.GetMany([]string{"a1", "zz"})
+	if found["a1"] != 1 || !slices.Equal(missing, []string{"zz"}) {
+		t.Fatalf("unexpected results %v %v", found, missing)
+	}
+	if !slices.Equal(c.Groups(), []string{"acme", "globex"}) {
+		t.Fatalf("expected acme refreshed got %v", c.Groups())
+	}
+}
+
+func TestExpiringCacheGetPutMany(t *testing.T) {
+	clock := newFakeClock()
+	var expired []string
+	c := NewExpiringCacheWithExpire(time.Minute, func(k string, _ int) {
+		expired = append(expired, k)
+	})
+	c.now = clock.Now
+
+	c.PutMany(map[string]int{"a": 1, "b": 2})
+	c.PutWithTTL("c", 3, 10*time.Second)
+	clock.Advance(30 * time.Second)
+
+	found, missing := c.GetMany([]string{"a", "c", "b", "x"})
+	if len(found) != 2 || found["a"] != 1 || found["b"] != 2 {
+		t.Fatalf("expected a and b found got %v", found)
+	}
+	if !slices.Equal(missing, []string{"c", "x"}) {
+		t.Fatalf("expected [c x] missing got %v", missing)
+	}
+	if !slices.Equal(expired, []string{"c"}) || c.Size() != 2 {
+		t.Fatalf("expected c dropped and reported got %v, size %d", expired, c.Size())
+	}
+
+	clock.Advance(31 * time.Second)
+	if found, _ := c.GetMany([]string{"a", "b"}); len(found) != 0 {
+		t.Fatalf("expected entries put together to expire together got %v", found)
+	}
+}
@@ -0,0 +1,145 @@
+package cache
+
+// Compute updates the entry for key with a single lookup
+// fn receives the current value and whether key is present, and returns the new value and whether
+// to keep it: keeping stores the value like Put, not keeping deletes an existing entry like Remove
+// fn must not use the cache
+// Returns the value stored for key afterwards, or false if key is not present
+func (c *LFUCache[K, V]) Compute(key K, fn func(old V, exists bool) (V, bool)) (V, bool) {
+	var old V
+	e, ok := c.entries[key]
+	if ok {
+		old = e.value
+	}
+	value, keep := fn(old, ok)
+	switch {
+	case keep && ok:
+		e.value = value
+		c.touch(e)
+	case keep:
+		c.insert(key, value)
+	case ok:
+		c.remove(e)
+	}
+	if !keep {
+		var zero V
+		return zero, false
+	}
+	return value, true
+}
+
+// GetOrSetFunc returns the value stored for key like Get, or stores and returns the value of fn
+// if key is not present
+// fn must not use the cache
+// Returns true if the value was already present
+func (c *LFUCache[K, V]) GetOrSetFunc(key K, fn func() V) (V, bool) {
+	c.metrics.CountLookup()
+	if e, ok := c.entries[key]; ok {
+		c.touch(e)
+		return e.value, true
+	}
+	value := fn()
+	c.insert(key, value)
+	return value, false
+}
+
+// Compute updates the entry for key with a single lookup
+// fn receives the current value and whether key is present, and returns the new value and whether
+// to keep it: keeping stores the value in group like Put, not keeping deletes an existing entry
+// like Remove
+// fn must not use the cache
+// Returns the value stored for key afterwards, or false if key is not present, including when
+// admission turned a new key away
+func (c *GroupedLRUCache[G, K, V]) Compute(group G, key K, fn func(old V, exists bool) (V, bool)) (V, bool) {
+	var old, zero V
+	c.record(key)
+	e, ok := c.entries[key]
+	if ok {
+		old = e.value
+	}
+	value, keep := fn(old, ok)
+	switch {
+	case keep && ok:
+		c.update(e, group, value)
+	case keep:
+		if !c.insert(group, key, value) {
+			return zero, false
+		}
+	case ok:
+		c.detach(e)
+		delete(c.entries, key)
+	}
+	if !keep {
+		return zero, false
+	}
+	return value, true
+}
+
+// GetOrSetFunc returns the value stored for key like Get, or stores the value of fn in group and
+// returns it if key is not present
+// fn must not use the cache; with admission the value may be turned away and not stored
+// Returns true if the value was already present
+func (c *GroupedLRUCache[G, K, V]) GetOrSetFunc(group G, key K, fn func() V) (V, bool) {
+	c.record(key)
+	if e, ok := c.entries[key]; ok {
+		c.refresh(e)
+		return e.value, true
+	}
+	value := fn()
+	c.insert(group, key, value)
+	return value, false
+}
+
+// Compute updates the entry for key atomically under a single lock
+// fn receives the current value and whether key is present and not expired, and returns the new
+// value and whether to keep it: keeping stores the value with the default TTL like Put, not
+// keeping deletes an existing entry like Remove
+// fn runs with the lock held and must not use the cache
+// Returns the value stored for key afterwards, or false if key is not present
+func (c *ExpiringCache[K, V]) Compute(key K, fn func(old V, exists bool) (V, bool)) (V, bool) {
+	var old, zero V
+	c.mu.Lock()
+	now := c.now()
+	e, live := c.load(key, now)
+	if live {
+		old = e.value
+	}
+	value, keep := fn(old, live)
+	switch {
+	case keep:
+		c.entries[key] = &expiringEntry[V]{value: value, ttl: c.defaultTTL, deadline: now.Add(c.defaultTTL)}
+	case live:
+		delete(c.entries, key)
+	}
+	c.mu.Unlock()
+
+	if e != nil && !live {
+		c.notify(key, e.value)
+	}
+	if !keep {
+		return zero, false
+	}
+	return value, true
+}
+
+// GetOrSetFunc returns the value stored for key like Get, or atomically stores the value of fn with
+// the default TTL and returns it if key is not present or has expired
+// fn runs with the lock held and must not use the cache
+// Returns true if the value was already present
+func (c *ExpiringCache[K, V]) GetOrSetFunc(key K, fn func() V) (V, bool) {
+	c.mu.Lock()
+	now := c.now()
+	e, live := c.load(key, now)
+	if live {
+		c.mu.Unlock()
+		return e.value, true
+	}
+	value := fn()
+	c.entries[key] = &expiringEntry[V]{value: value, ttl: c.defaultTTL, deadline: now.Add(c.defaultTTL)}
+	c.mu.Unlock()
+
+	if e != nil {
+		c.notify(key, e.value)
+	}
+	return value, false
+}
@@ -0,0 +1,131 @@
+package cache
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+// increment counts calls in a Compute callback, dropping the entry once it reaches limit
+func increment(limit int) func(old int, exists bool) (int, bool) {
+	return func(old int, _ bool) (int, bool) {
+		return old + 1, old+1 < limit
+	}
+}
+
+func TestLFUCacheCompute(t *testing.T) {
+	c := NewLFUCache[string, int](2)
+	if v, ok := c.Compute("a", increment(3)); !ok || v != 1 {
+		t.Fatalf("expected a new entry 1 got %d %v", v, ok)
+	}
+	if v, _ := c.Compute("a", increment(3)); v != 2 {
+		t.Fatalf("expected 2 got %d", v)
+	}
+	if freq, _ := c.FreqOf("a"); freq != 2 {
+		t.Fatalf("expected the update to count as an access got %d", freq)
+	}
+	if _, ok := c.Compute("a", increment(3)); ok || c.Contains("a") {
+		t.Fatalf("expected the entry removed at the limit")
+	}
+	if _, ok := c.Compute("x", func(int, bool) (int, bool) { return 0, false }); ok || c.Size() != 0 {
+		t.Fatalf("expected nothing stored for a dropped new key")
+	}
+
+	calls := 0
+	load := func() int { calls++; return 7 }
+	if v, loaded := c.GetOrSetFunc("b", load); loaded || v != 7 {
+		t.Fatalf("expected b stored got %d %v", v, loaded)
+	}
+	if v, loaded := c.GetOrSetFunc("b", load); !loaded || v != 7 || calls != 1 {
+		t.Fatalf("expected b loaded once got %d %v %d", v, loaded, calls)
+	}
+}
+
+func TestGroupedLRUCacheCompute(t *testing.T) {
+	c := NewGroupedLRUCache[string, string, int](3)
+	c.Compute("acme", "k", increment(10))
+	c.Compute("globex", "k", increment(10))
+	if v, _ := c.Peek("k"); v != 2 {
+		t.Fatalf("expected 2 got %d", v)
+	}
+	if g, _ := c.GroupOf("k"); g != "globex" {
+		t.Fatalf("expected the entry moved to globex got %s", g)
+	}
+	if _, ok := c.Compute("globex", "k", func(int, bool) (int, bool) { return 0, false }); ok || !c.IsEmpty() {
+		t.Fatalf("expected the entry removed")
+	}
+	if len(c.Groups()) != 0 {
+		t.Fatalf("expected the empty group dropped got %v", c.Groups())
+	}
+
+	c.Put("acme", "a", 1)
+	c.Put("globex", "g", 2)
+	if v, loaded := c.GetOrSetFunc("initech", "a", func() int { return 9 }); !loaded || v != 1 {
+		t.Fatalf("expected a loaded got %d %v", v, loaded)
+	}
+	if v, loaded := c.GetOrSetFunc("initech", "i", func() int { return 9 }); loaded || v != 9 {
+		t.Fatalf("expected i stored got %d %v", v, loaded)
+	}
+	if !slices.Equal(c.Groups(), []string{"initech", "acme", "globex"}) {
+		t.Fatalf("unexpected group order %v", c.Groups())
+	}
+}
+
+func TestGroupedLRUCacheComputeAdmission(t *testing.T) {
+	c := NewGroupedLRUCacheWithAdmission[string, int, int](1, func(k int) uint64 { return uint64(k) })
+	c.Put("g", 1, 1)
+	c.Get(1)
+	if _, ok := c.Compute("g", 2, increment(10)); ok || c.Contains(2) {
+		t.Fatalf("expected a key seen once turned away")
+	}
+}
+
+func TestExpiringCacheCompute(t *testing.T) {
+	clock := newFakeClock()
+	var expired []string
+	c := NewExpiringCacheWithExpire(time.Minute, func(k string, _ int) {
+		expired = append(expired, k)
+	})
+	c.now = clock.Now
+
+	c.PutWithTTL("a", 5, time.Second)
+	clock.Advance(2 * time.Second)
+	if v, _ := c.Compute("a", increment(10)); v != 1 {
+		t.Fatalf("expected an expired entry to count as absent got %d", v)
+	}
+	if !slices.Equal(expired, []string{"a"}) {
+		t.Fatalf("expected the expired entry reported got %v", expired)
+	}
+	if ttl, _ := c.TTL("a"); ttl != time.Minute {
+		t.Fatalf("expected the default TTL got %v", ttl)
+	}
+	if _, ok := c.Compute("a", func(int, bool) (int, bool) { return 0, false }); ok || c.Size() != 0 {
+		t.Fatalf("expected the entry removed")
+	}
+
+	if v, loaded := c.GetOrSetFunc("b", func() int { return 3 }); loaded || v != 3 {
+		t.Fatalf("expected b stored got %d %v", v, loaded)
+	}
+	if v, loaded := c.GetOrSetFunc("b", func() int { return 4 }); !loaded || v != 3 {
+		t.Fatalf("expected b loaded got %d %v", v, loaded)
+	}
+}
+
+func TestExpiringCacheComputeConcurrent(t *testing.T) {
+	c := NewExpiringCache[string, int](0)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				c.Compute("n", increment(1<<30))
+			}
+		}()
+	}
+	wg.Wait()
+	if v, _ := c.Get("n"); v != 8000 {
+		t.Fatalf("expected 8000 atomic increments got %d", v)
+	}
+}
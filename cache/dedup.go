@@ -0,0 +1,181 @@
+package cache
+
+import (
+	"container/heap"
+	"sync"
+	"time"
+)
+
+type dedupEntry[K comparable] struct {
+	key      K
+	deadline time.Time
+	index    int // position in the expiry heap, -1 for keys that never expire
+}
+
+// dedupHeap orders entries by deadline, soonest first
+type dedupHeap[K comparable] []*dedupEntry[K]
+
+func (h dedupHeap[K]) Len() int { return len(h) }
+
+func (h dedupHeap[K]) Less(i, j int) bool { return h[i].deadline.Before(h[j].deadline) }
+
+func (h dedupHeap[K]) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *dedupHeap[K]) Push(x any) {
+	e := x.(*dedupEntry[K])
+	e.index = len(*h)
+	*h = append(*h, e)
+}
+
+func (h *dedupHeap[K]) Pop() any {
+	old := *h
+	n := len(old)
+	e := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	e.index = -1
+	return e
+}
+
+// DedupSet is a goroutine-safe set of recently seen keys for at-most-once processing within a window
+// Every key carries its own deadline; expired keys are dropped in deadline order from a heap
+// as the set is used, so cleanup costs O(log n) per expired key and needs no background goroutine
+type DedupSet[K comparable] struct {
+	mu      sync.Mutex
+	entries map[K]*dedupEntry[K]
+	expiry  dedupHeap[K]
+	now     func() time.Time
+}
+
+// NewDedupSet creates a new empty dedup set
+func NewDedupSet[K comparable]() *DedupSet[K] {
+	return &DedupSet[K]{entries: make(map[K]*dedupEntry[K]), now: time.Now}
+}
+
+// Size returns the number of keys that have not expired
+func (s *DedupSet[K]) Size() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.expire(s.now())
+	return len(s.entries)
+}
+
+// IsEmpty checks if every key has expired or been removed
+func (s *DedupSet[K]) IsEmpty() bool {
+	return s.Size() == 0
+}
+
+// Add marks key as seen for ttl from now, re-arming the deadline if the key is already present
+// A non-positive ttl means the key never expires
+// Returns true if key was already present, which means the caller has seen it within its window
+func (s *DedupSet[K]) Add(key K, ttl time.Duration) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	s.expire(now)
+
+	e, present := s.entries[key]
+	if !present {
+		e = &dedupEntry[K]{key: key, index: -1}
+		s.entries[key] = e
+	}
+	switch {
+	case ttl <= 0:
+		if e.index >= 0 {
+			heap.Remove(&s.expiry, e.index)
+		}
+		e.deadline = time.Time{}
+	case e.index >= 0:
+		e.deadline = now.Add(ttl)
+		heap.Fix(&s.expiry, e.index)
+	default:
+		e.deadline = now.Add(ttl)
+		heap.Push(&s.expiry, e)
+	}
+	return present
+}
+
+// AddIfAbsent marks key as seen for ttl from now only if it is not already present,
+// leaving the deadline of a present key untouched
+// Returns true if key was added
+func (s *DedupSet[K]) AddIfAbsent(key K, ttl time.Duration) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	s.expire(now)
+	if _, ok := s.entries[key]; ok {
+		return false
+	}
+
+	e := &dedupEntry[K]{key: key, index: -1}
+	s.entries[key] = e
+	if ttl > 0 {
+		e.deadline = now.Add(ttl)
+		heap.Push(&s.expiry, e)
+	}
+	return true
+}
+
+// Contains checks if key was added and has not expired
+func (s *DedupSet[K]) Contains(key K) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.expire(s.now())
+	_, ok := s.entries[key]
+	return ok
+}
+
+// TTL returns the time left before key expires
+// A zero duration with true means the key never expires
+// Returns false if the key is not present
+func (s *DedupSet[K]) TTL(key K) (time.Duration, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	s.expire(now)
+	e, ok := s.entries[key]
+	if !ok {
+		return 0, false
+	}
+	if e.index < 0 {
+		return 0, true
+	}
+	return e.deadline.Sub(now), true
+}
+
+// Remove forgets key before its deadline
+// Returns true if the key was found and removed, false otherwise
+func (s *DedupSet[K]) Remove(key K) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.entries[key]
+	if !ok {
+		return false
+	}
+	if e.index >= 0 {
+		heap.Remove(&s.expiry, e.index)
+	}
+	delete(s.entries, key)
+	return true
+}
+
+// Clear removes all keys
+func (s *DedupSet[K]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	clear(s.entries)
+	clear(s.expiry)
+	s.expiry = s.expiry[:0]
+}
+
+// expire drops every key whose deadline is at or before now, the caller holds the lock
+func (s *DedupSet[K]) expire(now time.Time) {
+	for len(s.expiry) > 0 && !now.Before(s.expiry[0].deadline) {
+		e := heap.Pop(&s.expiry).(*dedupEntry[K])
+		delete(s.entries, e.key)
+	}
+}
@@ -0,0 +1,68 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDedupSetWindow(t *testing.T) {
+	clock := time.Unix(0, 0)
+	s := NewDedupSet[string]()
+	s.now = func() time.Time { return clock }
+
+	if s.Add("msg-1", time.Minute) {
+		t.Fatalf("expected msg-1 to be new")
+	}
+	clock = clock.Add(30 * time.Second)
+	if !s.Add("msg-1", time.Minute) {
+		t.Fatalf("expected msg-1 to be a duplicate")
+	}
+	// The duplicate re-armed the deadline to 90s
+	clock = clock.Add(45 * time.Second)
+	if !s.Contains("msg-1") {
+		t.Fatalf("expected msg-1 to still be present after re-arming")
+	}
+	if ttl, ok := s.TTL("msg-1"); !ok || ttl != 15*time.Second {
+		t.Fatalf("expected 15s left got %v %v", ttl, ok)
+	}
+	clock = clock.Add(15 * time.Second)
+	if s.Contains("msg-1") || s.Add("msg-1", time.Minute) {
+		t.Fatalf("expected msg-1 to have expired")
+	}
+}
+
+func TestDedupSetAddIfAbsentAndRemove(t *testing.T) {
+	clock := time.Unix(0, 0)
+	s := NewDedupSet[int]()
+	s.now = func() time.Time { return clock }
+
+	if !s.AddIfAbsent(1, 10*time.Second) || s.AddIfAbsent(1, time.Hour) {
+		t.Fatalf("expected only the first AddIfAbsent to succeed")
+	}
+	s.Add(2, 0)
+	s.Add(3, 5*time.Second)
+	s.Add(3, 0)
+	if s.Size() != 3 {
+		t.Fatalf("expected size 3 got %d", s.Size())
+	}
+
+	clock = clock.Add(time.Minute)
+	if s.Contains(1) {
+		t.Fatalf("expected AddIfAbsent not to extend the deadline")
+	}
+	if ttl, ok := s.TTL(3); !ok || ttl != 0 {
+		t.Fatalf("expected 3 to never expire got %v %v", ttl, ok)
+	}
+	if s.Size() != 2 {
+		t.Fatalf("expected size 2 got %d", s.Size())
+	}
+
+	if !s.Remove(2) || s.Remove(2) {
+		t.Fatalf("unexpected Remove results")
+	}
+	s.Add(4, time.Second)
+	s.Clear()
+	if !s.IsEmpty() {
+		t.Fatalf("expected empty set after Clear")
+	}
+}
@@ -0,0 +1,129 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/profoundwu/containers/graph"
+)
+
+var (
+	ErrDependencyCycle = errors.New("dependency would create a cycle")
+)
+
+// DependencyCache caches derived values whose keys depend on other keys
+// Dependencies form a DAG, and invalidating a key also invalidates everything derived from it, transitively
+// The dependency structure outlives the cached values, so a recomputed entry keeps its dependents
+type DependencyCache[K comparable, V any] struct {
+	values       map[K]V
+	dependents   *graph.Graph[K]
+	onInvalidate func(key K, value V)
+}
+
+// NewDependencyCache creates a new empty dependency cache
+func NewDependencyCache[K comparable, V any]() *DependencyCache[K, V] {
+	return NewDependencyCacheWithInvalidate[K, V](nil)
+}
+
+// NewDependencyCacheWithInvalidate creates a new empty dependency cache that calls
+// onInvalidate for every cached entry removed by Invalidate
+func NewDependencyCacheWithInvalidate[K comparable, V any](onInvalidate func(key K, value V)) *DependencyCache[K, V] {
+	return &DependencyCache[K, V]{
+		values:       make(map[K]V),
+		dependents:   graph.NewDirectedGraph[K](),
+		onInvalidate: onInvalidate,
+	}
+}
+
+// Size returns the number of cached values
+func (c *DependencyCache[K, V]) Size() int {
+	return len(c.values)
+}
+
+// IsEmpty checks if no values are cached
+func (c *DependencyCache[K, V]) IsEmpty() bool {
+	return len(c.values) == 0
+}
+
+// Put caches value under key
+func (c *DependencyCache[K, V]) Put(key K, value V) {
+	c.values[key] = value
+}
+
+// Get returns the cached value for key
+// Returns false if the key is not cached
+func (c *DependencyCache[K, V]) Get(key K) (V, bool) {
+	v, ok := c.values[key]
+	return v, ok
+}
+
+// Contains checks if a value is cached for key
+func (c *DependencyCache[K, V]) Contains(key K) bool {
+	_, ok := c.values[key]
+	return ok
+}
+
+// AddDependency records that key is derived from dependency, so invalidating dependency invalidates key
+// Returns error if dependency already depends on key, directly or transitively
+func (c *DependencyCache[K, V]) AddDependency(key, dependency K) error {
+	if key == dependency || c.dependsOn(dependency, key) {
+		return fmt.Errorf("%w: %v -> %v", ErrDependencyCycle, key, dependency)
+	}
+	c.dependents.AddEdge(dependency, key)
+	return nil
+}
+
+// RemoveDependency forgets that key is derived from dependency
+// Returns true if the dependency existed, false otherwise
+func (c *DependencyCache[K, V]) RemoveDependency(key, dependency K) bool {
+	return c.dependents.RemoveEdge(dependency, key)
+}
+
+// Dependents returns the keys directly derived from key
+func (c *DependencyCache[K, V]) Dependents(key K) []K {
+	dependents, _ := c.dependents.Neighbors(key)
+	return dependents
+}
+
+// Invalidate removes the cached value of key and of every key that depends on it, transitively
+// Returns the keys whose values were removed, nearest dependents first
+func (c *DependencyCache[K, V]) Invalidate(key K) []K {
+	affected, err := c.dependents.BFS(key)
+	if err != nil {
+		// key has no dependency edges
+		affected = []K{key}
+	}
+
+	var removed []K
+	for _, k := range affected {
+		v, ok := c.values[k]
+		if !ok {
+			continue
+		}
+		delete(c.values, k)
+		removed = append(removed, k)
+		if c.onInvalidate != nil {
+			c.onInvalidate(k, v)
+		}
+	}
+	return removed
+}
+
+// Clear removes every cached value but keeps the recorded dependencies
+func (c *DependencyCache[K, V]) Clear() {
+	clear(c.values)
+}
+
+// dependsOn checks if key is reachable from dependency through dependent edges
+func (c *DependencyCache[K, V]) dependsOn(key, dependency K) bool {
+	reachable, err := c.dependents.BFS(dependency)
+	if err != nil {
+		return false
+	}
+	for _, k := range reachable {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package cache
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func newTemplateCache(t *testing.T) *DependencyCache[string, string] {
+	t.Helper()
+	c := NewDependencyCache[string, string]()
+	for _, k := range []string{"base", "header", "page", "sitemap", "other"} {
+		c.Put(k, k+" html")
+	}
+	for _, dep := range [][2]string{{"header", "base"}, {"page", "header"}, {"sitemap", "page"}} {
+		if err := c.AddDependency(dep[0], dep[1]); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	return c
+}
+
+func TestDependencyCacheInvalidateCascades(t *testing.T) {
+	c := newTemplateCache(t)
+	removed := c.Invalidate("header")
+	if !slices.Equal(removed, []string{"header", "page", "sitemap"}) {
+		t.Fatalf("unexpected invalidation %v", removed)
+	}
+	if !c.Contains("base") || !c.Contains("other") || c.Size() != 2 {
+		t.Fatalf("unrelated entries should survive, size %d", c.Size())
+	}
+
+	// Recomputed entries keep their dependents
+	c.Put("header", "new header")
+	c.Put("page", "new page")
+	if removed := c.Invalidate("base"); !slices.Equal(removed, []string{"base", "header", "page"}) {
+		t.Fatalf("unexpected invalidation %v", removed)
+	}
+	if removed := c.Invalidate("unknown"); len(removed) != 0 {
+		t.Fatalf("expected nothing removed got %v", removed)
+	}
+	if removed := c.Invalidate("other"); !slices.Equal(removed, []string{"other"}) {
+		t.Fatalf("expected a key without dependencies to be removed got %v", removed)
+	}
+}
+
+func TestDependencyCacheRejectsCycles(t *testing.T) {
+	c := newTemplateCache(t)
+	if err := c.AddDependency("base", "sitemap"); !errors.Is(err, ErrDependencyCycle) {
+		t.Fatalf("expected ErrDependencyCycle got %v", err)
+	}
+	if err := c.AddDependency("base", "base"); !errors.Is(err, ErrDependencyCycle) {
+		t.Fatalf("expected ErrDependencyCycle for a self dependency got %v", err)
+	}
+	if !slices.Equal(c.Dependents("header"), []string{"page"}) {
+		t.Fatalf("unexpected dependents %v", c.Dependents("header"))
+	}
+	if !c.RemoveDependency("page", "header") || c.RemoveDependency("page", "header") {
+		t.Fatalf("expected a single successful removal")
+	}
+	if removed := c.Invalidate("header"); !slices.Equal(removed, []string{"header"}) {
+		t.Fatalf("removed dependency should stop the cascade got %v", removed)
+	}
+}
+
+func TestDependencyCacheOnInvalidate(t *testing.T) {
+	var seen []string
+	c := NewDependencyCacheWithInvalidate(func(k string, v int) {
+		seen = append(seen, k)
+	})
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.AddDependency("b", "a")
+	c.Invalidate("a")
+	if !slices.Equal(seen, []string{"a", "b"}) {
+		t.Fatalf("unexpected callbacks %v", seen)
+	}
+	c.Put("a", 1)
+	c.Clear()
+	if !c.IsEmpty() || len(c.Dependents("a")) != 1 {
+		t.Fatalf("clear should drop values but keep dependencies")
+	}
+	if _, ok := c.Get("a"); ok {
+		t.Fatalf("expected a to be gone")
+	}
+}
@@ -0,0 +1,237 @@
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+type expiringEntry[V any] struct {
+	value    V
+	ttl      time.Duration
+	deadline time.Time
+}
+
+// expired checks if the entry has a deadline at or before now
+func (e *expiringEntry[V]) expired(now time.Time) bool {
+	return e.ttl > 0 && !now.Before(e.deadline)
+}
+
+// ExpiringCache is a goroutine-safe cache whose entries expire after a time-to-live
+// Expired entries are dropped lazily on access, by DeleteExpired, or by the
+// background janitor started with Start
+type ExpiringCache[K comparable, V any] struct {
+	mu         sync.Mutex
+	entries    map[K]*expiringEntry[V]
+	defaultTTL time.Duration
+	sliding    bool
+	onExpire   func(key K, value V)
+	now        func() time.Time
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewExpiringCache creates a new empty cache whose entries live for defaultTTL
+// A non-positive defaultTTL means entries without an explicit TTL never expire
+func NewExpiringCache[K comparable, V any](defaultTTL time.Duration) *ExpiringCache[K, V] {
+	return &ExpiringCache[K, V]{
+		entries:    make(map[K]*expiringEntry[V]),
+		defaultTTL: defaultTTL,
+		now:        time.Now,
+	}
+}
+
+// NewExpiringCacheWithExpire creates a new empty cache that calls onExpire
+// whenever an expired entry is dropped
+func NewExpiringCacheWithExpire[K comparable, V any](defaultTTL time.Duration, onExpire func(key K, value V)) *ExpiringCache[K, V] {
+	c := NewExpiringCache[K, V](defaultTTL)
+	c.onExpire = onExpire
+	return c
+}
+
+// SetSliding enables or disables sliding expiration
+// In sliding mode every successful Get pushes the entry's deadline back by its TTL
+func (c *ExpiringCache[K, V]) SetSliding(sliding bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sliding = sliding
+}
+
+// Size returns the number of entries in the cache
+// Expired entries that have not been dropped yet are included
+func (c *ExpiringCache[K, V]) Size() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// IsEmpty checks if the cache is empty
+func (c *ExpiringCache[K, V]) IsEmpty() bool {
+	return c.Size() == 0
+}
+
+// Put stores value under key with the default TTL
+func (c *ExpiringCache[K, V]) Put(key K, value V) {
+	c.PutWithTTL(key, value, c.defaultTTL)
+}
+
+// PutWithTTL stores value under key with the given TTL
+// A non-positive ttl means the entry never expires
+func (c *ExpiringCache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = &expiringEntry[V]{
+		value:    value,
+		ttl:      ttl,
+		deadline: c.now().Add(ttl),
+	}
+}
+
+// Get returns the value stored for key
+// Returns false if the key is not present or has expired
+func (c *ExpiringCache[K, V]) Get(key K) (V, bool) {
+	var zero V
+	c.mu.Lock()
+	e, live := c.load(key, c.now())
+	c.mu.Unlock()
+
+	if e == nil {
+		return zero, false
+	}
+	if !live {
+		c.notify(key, e.value)
+		return zero, false
+	}
+	return e.value, true
+}
+
+// load returns the entry stored for key and whether it is still live, refreshing a sliding deadline
+// An expired entry is dropped and returned for the caller to notify once mu is released
+// Returns nil if the key is not present; the caller holds mu
+func (c *ExpiringCache[K, V]) load(key K, now time.Time) (*expiringEntry[V], bool) {
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if e.expired(now) {
+		delete(c.entries, key)
+		return e, false
+	}
+	if c.sliding {
+		e.deadline = now.Add(e.ttl)
+	}
+	return e, true
+}
+
+// TTL returns the time left before the entry stored for key expires
+// A zero duration with true means the entry never expires
+// Returns false if the key is not present or has expired
+func (c *ExpiringCache[K, V]) TTL(key K) (time.Duration, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return 0, false
+	}
+	if e.ttl <= 0 {
+		return 0, true
+	}
+	now := c.now()
+	if e.expired(now) {
+		return 0, false
+	}
+	return e.deadline.Sub(now), true
+}
+
+// Remove deletes the entry stored for key without invoking the expiry callback
+// Returns true if the key was found and removed, false otherwise
+func (c *ExpiringCache[K, V]) Remove(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[key]; !ok {
+		return false
+	}
+	delete(c.entries, key)
+	return true
+}
+
+// Clear removes all entries from the cache without invoking the expiry callback
+func (c *ExpiringCache[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[K]*expiringEntry[V])
+}
+
+// DeleteExpired drops every expired entry and returns how many were dropped
+func (c *ExpiringCache[K, V]) DeleteExpired() int {
+	type expiredPair struct {
+		key   K
+		value V
+	}
+
+	c.mu.Lock()
+	now := c.now()
+	var expired []expiredPair
+	for k, e := range c.entries {
+		if e.expired(now) {
+			expired = append(expired, expiredPair{k, e.value})
+			delete(c.entries, k)
+		}
+	}
+	c.mu.Unlock()
+
+	// Callbacks run outside the lock so they may use the cache
+	for _, p := range expired {
+		c.notify(p.key, p.value)
+	}
+	return len(expired)
+}
+
+// Start launches a background janitor that calls DeleteExpired every interval
+// Calling Start while the janitor is running has no effect
+func (c *ExpiringCache[K, V]) Start(interval time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.stop != nil || interval <= 0 {
+		return
+	}
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	c.stop, c.done = stop, done
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				c.DeleteExpired()
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop halts the background janitor and waits for it to exit
+// Calling Stop when no janitor is running has no effect
+func (c *ExpiringCache[K, V]) Stop() {
+	c.mu.Lock()
+	stop, done := c.stop, c.done
+	c.stop, c.done = nil, nil
+	c.mu.Unlock()
+
+	if stop == nil {
+		return
+	}
+	close(stop)
+	<-done
+}
+
+// notify invokes the expiry callback if one is registered
+func (c *ExpiringCache[K, V]) notify(key K, value V) {
+	if c.onExpire != nil {
+		c.onExpire(key, value)
+	}
+}
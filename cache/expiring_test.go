@@ -0,0 +1,147 @@
+package cache
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock is a manually advanced time source for expiry tests
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Unix(1000, 0)}
+}
+
+func (f *fakeClock) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+func (f *fakeClock) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = f.now.Add(d)
+}
+
+func TestExpiringCacheLazyExpiry(t *testing.T) {
+	clock := newFakeClock()
+	var expired []string
+	c := NewExpiringCacheWithExpire(time.Minute, func(k string, _ int) {
+		expired = append(expired, k)
+	})
+	c.now = clock.Now
+
+	c.Put("a", 1)
+	c.PutWithTTL("b", 2, 10*time.Second)
+	c.PutWithTTL("forever", 3, 0)
+
+	if v, ok := c.Get("b"); !ok || v != 2 {
+		t.Fatalf("expected 2 got %d ok=%v", v, ok)
+	}
+	clock.Advance(10 * time.Second)
+	if _, ok := c.Get("b"); ok {
+		t.Fatalf("b should have expired")
+	}
+	if _, ok := c.Get("a"); !ok {
+		t.Fatalf("a should still be alive")
+	}
+	clock.Advance(time.Hour)
+	if _, ok := c.Get("a"); ok {
+		t.Fatalf("a should have expired")
+	}
+	if _, ok := c.Get("forever"); !ok {
+		t.Fatalf("entry without ttl should never expire")
+	}
+	if len(expired) != 2 || expired[0] != "b" || expired[1] != "a" {
+		t.Fatalf("unexpected expiry callbacks %v", expired)
+	}
+	if c.Size() != 1 {
+		t.Fatalf("expected size 1 got %d", c.Size())
+	}
+}
+
+func TestExpiringCacheSliding(t *testing.T) {
+	clock := newFakeClock()
+	c := NewExpiringCache[string, int](10 * time.Second)
+	c.now = clock.Now
+	c.SetSliding(true)
+
+	c.Put("a", 1)
+	for i := 0; i < 5; i++ {
+		clock.Advance(8 * time.Second)
+		if _, ok := c.Get("a"); !ok {
+			t.Fatalf("sliding entry should stay alive on access (round %d)", i)
+		}
+	}
+	clock.Advance(10 * time.Second)
+	if _, ok := c.Get("a"); ok {
+		t.Fatalf("sliding entry should expire once left idle")
+	}
+}
+
+func TestExpiringCacheTTLAndRemove(t *testing.T) {
+	clock := newFakeClock()
+	c := NewExpiringCache[string, int](time.Minute)
+	c.now = clock.Now
+
+	c.Put("a", 1)
+	clock.Advance(20 * time.Second)
+	if ttl, ok := c.TTL("a"); !ok || ttl != 40*time.Second {
+		t.Fatalf("expected 40s left got %v ok=%v", ttl, ok)
+	}
+	if _, ok := c.TTL("missing"); ok {
+		t.Fatalf("missing key should have no ttl")
+	}
+	if !c.Remove("a") || c.Remove("a") {
+		t.Fatalf("expected a single successful removal")
+	}
+	c.Put("b", 2)
+	c.Clear()
+	if !c.IsEmpty() {
+		t.Fatalf("expected empty cache after clear")
+	}
+}
+
+func TestExpiringCacheDeleteExpired(t *testing.T) {
+	clock := newFakeClock()
+	c := NewExpiringCache[int, int](time.Second)
+	c.now = clock.Now
+	for i := 0; i < 5; i++ {
+		c.Put(i, i)
+	}
+	c.PutWithTTL(99, 99, time.Hour)
+	clock.Advance(time.Second)
+	if n := c.DeleteExpired(); n != 5 {
+		t.Fatalf("expected 5 expired entries got %d", n)
+	}
+	if c.Size() != 1 {
+		t.Fatalf("expected size 1 got %d", c.Size())
+	}
+}
+
+func TestExpiringCacheJanitor(t *testing.T) {
+	expired := make(chan string, 1)
+	c := NewExpiringCacheWithExpire(time.Millisecond, func(k string, _ int) {
+		expired <- k
+	})
+	c.Put("a", 1)
+	c.Start(time.Millisecond)
+	c.Start(time.Millisecond) // no-op while running
+	defer c.Stop()
+
+	select {
+	case k := <-expired:
+		if k != "a" {
+			t.Fatalf("unexpected expired key %s", k)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("janitor did not expire the entry")
+	}
+	c.Stop()
+	c.Stop() // no-op when stopped
+}
@@ -0,0 +1,372 @@
+package cache
+
+import (
+	"iter"
+
+	"github.com/profoundwu/containers/internal/utils"
+	"github.com/profoundwu/containers/sketch"
+)
+
+type groupedEntry[G comparable, K comparable, V any] struct {
+	key   K
+	value V
+	group *entryGroup[G, K, V]
+	prev  *groupedEntry[G, K, V]
+	next  *groupedEntry[G, K, V]
+}
+
+// entryGroup owns a circular list of its entries, most recently used first, and sits in the
+// cache's own circular list of groups
+type entryGroup[G comparable, K comparable, V any] struct {
+	name     G
+	sentinel groupedEntry[G, K, V]
+	size     int
+	prev     *entryGroup[G, K, V]
+	next     *entryGroup[G, K, V]
+}
+
+func newEntryGroup[G comparable, K comparable, V any](name G) *entryGroup[G, K, V] {
+	g := &entryGroup[G, K, V]{name: name}
+	g.sentinel.prev = &g.sentinel
+	g.sentinel.next = &g.sentinel
+	return g
+}
+
+func (g *entryGroup[G, K, V]) pushFront(e *groupedEntry[G, K, V]) {
+	e.group = g
+	e.prev = &g.sentinel
+	e.next = g.sentinel.next
+	g.sentinel.next.prev = e
+	g.sentinel.next = e
+	g.size++
+}
+
+func (g *entryGroup[G, K, V]) remove(e *groupedEntry[G, K, V]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.prev = nil
+	e.next = nil
+	g.size--
+}
+
+// GroupedLRUCache is a fixed-capacity cache whose entries belong to groups, such as all keys of one tenant
+// Recency is tracked per group: using any entry refreshes its whole group, and when the cache is full the
+// least recently used group is evicted as a unit. Only a single group outgrowing the whole capacity
+// loses individual entries, its least recently used ones first
+type GroupedLRUCache[G comparable, K comparable, V any] struct {
+	capacity int
+	entries  map[K]*groupedEntry[G, K, V]
+	groups   map[G]*entryGroup[G, K, V]
+	// order is the sentinel of the group list, most recently used group first
+	order   entryGroup[G, K, V]
+	onEvict func(group G, key K, value V)
+	// admission gates new keys by recent frequency when hash is set
+	admission *sketch.FrequencySketch
+	hash      func(key K) uint64
+}
+
+// NewGroupedLRUCache creates a new empty grouped cache holding at most capacity entries
+func NewGroupedLRUCache[G comparable, K comparable, V any](capacity int) *GroupedLRUCache[G, K, V] {
+	if capacity < 1 {
+		capacity = utils.DefaultCapacity
+	}
+	c := &GroupedLRUCache[G, K, V]{
+		capacity: capacity,
+		entries:  make(map[K]*groupedEntry[G, K, V], capacity),
+		groups:   make(map[G]*entryGroup[G, K, V]),
+	}
+	c.order.prev = &c.order
+	c.order.next = &c.order
+	return c
+}
+
+// NewGroupedLRUCacheWithEvict creates a new empty grouped cache that calls onEvict
+// for every entry evicted to make room for new ones
+func NewGroupedLRUCacheWithEvict[G comparable, K comparable, V any](capacity int, onEvict func(group G, key K, value V)) *GroupedLRUCache[G, K, V] {
+	c := NewGroupedLRUCache[G, K, V](capacity)
+	c.onEvict = onEvict
+	return c
+}
+
+// NewGroupedLRUCacheWithAdmission creates a new empty grouped cache with TinyLFU admission: every Get
+// and Put is counted in a frequency sketch keyed by hash, and a full cache only stores a new key
+// that was seen more often recently than the entries it would evict
+// This keeps a burst of keys used once, such as a scan, from flushing out groups that are still hot
+// A rejected Put stores nothing and evicts nothing; Put the key again once it is popular enough
+func NewGroupedLRUCacheWithAdmission[G comparable, K comparable, V any](capacity int, hash func(key K) uint64) *GroupedLRUCache[G, K, V] {
+	c := NewGroupedLRUCache[G, K, V](capacity)
+	c.admission = sketch.NewFrequencySketch(c.capacity)
+	c.hash = hash
+	return c
+}
+
+// Size returns the number of entries in the cache
+func (c *GroupedLRUCache[G, K, V]) Size() int {
+	return len(c.entries)
+}
+
+// IsEmpty checks if the cache is empty
+func (c *GroupedLRUCache[G, K, V]) IsEmpty() bool {
+	return len(c.entries) == 0
+}
+
+// Capacity returns the maximum number of entries the cache holds
+func (c *GroupedLRUCache[G, K, V]) Capacity() int {
+	return c.capacity
+}
+
+// Put stores value under key in group, evicting least recently used groups when full
+// An existing key is updated and moved to group if it belonged to another one
+// With admission, a new key that is not more popular than the entries it would evict is dropped
+func (c *GroupedLRUCache[G, K, V]) Put(group G, key K, value V) {
+	c.record(key)
+	if e, ok := c.entries[key]; ok {
+		c.update(e, group, value)
+		return
+	}
+	c.insert(group, key, value)
+}
+
+// update stores value in the existing entry e, moving it to group if it belonged to another one
+func (c *GroupedLRUCache[G, K, V]) update(e *groupedEntry[G, K, V], group G, value V) {
+	e.value = value
+	if e.group.name == group {
+		e.group.remove(e)
+		e.group.pushFront(e)
+	} else {
+		c.detach(e)
+		c.group(group).pushFront(e)
+	}
+	c.touch(e.group)
+}
+
+// insert stores a new entry for key in group, evicting least recently used groups when full
+// Returns false if admission turned the key away
+func (c *GroupedLRUCache[G, K, V]) insert(group G, key K, value V) bool {
+	if !c.admit(group, key) {
+		return false
+	}
+	g := c.group(group)
+	e := &groupedEntry[G, K, V]{key: key, value: value}
+	c.entries[key] = e
+	g.pushFront(e)
+	c.touch(g)
+
+	for len(c.entries) > c.capacity {
+		if victim := c.order.prev; victim != g {
+			c.removeGroup(victim, true)
+		} else {
+			// The only group left is over capacity on its own
+			c.evictEntry(g.sentinel.prev)
+		}
+	}
+	return true
+}
+
+// Get returns the value stored for key and refreshes the entry and its group
+// Returns false if the key is not present
+func (c *GroupedLRUCache[G, K, V]) Get(key K) (V, bool) {
+	c.record(key)
+	e, ok := c.entries[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	c.refresh(e)
+	return e.value, true
+}
+
+// refresh makes e the most recently used entry of its group and the group the most recently used one
+func (c *GroupedLRUCache[G, K, V]) refresh(e *groupedEntry[G, K, V]) {
+	g := e.group
+	g.remove(e)
+	g.pushFront(e)
+	c.touch(g)
+}
+
+// Peek returns the value stored for key without changing any recency
+// Returns false if the key is not present
+func (c *GroupedLRUCache[G, K, V]) Peek(key K) (V, bool) {
+	e, ok := c.entries[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return e.value, true
+}
+
+// Contains checks if the cache holds key without changing any recency
+func (c *GroupedLRUCache[G, K, V]) Contains(key K) bool {
+	_, ok := c.entries[key]
+	return ok
+}
+
+// GroupOf returns the group key belongs to
+// Returns false if the key is not present
+func (c *GroupedLRUCache[G, K, V]) GroupOf(key K) (G, bool) {
+	e, ok := c.entries[key]
+	if !ok {
+		var zero G
+		return zero, false
+	}
+	return e.group.name, true
+}
+
+// GroupSize returns the number of entries in group
+func (c *GroupedLRUCache[G, K, V]) GroupSize(group G) int {
+	if g, ok := c.groups[group]; ok {
+		return g.size
+	}
+	return 0
+}
+
+// Remove deletes the entry stored for key without invoking the eviction callback
+// Returns true if the key was found and removed, false otherwise
+func (c *GroupedLRUCache[G, K, V]) Remove(key K) bool {
+	e, ok := c.entries[key]
+	if !ok {
+		return false
+	}
+	c.detach(e)
+	delete(c.entries, key)
+	return true
+}
+
+// InvalidateGroup deletes every entry of group without invoking the eviction callback
+// Returns the number of entries removed
+func (c *GroupedLRUCache[G, K, V]) InvalidateGroup(group G) int {
+	g, ok := c.groups[group]
+	if !ok {
+		return 0
+	}
+	n := g.size
+	c.removeGroup(g, false)
+	return n
+}
+
+// Groups returns the groups from most to least recently used
+func (c *GroupedLRUCache[G, K, V]) Groups() []G {
+	groups := make([]G, 0, len(c.groups))
+	for g := c.order.next; g != &c.order; g = g.next {
+		groups = append(groups, g.name)
+	}
+	return groups
+}
+
+// Keys returns a sequence of the keys of group from most to least recently used
+// The cache must not be changed during iteration
+func (c *GroupedLRUCache[G, K, V]) Keys(group G) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		g, ok := c.groups[group]
+		if !ok {
+			return
+		}
+		for e := g.sentinel.next; e != &g.sentinel; e = e.next {
+			if !yield(e.key) {
+				return
+			}
+		}
+	}
+}
+
+// Clear removes all entries and groups without invoking the eviction callback
+func (c *GroupedLRUCache[G, K, V]) Clear() {
+	c.entries = make(map[K]*groupedEntry[G, K, V], c.capacity)
+	c.groups = make(map[G]*entryGroup[G, K, V])
+	c.order.prev = &c.order
+	c.order.next = &c.order
+}
+
+// record counts a use of key for admission
+func (c *GroupedLRUCache[G, K, V]) record(key K) {
+	if c.admission != nil {
+		c.admission.Increment(c.hash(key))
+	}
+}
+
+// admit checks if a new key for group may be stored
+// Without admission, or while the cache has room, every key is admitted; otherwise key must be
+// estimated more frequent than the hottest entry the insertion would evict
+func (c *GroupedLRUCache[G, K, V]) admit(group G, key K) bool {
+	if c.admission == nil || len(c.entries) < c.capacity {
+		return true
+	}
+	candidate := c.admission.Estimate(c.hash(key))
+	victim := c.order.prev
+	if victim != &c.order && victim.name == group {
+		// The key's group moves to the front, so the next least recently used group is evicted
+		victim = victim.prev
+	}
+	if victim == &c.order {
+		// The key's group is the only one and loses its least recently used entry
+		g := c.groups[group]
+		return candidate > c.admission.Estimate(c.hash(g.sentinel.prev.key))
+	}
+	for e := victim.sentinel.next; e != &victim.sentinel; e = e.next {
+		if c.admission.Estimate(c.hash(e.key)) >= candidate {
+			return false
+		}
+	}
+	return true
+}
+
+// group returns the named group, creating it at the back of the group list if needed
+func (c *GroupedLRUCache[G, K, V]) group(name G) *entryGroup[G, K, V] {
+	g, ok := c.groups[name]
+	if !ok {
+		g = newEntryGroup[G, K, V](name)
+		c.groups[name] = g
+		g.prev = c.order.prev
+		g.next = &c.order
+		c.order.prev.next = g
+		c.order.prev = g
+	}
+	return g
+}
+
+// touch moves g to the front of the group list
+func (c *GroupedLRUCache[G, K, V]) touch(g *entryGroup[G, K, V]) {
+	g.prev.next = g.next
+	g.next.prev = g.prev
+	g.prev = &c.order
+	g.next = c.order.next
+	c.order.next.prev = g
+	c.order.next = g
+}
+
+// detach unlinks e from its group, dropping the group once it is empty
+func (c *GroupedLRUCache[G, K, V]) detach(e *groupedEntry[G, K, V]) {
+	g := e.group
+	g.remove(e)
+	if g.size == 0 {
+		c.unlinkGroup(g)
+	}
+}
+
+func (c *GroupedLRUCache[G, K, V]) unlinkGroup(g *entryGroup[G, K, V]) {
+	g.prev.next = g.next
+	g.next.prev = g.prev
+	g.prev = nil
+	g.next = nil
+	delete(c.groups, g.name)
+}
+
+// removeGroup deletes every entry of g and g itself, reporting each entry to onEvict if evicting
+func (c *GroupedLRUCache[G, K, V]) removeGroup(g *entryGroup[G, K, V], evicting bool) {
+	for e := g.sentinel.prev; e != &g.sentinel; e = e.prev {
+		delete(c.entries, e.key)
+		if evicting && c.onEvict != nil {
+			c.onEvict(g.name, e.key, e.value)
+		}
+	}
+	c.unlinkGroup(g)
+}
+
+func (c *GroupedLRUCache[G, K, V]) evictEntry(e *groupedEntry[G, K, V]) {
+	g := e.group
+	c.detach(e)
+	delete(c.entries, e.key)
+	if c.onEvict != nil {
+		c.onEvict(g.name, e.key, e.value)
+	}
+}
@@ -0,0 +1,121 @@
+package cache
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestGroupedLRUCacheEvictsWholeGroups(t *testing.T) {
+	var evicted []string
+	c := NewGroupedLRUCacheWithEvict(4, func(g, k string, v int) {
+		evicted = append(evicted, g+"/"+k)
+	})
+	c.Put("acme", "a1", 1)
+	c.Put("acme", "a2", 2)
+	c.Put("globex", "g1", 3)
+	c.Put("initech", "i1", 4)
+
+	// Touching acme makes globex the least recently used group
+	c.Get("a1")
+	c.Put("initech", "i2", 5)
+	if !slices.Equal(evicted, []string{"globex/g1"}) {
+		t.Fatalf("unexpected evictions %v", evicted)
+	}
+	if !slices.Equal(c.Groups(), []string{"initech", "acme"}) {
+		t.Fatalf("unexpected group order %v", c.Groups())
+	}
+
+	c.Put("hooli", "h1", 6)
+	if !slices.Equal(evicted, []string{"globex/g1", "acme/a2", "acme/a1"}) {
+		t.Fatalf("expected the whole acme group evicted got %v", evicted)
+	}
+	if c.Size() != 3 || c.GroupSize("acme") != 0 {
+		t.Fatalf("unexpected size %d", c.Size())
+	}
+}
+
+func TestGroupedLRUCacheSingleOversizedGroup(t *testing.T) {
+	c := NewGroupedLRUCache[string, int, int](3)
+	for i := 0; i < 5; i++ {
+		c.Put("only", i, i)
+	}
+	if keys := slices.Collect(c.Keys("only")); !slices.Equal(keys, []int{4, 3, 2}) {
+		t.Fatalf("expected the newest entries to survive got %v", keys)
+	}
+}
+
+func TestGroupedLRUCacheInvalidateAndMove(t *testing.T) {
+	c := NewGroupedLRUCache[string, string, int](10)
+	c.Put("t1", "x", 1)
+	c.Put("t1", "y", 2)
+	c.Put("t2", "z", 3)
+	c.Put("t2", "z", 4)
+	if v, _ := c.Get("z"); v != 4 || c.GroupSize("t2") != 1 {
+		t.Fatalf("expected z updated in place got %d", v)
+	}
+
+	c.Put("t2", "x", 10)
+	if g, _ := c.GroupOf("x"); g != "t2" || c.GroupSize("t1") != 1 {
+		t.Fatalf("expected x moved to t2 got %s", g)
+	}
+	if v, _ := c.Peek("x"); v != 10 {
+		t.Fatalf("expected updated value 10 got %d", v)
+	}
+
+	if n := c.InvalidateGroup("t2"); n != 2 {
+		t.Fatalf("expected 2 entries invalidated got %d", n)
+	}
+	if c.Contains("z") || c.Contains("x") || c.InvalidateGroup("t2") != 0 {
+		t.Fatalf("group t2 should be gone")
+	}
+	if !c.Remove("y") || c.Remove("y") || len(c.Groups()) != 0 {
+		t.Fatalf("removing the last entry should drop its group")
+	}
+	c.Put("t3", "w", 1)
+	c.Clear()
+	if !c.IsEmpty() || len(slices.Collect(c.Keys("t3"))) != 0 || c.Capacity() != 10 {
+		t.Fatalf("expected empty cache after clear")
+	}
+	if _, ok := c.Get("w"); ok {
+		t.Fatalf("unexpected hit after clear")
+	}
+}
+
+func TestGroupedLRUCacheAdmission(t *testing.T) {
+	c := NewGroupedLRUCacheWithAdmission[string, int, int](4, func(k int) uint64 { return uint64(k) })
+	for i := 0; i < 4; i++ {
+		c.Put("hot", i, i)
+		c.Get(i)
+		c.Get(i)
+	}
+
+	// A scan of keys used once is turned away instead of flushing the hot group
+	for i := 100; i < 200; i++ {
+		c.Put("scan", i, i)
+	}
+	if c.Size() != 4 || c.GroupSize("hot") != 4 || c.GroupSize("scan") != 0 {
+		t.Fatalf("expected the hot group kept got groups %v", c.Groups())
+	}
+
+	// A key used more often than the hot entries is admitted and evicts the group
+	for i := 0; i < 5; i++ {
+		c.Get(1000)
+	}
+	c.Put("new", 1000, 1)
+	if !c.Contains(1000) || c.GroupSize("hot") != 0 {
+		t.Fatalf("expected the popular key admitted got groups %v", c.Groups())
+	}
+
+	// Within a single group the candidate is weighed against its least recently used entry
+	single := NewGroupedLRUCacheWithAdmission[string, int, int](2, func(k int) uint64 { return uint64(k) })
+	single.Put("only", 1, 1)
+	single.Put("only", 2, 2)
+	single.Put("only", 3, 3)
+	if single.Contains(3) {
+		t.Fatalf("expected a key seen once rejected")
+	}
+	single.Put("only", 3, 3)
+	if !single.Contains(3) || single.Contains(1) {
+		t.Fatalf("expected the repeated key to replace the least recently used one got %v", slices.Collect(single.Keys("only")))
+	}
+}
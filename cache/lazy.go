@@ -0,0 +1,85 @@
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+// Lazy is a goroutine-safe cell holding a single value computed on first use
+// With a TTL the value is recomputed on the first Get after it expires
+type Lazy[T any] struct {
+	mu       sync.Mutex
+	value    T
+	loaded   bool
+	ttl      time.Duration
+	deadline time.Time
+	now      func() time.Time
+}
+
+// NewLazy creates a new empty cell whose value never expires once loaded
+func NewLazy[T any]() *Lazy[T] {
+	return &Lazy[T]{now: time.Now}
+}
+
+// NewLazyWithTTL creates a new empty cell whose value expires ttl after it was loaded
+// A non-positive ttl means the value never expires
+func NewLazyWithTTL[T any](ttl time.Duration) *Lazy[T] {
+	return &Lazy[T]{ttl: ttl, now: time.Now}
+}
+
+// Get returns the cached value, calling loader to compute it if the cell is empty or expired
+// Concurrent callers wait for a single loader call instead of running their own
+// Returns the loader's error without caching anything, so the next Get retries
+func (l *Lazy[T]) Get(loader func() (T, error)) (T, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.valid() {
+		return l.value, nil
+	}
+
+	v, err := loader()
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	l.value = v
+	l.loaded = true
+	if l.ttl > 0 {
+		l.deadline = l.now().Add(l.ttl)
+	}
+	return v, nil
+}
+
+// Peek returns the cached value without loading it
+// Returns false if the cell is empty or expired
+func (l *Lazy[T]) Peek() (T, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if !l.valid() {
+		var zero T
+		return zero, false
+	}
+	return l.value, true
+}
+
+// IsLoaded checks if the cell currently holds an unexpired value
+func (l *Lazy[T]) IsLoaded() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.valid()
+}
+
+// Reset discards the cached value so the next Get calls its loader again
+func (l *Lazy[T]) Reset() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var zero T
+	l.value = zero
+	l.loaded = false
+}
+
+// valid checks if a loaded value is present and unexpired; the caller holds the lock
+func (l *Lazy[T]) valid() bool {
+	return l.loaded && (l.ttl <= 0 || l.now().Before(l.deadline))
+}
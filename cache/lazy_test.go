@@ -0,0 +1,84 @@
+package cache
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLazyComputesOnce(t *testing.T) {
+	l := NewLazy[int]()
+	var calls atomic.Int32
+	loader := func() (int, error) {
+		calls.Add(1)
+		time.Sleep(time.Millisecond)
+		return 42, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if v, err := l.Get(loader); err != nil || v != 42 {
+				t.Errorf("expected 42 got %d err=%v", v, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if calls.Load() != 1 {
+		t.Fatalf("expected a single loader call got %d", calls.Load())
+	}
+	if v, ok := l.Peek(); !ok || v != 42 {
+		t.Fatalf("expected cached 42 got %d ok=%v", v, ok)
+	}
+}
+
+func TestLazyErrorIsNotCached(t *testing.T) {
+	l := NewLazy[string]()
+	boom := errors.New("boom")
+	if _, err := l.Get(func() (string, error) { return "", boom }); !errors.Is(err, boom) {
+		t.Fatalf("expected loader error got %v", err)
+	}
+	if l.IsLoaded() {
+		t.Fatalf("failed load should leave the cell empty")
+	}
+	if v, _ := l.Get(func() (string, error) { return "ok", nil }); v != "ok" {
+		t.Fatalf("expected retry to load ok got %s", v)
+	}
+}
+
+func TestLazyTTLAndReset(t *testing.T) {
+	clock := newFakeClock()
+	l := NewLazyWithTTL[int](time.Minute)
+	l.now = clock.Now
+
+	n := 0
+	loader := func() (int, error) {
+		n++
+		return n, nil
+	}
+	if v, _ := l.Get(loader); v != 1 {
+		t.Fatalf("expected 1 got %d", v)
+	}
+	clock.Advance(30 * time.Second)
+	if v, _ := l.Get(loader); v != 1 {
+		t.Fatalf("expected cached 1 got %d", v)
+	}
+	clock.Advance(30 * time.Second)
+	if _, ok := l.Peek(); ok {
+		t.Fatalf("value should have expired")
+	}
+	if v, _ := l.Get(loader); v != 2 {
+		t.Fatalf("expected reload to 2 got %d", v)
+	}
+	l.Reset()
+	if l.IsLoaded() {
+		t.Fatalf("reset should empty the cell")
+	}
+	if v, _ := l.Get(loader); v != 3 {
+		t.Fatalf("expected reload to 3 got %d", v)
+	}
+}
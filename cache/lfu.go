@@ -0,0 +1,322 @@
+package cache
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+
+	"github.com/profoundwu/containers/internal/utils"
+	"github.com/profoundwu/containers/metrics"
+)
+
+type lfuEntry[K comparable, V any] struct {
+	key   K
+	value V
+	freq  int
+	prev  *lfuEntry[K, V]
+	next  *lfuEntry[K, V]
+}
+
+// freqList is a circular doubly linked list of entries sharing one access frequency
+// The most recently used entry sits right after the sentinel
+type freqList[K comparable, V any] struct {
+	sentinel lfuEntry[K, V]
+	size     int
+}
+
+func newFreqList[K comparable, V any]() *freqList[K, V] {
+	fl := &freqList[K, V]{}
+	fl.sentinel.prev = &fl.sentinel
+	fl.sentinel.next = &fl.sentinel
+	return fl
+}
+
+func (fl *freqList[K, V]) pushFront(e *lfuEntry[K, V]) {
+	e.prev = &fl.sentinel
+	e.next = fl.sentinel.next
+	fl.sentinel.next.prev = e
+	fl.sentinel.next = e
+	fl.size++
+}
+
+func (fl *freqList[K, V]) remove(e *lfuEntry[K, V]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.prev = nil
+	e.next = nil
+	fl.size--
+}
+
+func (fl *freqList[K, V]) back() *lfuEntry[K, V] {
+	return fl.sentinel.prev
+}
+
+// LFUCache is a fixed-capacity cache that evicts the least frequently used entry
+// Ties between equally frequent entries are broken by evicting the least recently used one
+type LFUCache[K comparable, V any] struct {
+	capacity int
+	entries  map[K]*lfuEntry[K, V]
+	freqs    map[int]*freqList[K, V]
+	minFreq  int
+	onEvict  func(key K, value V)
+	metrics  *metrics.Counters
+}
+
+// NewLFUCache creates a new empty LFU cache holding at most capacity entries
+func NewLFUCache[K comparable, V any](capacity int) *LFUCache[K, V] {
+	if capacity < 1 {
+		capacity = utils.DefaultCapacity
+	}
+	return &LFUCache[K, V]{
+		capacity: capacity,
+		entries:  make(map[K]*lfuEntry[K, V], capacity),
+		freqs:    make(map[int]*freqList[K, V]),
+	}
+}
+
+// NewLFUCacheWithEvict creates a new empty LFU cache that calls onEvict
+// whenever an entry is evicted to make room for a new one
+func NewLFUCacheWithEvict[K comparable, V any](capacity int, onEvict func(key K, value V)) *LFUCache[K, V] {
+	c := NewLFUCache[K, V](capacity)
+	c.onEvict = onEvict
+	return c
+}
+
+// NewLFUCacheWithMetrics creates a new empty LFU cache that counts its lookups, insertions,
+// removals and evictions into m
+func NewLFUCacheWithMetrics[K comparable, V any](capacity int, m *metrics.Counters) *LFUCache[K, V] {
+	c := NewLFUCache[K, V](capacity)
+	c.metrics = m
+	return c
+}
+
+// Metrics returns the operation counts of a cache created with NewLFUCacheWithMetrics
+// Caches created without counters report zero counts
+func (c *LFUCache[K, V]) Metrics() metrics.Metrics {
+	return c.metrics.Snapshot()
+}
+
+// Size returns the number of entries in the cache
+func (c *LFUCache[K, V]) Size() int {
+	return len(c.entries)
+}
+
+// IsEmpty checks if the cache is empty
+func (c *LFUCache[K, V]) IsEmpty() bool {
+	return len(c.entries) == 0
+}
+
+// Capacity returns the maximum number of entries the cache holds
+func (c *LFUCache[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// Get returns the value stored for key and bumps its access frequency
+// Returns false if the key is not present
+func (c *LFUCache[K, V]) Get(key K) (V, bool) {
+	c.metrics.CountLookup()
+	e, ok := c.entries[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	c.touch(e)
+	return e.value, true
+}
+
+// Peek returns the value stored for key without changing its access frequency
+// Returns false if the key is not present
+func (c *LFUCache[K, V]) Peek(key K) (V, bool) {
+	e, ok := c.entries[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return e.value, true
+}
+
+// Contains checks if the cache holds the specified key without changing its access frequency
+func (c *LFUCache[K, V]) Contains(key K) bool {
+	_, ok := c.entries[key]
+	return ok
+}
+
+// FreqOf returns the access frequency of key
+// Returns false if the key is not present
+func (c *LFUCache[K, V]) FreqOf(key K) (int, bool) {
+	e, ok := c.entries[key]
+	if !ok {
+		return 0, false
+	}
+	return e.freq, true
+}
+
+// Put stores value under key, evicting the least frequently used entry when full
+// Updating an existing key counts as an access
+func (c *LFUCache[K, V]) Put(key K, value V) {
+	if e, ok := c.entries[key]; ok {
+		e.value = value
+		c.touch(e)
+		return
+	}
+	c.insert(key, value)
+}
+
+// insert stores a new entry for key, evicting the least frequently used entry when full
+func (c *LFUCache[K, V]) insert(key K, value V) {
+	if len(c.entries) >= c.capacity {
+		c.evict()
+	}
+
+	e := &lfuEntry[K, V]{key: key, value: value, freq: 1}
+	c.entries[key] = e
+	c.bucket(1).pushFront(e)
+	c.minFreq = 1
+	c.metrics.CountAdd()
+}
+
+// Remove deletes the entry stored for key without invoking the eviction callback
+// Returns true if the key was found and removed, false otherwise
+func (c *LFUCache[K, V]) Remove(key K) bool {
+	e, ok := c.entries[key]
+	if !ok {
+		return false
+	}
+	c.remove(e)
+	return true
+}
+
+// remove deletes e from the cache
+func (c *LFUCache[K, V]) remove(e *lfuEntry[K, V]) {
+	c.unlink(e)
+	delete(c.entries, e.key)
+	if _, ok := c.freqs[c.minFreq]; !ok && len(c.freqs) > 0 {
+		c.minFreq = c.lowestFreq()
+	}
+	c.metrics.CountRemove()
+}
+
+// Clear removes all entries from the cache without invoking the eviction callback
+func (c *LFUCache[K, V]) Clear() {
+	c.entries = make(map[K]*lfuEntry[K, V], c.capacity)
+	c.freqs = make(map[int]*freqList[K, V])
+	c.minFreq = 0
+}
+
+// Keys returns a sequence of the keys from least to most frequently used, ties from least to most
+// recently used
+// Only the distinct frequencies are collected up front; the cache must not be changed during iteration
+func (c *LFUCache[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for e := range c.ordered() {
+			if !yield(e.key) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a sequence of the values in the order of Keys
+func (c *LFUCache[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for e := range c.ordered() {
+			if !yield(e.value) {
+				return
+			}
+		}
+	}
+}
+
+// String returns a string representation of the cache
+func (c *LFUCache[K, V]) String() string {
+	var sb strings.Builder
+	sb.WriteString("{")
+
+	i := 0
+	for e := range c.ordered() {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%v: %v (%d)", e.key, e.value, e.freq))
+		i++
+	}
+
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// ordered returns a sequence of the entries from least to most frequently used
+func (c *LFUCache[K, V]) ordered() iter.Seq[*lfuEntry[K, V]] {
+	return func(yield func(*lfuEntry[K, V]) bool) {
+		freqs := make([]int, 0, len(c.freqs))
+		for freq := range c.freqs {
+			freqs = append(freqs, freq)
+		}
+		slices.Sort(freqs)
+
+		for _, freq := range freqs {
+			fl := c.freqs[freq]
+			for e := fl.back(); e != &fl.sentinel; e = e.prev {
+				if !yield(e) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// bucket returns the frequency list for freq, creating it if needed
+func (c *LFUCache[K, V]) bucket(freq int) *freqList[K, V] {
+	fl, ok := c.freqs[freq]
+	if !ok {
+		fl = newFreqList[K, V]()
+		c.freqs[freq] = fl
+	}
+	return fl
+}
+
+// unlink removes e from its frequency list, dropping the list once it is empty
+func (c *LFUCache[K, V]) unlink(e *lfuEntry[K, V]) {
+	fl := c.freqs[e.freq]
+	fl.remove(e)
+	if fl.size == 0 {
+		delete(c.freqs, e.freq)
+		if c.minFreq == e.freq {
+			c.minFreq++
+		}
+	}
+}
+
+// lowestFreq scans the frequency lists for the smallest frequency in use
+func (c *LFUCache[K, V]) lowestFreq() int {
+	lowest := 0
+	for freq := range c.freqs {
+		if lowest == 0 || freq < lowest {
+			lowest = freq
+		}
+	}
+	return lowest
+}
+
+// touch moves e to the next frequency list
+func (c *LFUCache[K, V]) touch(e *lfuEntry[K, V]) {
+	c.unlink(e)
+	e.freq++
+	c.bucket(e.freq).pushFront(e)
+}
+
+// evict removes the least recently used entry with the lowest frequency
+func (c *LFUCache[K, V]) evict() {
+	fl, ok := c.freqs[c.minFreq]
+	if !ok {
+		return
+	}
+	victim := fl.back()
+	c.unlink(victim)
+	c.metrics.CountEviction()
+	delete(c.entries, victim.key)
+	if c.onEvict != nil {
+		c.onEvict(victim.key, victim.value)
+	}
+}
@@ -0,0 +1,138 @@
+package cache
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/profoundwu/containers/metrics"
+)
+
+func TestNewLFUCache(t *testing.T) {
+	c := NewLFUCache[string, int](3)
+	if c.Size() != 0 || !c.IsEmpty() {
+		t.Fatalf("expected empty cache")
+	}
+	if c.Capacity() != 3 {
+		t.Fatalf("expected capacity 3 got %d", c.Capacity())
+	}
+	if NewLFUCache[string, int](0).Capacity() <= 0 {
+		t.Fatalf("expected positive default capacity")
+	}
+}
+
+func TestLFUCacheGetPut(t *testing.T) {
+	c := NewLFUCache[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	v, ok := c.Get("a")
+	if !ok || v != 1 {
+		t.Fatalf("expected 1 got %d ok=%v", v, ok)
+	}
+	if _, ok := c.Get("missing"); ok {
+		t.Fatalf("missing key should not be found")
+	}
+	c.Put("a", 10)
+	v, _ = c.Peek("a")
+	if v != 10 {
+		t.Fatalf("expected updated value 10 got %d", v)
+	}
+	if f, _ := c.FreqOf("a"); f != 3 {
+		t.Fatalf("expected frequency 3 got %d", f)
+	}
+	if _, ok := c.FreqOf("missing"); ok {
+		t.Fatalf("missing key should have no frequency")
+	}
+}
+
+func TestLFUCacheEvictsLeastFrequent(t *testing.T) {
+	var evicted []string
+	c := NewLFUCacheWithEvict(2, func(k string, _ int) {
+		evicted = append(evicted, k)
+	})
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+	c.Put("c", 3) // b has the lowest frequency
+	if c.Contains("b") || !c.Contains("a") || !c.Contains("c") {
+		t.Fatalf("expected b to be evicted, cache=%s", c)
+	}
+	c.Get("c")
+	c.Put("d", 4) // a and c tie at frequency 2, a is least recently used
+	if c.Contains("a") {
+		t.Fatalf("expected a to be evicted, cache=%s", c)
+	}
+	if len(evicted) != 2 || evicted[0] != "b" || evicted[1] != "a" {
+		t.Fatalf("unexpected eviction order %v", evicted)
+	}
+	if c.Size() != 2 {
+		t.Fatalf("expected size 2 got %d", c.Size())
+	}
+}
+
+func TestLFUCacheRemoveKeepsEvictionWorking(t *testing.T) {
+	c := NewLFUCache[int, int](2)
+	c.Put(1, 1)
+	c.Put(2, 2)
+	for i := 0; i < 3; i++ {
+		c.Get(2)
+	}
+	if !c.Remove(1) {
+		t.Fatalf("expected removal of key 1")
+	}
+	if c.Remove(1) {
+		t.Fatalf("should not remove absent key")
+	}
+	c.Put(3, 3)
+	c.Put(4, 4) // 3 is the only entry at frequency 1
+	if c.Contains(3) || !c.Contains(2) || !c.Contains(4) {
+		t.Fatalf("unexpected contents after eviction %s", c)
+	}
+	if c.Size() != 2 {
+		t.Fatalf("expected size 2 got %d", c.Size())
+	}
+}
+
+func TestLFUCacheKeysAndClear(t *testing.T) {
+	c := NewLFUCache[string, int](3)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	c.Get("a")
+	c.Get("a")
+	c.Get("c")
+	keys := slices.Collect(c.Keys())
+	if expected := []string{"b", "c", "a"}; !slices.Equal(keys, expected) {
+		t.Fatalf("keys mismatch got %v want %v", keys, expected)
+	}
+	if values := slices.Collect(c.Values()); !slices.Equal(values, []int{2, 3, 1}) {
+		t.Fatalf("values mismatch got %v", values)
+	}
+	if c.String() != "{b: 2 (1), c: 3 (2), a: 1 (3)}" {
+		t.Fatalf("unexpected string %s", c)
+	}
+	c.Clear()
+	if !c.IsEmpty() || len(slices.Collect(c.Keys())) != 0 {
+		t.Fatalf("expected empty cache after clear")
+	}
+	c.Put("x", 1)
+	if !c.Contains("x") {
+		t.Fatalf("cache should be usable after clear")
+	}
+}
+
+func TestLFUCacheMetrics(t *testing.T) {
+	m := metrics.New()
+	c := NewLFUCacheWithMetrics[string, int](2, m)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+	c.Get("z")
+	c.Put("c", 3)
+	c.Remove("a")
+	c.Remove("missing")
+
+	want := metrics.Metrics{Adds: 3, Removes: 1, Lookups: 2, Evictions: 1}
+	if got := c.Metrics(); got != want {
+		t.Fatalf("expected %+v got %+v", want, got)
+	}
+}
@@ -0,0 +1,147 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+)
+
+// Tier is the in-memory first level of a TieredCache
+// LFUCache and ExpiringCache implement it
+type Tier[K comparable, V any] interface {
+	Get(key K) (V, bool)
+	Put(key K, value V)
+	Remove(key K) bool
+}
+
+var (
+	_ Tier[int, int] = (*LFUCache[int, int])(nil)
+	_ Tier[int, int] = (*ExpiringCache[int, int])(nil)
+)
+
+// Backend is the second level of a TieredCache, typically an adapter over a remote store
+// Load returns false without error when the backend does not hold key
+type Backend[K comparable, V any] interface {
+	Load(ctx context.Context, key K) (V, bool, error)
+	Store(ctx context.Context, key K, value V) error
+	Delete(ctx context.Context, key K) error
+}
+
+// WritePolicy decides how a TieredCache passes writes on to its backend
+type WritePolicy int
+
+const (
+	// WriteThrough stores every write in the backend, then in the first tier
+	WriteThrough WritePolicy = iota
+	// WriteAround stores every write in the backend only and drops the key from the first tier,
+	// so it is cached again on its next read
+	WriteAround
+	// WriteBack keeps writes in the first tier and stores them in the backend on Flush
+	WriteBack
+)
+
+// String returns the name of the policy
+func (p WritePolicy) String() string {
+	switch p {
+	case WriteThrough:
+		return "WriteThrough"
+	case WriteAround:
+		return "WriteAround"
+	case WriteBack:
+		return "WriteBack"
+	default:
+		return fmt.Sprintf("WritePolicy(%d)", int(p))
+	}
+}
+
+// TieredCache composes an in-memory tier over a backend: reads that miss the tier are loaded from
+// the backend and promoted into the tier, and writes follow the configured WritePolicy
+// Under WriteBack, writes not yet flushed are kept aside as well, so an entry the tier evicts is
+// neither lost nor shadowed by an older backend value
+// It is not safe for concurrent use
+type TieredCache[K comparable, V any] struct {
+	tier    Tier[K, V]
+	backend Backend[K, V]
+	policy  WritePolicy
+	// dirty holds the write-back entries not yet stored in the backend
+	dirty map[K]V
+}
+
+// NewTieredCache creates a tiered cache reading through tier to backend and writing with policy
+func NewTieredCache[K comparable, V any](tier Tier[K, V], backend Backend[K, V], policy WritePolicy) *TieredCache[K, V] {
+	return &TieredCache[K, V]{tier: tier, backend: backend, policy: policy, dirty: make(map[K]V)}
+}
+
+// Policy returns the write policy of the cache
+func (c *TieredCache[K, V]) Policy() WritePolicy {
+	return c.policy
+}
+
+// Get returns the value for key, loading it from the backend and promoting it into the tier on a miss
+// Returns false if neither level holds key, and error if the backend fails
+func (c *TieredCache[K, V]) Get(ctx context.Context, key K) (V, bool, error) {
+	if v, ok := c.dirty[key]; ok {
+		return v, true, nil
+	}
+	if v, ok := c.tier.Get(key); ok {
+		return v, true, nil
+	}
+	v, ok, err := c.backend.Load(ctx, key)
+	if err != nil || !ok {
+		var zero V
+		return zero, false, err
+	}
+	c.tier.Put(key, v)
+	return v, true, nil
+}
+
+// Put writes value for key according to the write policy
+// Returns error if the backend fails, in which case the tier is left unchanged
+func (c *TieredCache[K, V]) Put(ctx context.Context, key K, value V) error {
+	switch c.policy {
+	case WriteBack:
+		c.dirty[key] = value
+		c.tier.Put(key, value)
+		return nil
+	case WriteAround:
+		if err := c.backend.Store(ctx, key, value); err != nil {
+			return err
+		}
+		c.tier.Remove(key)
+		return nil
+	default:
+		if err := c.backend.Store(ctx, key, value); err != nil {
+			return err
+		}
+		c.tier.Put(key, value)
+		return nil
+	}
+}
+
+// Remove deletes key from both levels, discarding a write-back value not yet flushed
+// Returns error if the backend fails, in which case the tier is left unchanged
+func (c *TieredCache[K, V]) Remove(ctx context.Context, key K) error {
+	if err := c.backend.Delete(ctx, key); err != nil {
+		return err
+	}
+	delete(c.dirty, key)
+	c.tier.Remove(key)
+	return nil
+}
+
+// Pending returns the number of write-back entries not yet stored in the backend
+func (c *TieredCache[K, V]) Pending() int {
+	return len(c.dirty)
+}
+
+// Flush stores every pending write-back entry in the backend
+// Returns error for the first entry the backend fails to store, keeping it and the entries not yet
+// stored pending
+func (c *TieredCache[K, V]) Flush(ctx context.Context) error {
+	for key, value := range c.dirty {
+		if err := c.backend.Store(ctx, key, value); err != nil {
+			return fmt.Errorf("flush %v: %w", key, err)
+		}
+		delete(c.dirty, key)
+	}
+	return nil
+}
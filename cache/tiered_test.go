@@ -0,0 +1,138 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// mapBackend is an in-memory Backend that counts loads and can be made to fail
+type mapBackend struct {
+	data  map[string]int
+	loads int
+	fail  error
+}
+
+func newMapBackend() *mapBackend {
+	return &mapBackend{data: map[string]int{}}
+}
+
+func (b *mapBackend) Load(_ context.Context, key string) (int, bool, error) {
+	b.loads++
+	if b.fail != nil {
+		return 0, false, b.fail
+	}
+	v, ok := b.data[key]
+	return v, ok, nil
+}
+
+func (b *mapBackend) Store(_ context.Context, key string, value int) error {
+	if b.fail != nil {
+		return b.fail
+	}
+	b.data[key] = value
+	return nil
+}
+
+func (b *mapBackend) Delete(_ context.Context, key string) error {
+	if b.fail != nil {
+		return b.fail
+	}
+	delete(b.data, key)
+	return nil
+}
+
+func TestTieredCacheWriteThrough(t *testing.T) {
+	ctx := context.Background()
+	backend := newMapBackend()
+	backend.data["a"] = 1
+	tier := NewLFUCache[string, int](2)
+	c := NewTieredCache[string, int](tier, backend, WriteThrough)
+
+	// A miss loads from the backend and promotes the value
+	if v, ok, err := c.Get(ctx, "a"); err != nil || !ok || v != 1 {
+		t.Fatalf("expected 1 got %d %v %v", v, ok, err)
+	}
+	c.Get(ctx, "a")
+	if backend.loads != 1 || !tier.Contains("a") {
+		t.Fatalf("expected one load and a promoted entry got %d loads", backend.loads)
+	}
+	if _, ok, err := c.Get(ctx, "missing"); ok || err != nil {
+		t.Fatalf("expected a clean miss got %v %v", ok, err)
+	}
+
+	if err := c.Put(ctx, "b", 2); err != nil || backend.data["b"] != 2 || !tier.Contains("b") {
+		t.Fatalf("expected b written to both levels got %v", err)
+	}
+
+	backend.fail = errors.New("down")
+	if err := c.Put(ctx, "c", 3); !errors.Is(err, backend.fail) || tier.Contains("c") {
+		t.Fatalf("expected a failed write to leave the tier alone got %v", err)
+	}
+	if _, _, err := c.Get(ctx, "c"); !errors.Is(err, backend.fail) {
+		t.Fatalf("expected the backend error got %v", err)
+	}
+	if err := c.Remove(ctx, "a"); !errors.Is(err, backend.fail) || !tier.Contains("a") {
+		t.Fatalf("expected a failed remove to leave the tier alone got %v", err)
+	}
+	backend.fail = nil
+	if err := c.Remove(ctx, "a"); err != nil || tier.Contains("a") || backend.data["a"] != 0 {
+		t.Fatalf("expected a removed from both levels got %v", err)
+	}
+}
+
+func TestTieredCacheWriteAround(t *testing.T) {
+	ctx := context.Background()
+	backend := newMapBackend()
+	tier := NewLFUCache[string, int](2)
+	c := NewTieredCache[string, int](tier, backend, WriteAround)
+	tier.Put("a", 0)
+
+	if err := c.Put(ctx, "a", 1); err != nil || tier.Contains("a") || backend.data["a"] != 1 {
+		t.Fatalf("expected a written around the tier got %v", err)
+	}
+	if v, _, _ := c.Get(ctx, "a"); v != 1 || !tier.Contains("a") {
+		t.Fatalf("expected the next read to cache 1 got %d", v)
+	}
+}
+
+func TestTieredCacheWriteBack(t *testing.T) {
+	ctx := context.Background()
+	backend := newMapBackend()
+	backend.data["a"] = 1
+	tier := NewLFUCache[string, int](1)
+	c := NewTieredCache[string, int](tier, backend, WriteBack)
+	if c.Policy().String() != "WriteBack" {
+		t.Fatalf("expected WriteBack got %s", c.Policy())
+	}
+
+	c.Put(ctx, "a", 10)
+	c.Put(ctx, "b", 20)
+	if c.Pending() != 2 || backend.data["a"] != 1 {
+		t.Fatalf("expected 2 pending writes and an untouched backend got %d", c.Pending())
+	}
+	// a was evicted from the single-entry tier but its pending value still wins over the backend
+	if v, _, _ := c.Get(ctx, "a"); v != 10 || backend.loads != 0 {
+		t.Fatalf("expected pending value 10 got %d after %d loads", v, backend.loads)
+	}
+
+	backend.fail = errors.New("down")
+	if err := c.Flush(ctx); !errors.Is(err, backend.fail) || c.Pending() != 2 {
+		t.Fatalf("expected a failed flush to keep both writes got %v %d", err, c.Pending())
+	}
+	backend.fail = nil
+	if err := c.Flush(ctx); err != nil || c.Pending() != 0 {
+		t.Fatalf("expected a clean flush got %v %d", err, c.Pending())
+	}
+	if backend.data["a"] != 10 || backend.data["b"] != 20 {
+		t.Fatalf("expected flushed values got %v", backend.data)
+	}
+
+	c.Put(ctx, "c", 30)
+	if err := c.Remove(ctx, "c"); err != nil || c.Pending() != 0 {
+		t.Fatalf("expected remove to discard the pending write got %v %d", err, c.Pending())
+	}
+	if _, ok, _ := c.Get(ctx, "c"); ok {
+		t.Fatalf("expected c to be gone")
+	}
+}
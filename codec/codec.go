@@ -0,0 +1,192 @@
+// Package codec streams container elements to and from byte streams one element at a time,
+// so large containers can be persisted without building the whole encoding in memory
+package codec
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+
+	"github.com/profoundwu/containers/pair"
+)
+
+var (
+	ErrUnsupportedType = errors.New("type has no fixed-size binary encoding")
+	ErrElementTooLarge = errors.New("element too large to encode")
+)
+
+// Codec encodes and decodes single elements
+// Decode returns io.EOF when r ends cleanly before an element, and io.ErrUnexpectedEOF when it ends
+// part way through one
+type Codec[T any] interface {
+	Encode(w io.Writer, elem T) error
+	Decode(r io.Reader) (T, error)
+}
+
+type binaryCodec[T any] struct {
+	order binary.ByteOrder
+}
+
+// Binary returns a codec for fixed-size values such as numbers, booleans and arrays or structs of them,
+// written big-endian with encoding/binary
+// Returns error if T has no fixed size
+func Binary[T any]() (Codec[T], error) {
+	var zero T
+	if binary.Size(zero) < 0 {
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedType, zero)
+	}
+	return binaryCodec[T]{order: binary.BigEndian}, nil
+}
+
+func (c binaryCodec[T]) Encode(w io.Writer, elem T) error {
+	return binary.Write(w, c.order, elem)
+}
+
+func (c binaryCodec[T]) Decode(r io.Reader) (T, error) {
+	var elem T
+	err := binary.Read(r, c.order, &elem)
+	return elem, err
+}
+
+type stringCodec struct{}
+
+// String returns a codec writing each string as a 4-byte big-endian length followed by its bytes
+func String() Codec[string] {
+	return stringCodec{}
+}
+
+func (stringCodec) Encode(w io.Writer, elem string) error {
+	return writeFrame(w, []byte(elem))
+}
+
+func (stringCodec) Decode(r io.Reader) (string, error) {
+	buf, err := readFrame(r)
+	return string(buf), err
+}
+
+type funcCodec[T any] struct {
+	encode func(elem T) ([]byte, error)
+	decode func(data []byte) (T, error)
+}
+
+// Func returns a codec that frames the bytes produced by encode with a 4-byte big-endian length,
+// so any per-element encoding such as json.Marshal can be streamed and read back with decode
+func Func[T any](encode func(elem T) ([]byte, error), decode func(data []byte) (T, error)) Codec[T] {
+	return funcCodec[T]{encode: encode, decode: decode}
+}
+
+func (c funcCodec[T]) Encode(w io.Writer, elem T) error {
+	data, err := c.encode(elem)
+	if err != nil {
+		return err
+	}
+	return writeFrame(w, data)
+}
+
+func (c funcCodec[T]) Decode(r io.Reader) (T, error) {
+	data, err := readFrame(r)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return c.decode(data)
+}
+
+type pairCodec[K, V any] struct {
+	key   Codec[K]
+	value Codec[V]
+}
+
+// Pair returns a codec writing each pair as its key encoded with key followed by its value encoded with value
+func Pair[K, V any](key Codec[K], value Codec[V]) Codec[pair.Pair[K, V]] {
+	return pairCodec[K, V]{key: key, value: value}
+}
+
+func (c pairCodec[K, V]) Encode(w io.Writer, elem pair.Pair[K, V]) error {
+	if err := c.key.Encode(w, elem.Key()); err != nil {
+		return err
+	}
+	return c.value.Encode(w, elem.Value())
+}
+
+func (c pairCodec[K, V]) Decode(r io.Reader) (pair.Pair[K, V], error) {
+	key, err := c.key.Decode(r)
+	if err != nil {
+		return pair.Pair[K, V]{}, err
+	}
+	value, err := c.value.Decode(r)
+	if errors.Is(err, io.EOF) {
+		err = io.ErrUnexpectedEOF
+	}
+	return pair.New(key, value), err
+}
+
+// writeFrame writes data preceded by its length as 4 big-endian bytes
+func writeFrame(w io.Writer, data []byte) error {
+	if uint64(len(data)) > 1<<32-1 {
+		return fmt.Errorf("%w: %d bytes", ErrElementTooLarge, len(data))
+	}
+	var header [4]byte
+	binary.BigEndian.PutUint32(header[:], uint32(len(data)))
+	if _, err := w.Write(header[:]); err != nil {
+		return err
+	}
+	_, err := w.Write(data)
+	return err
+}
+
+// frameChunk bounds the buffer reserved for a frame before its bytes arrive
+const frameChunk = 64 << 10
+
+// readFrame reads one frame written by writeFrame
+func readFrame(r io.Reader) ([]byte, error) {
+	var header [4]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, err
+	}
+	// Grow with the bytes actually read, so a corrupt length cannot force a huge allocation up front
+	n := int64(binary.BigEndian.Uint32(header[:]))
+	var buf bytes.Buffer
+	buf.Grow(int(min(n, frameChunk)))
+	if _, err := io.CopyN(&buf, r, n); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// WriteSeq encodes every element of seq to w in order
+// Returns error naming the position of the first element that failed
+func WriteSeq[T any](w io.Writer, seq iter.Seq[T], c Codec[T]) error {
+	i := 0
+	for elem := range seq {
+		if err := c.Encode(w, elem); err != nil {
+			return fmt.Errorf("element %d: %w", i, err)
+		}
+		i++
+	}
+	return nil
+}
+
+// ReadSeq decodes elements from r until it ends, passing each one to add
+// Reading stops at the first error returned by add
+// Returns the number of elements added, and error naming the position of the first element that failed
+func ReadSeq[T any](r io.Reader, c Codec[T], add func(elem T) error) (int, error) {
+	for i := 0; ; i++ {
+		elem, err := c.Decode(r)
+		if errors.Is(err, io.EOF) {
+			return i, nil
+		}
+		if err == nil {
+			err = add(elem)
+		}
+		if err != nil {
+			return i, fmt.Errorf("element %d: %w", i, err)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package codec
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"runtime"
+	"slices"
+	"testing"
+
+	"github.com/profoundwu/containers/pair"
+)
+
+func roundTrip[T any](t *testing.T, c Codec[T], elems []T) []T {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := WriteSeq(&buf, slices.Values(elems), c); err != nil {
+		t.Fatalf("expected no error got %v", err)
+	}
+	var got []T
+	n, err := ReadSeq(&buf, c, func(elem T) error {
+		got = append(got, elem)
+		return nil
+	})
+	if err != nil || n != len(elems) {
+		t.Fatalf("expected %d elements got %d %v", len(elems), n, err)
+	}
+	return got
+}
+
+func TestBinaryCodec(t *testing.T) {
+	c, err := Binary[int64]()
+	if err != nil {
+		t.Fatalf("expected no error got %v", err)
+	}
+	if got := roundTrip(t, c, []int64{1, -2, 1 << 40}); !slices.Equal(got, []int64{1, -2, 1 << 40}) {
+		t.Fatalf("unexpected round trip %v", got)
+	}
+
+	type point struct{ X, Y float64 }
+	pc, _ := Binary[point]()
+	if got := roundTrip(t, pc, []point{{1, 2}, {3.5, -4}}); !slices.Equal(got, []point{{1, 2}, {3.5, -4}}) {
+		t.Fatalf("unexpected round trip %v", got)
+	}
+
+	if _, err := Binary[int](); !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("expected ErrUnsupportedType got %v", err)
+	}
+}
+
+func TestStringCodec(t *testing.T) {
+	elems := []string{"hello", "", "wörld"}
+	if got := roundTrip(t, String(), elems); !slices.Equal(got, elems) {
+		t.Fatalf("unexpected round trip %q", got)
+	}
+
+	var buf bytes.Buffer
+	String().Encode(&buf, "truncated")
+	data := buf.Bytes()[:buf.Len()-2]
+	n, err := ReadSeq(bytes.NewReader(data), String(), func(string) error { return nil })
+	if n != 0 || !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("expected ErrUnexpectedEOF got %d %v", n, err)
+	}
+}
+
+func TestCorruptFrameLength(t *testing.T) {
+	// A header claiming 4 GiB in front of three bytes must not allocate for the claimed length
+	data := append([]byte{0xff, 0xff, 0xff, 0xff}, "abc"...)
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	_, err := String().Decode(bytes.NewReader(data))
+	runtime.ReadMemStats(&after)
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("expected ErrUnexpectedEOF got %v", err)
+	}
+	if allocated := after.TotalAlloc - before.TotalAlloc; allocated > 1<<20 {
+		t.Fatalf("expected a small allocation got %d bytes", allocated)
+	}
+}
+
+func TestFuncCodec(t *testing.T) {
+	type point struct{ X, Y int }
+	c := Func(
+		func(p point) ([]byte, error) { return json.Marshal(p) },
+		func(data []byte) (point, error) {
+			var p point
+			err := json.Unmarshal(data, &p)
+			return p, err
+		},
+	)
+	elems := []point{{1, 2}, {-3, 40}}
+	if got := roundTrip(t, c, elems); !slices.Equal(got, elems) {
+		t.Fatalf("unexpected round trip %v", got)
+	}
+
+	failing := Func(func(int) ([]byte, error) { return nil, ErrUnsupportedType }, nil)
+	if err := WriteSeq(io.Discard, slices.Values([]int{1}), failing); !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("expected ErrUnsupportedType got %v", err)
+	}
+}
+
+func TestPairCodec(t *testing.T) {
+	ints, _ := Binary[int32]()
+	c := Pair(String(), ints)
+	elems := []pair.Pair[string, int32]{pair.New("a", int32(1)), pair.New("bc", int32(-2))}
+	if got := roundTrip(t, c, elems); !slices.Equal(got, elems) {
+		t.Fatalf("unexpected round trip %v", got)
+	}
+
+	var buf bytes.Buffer
+	c.Encode(&buf, elems[0])
+	data := buf.Bytes()[:buf.Len()-4]
+	n, err := ReadSeq(bytes.NewReader(data), c, func(pair.Pair[string, int32]) error { return nil })
+	if n != 0 || !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("expected ErrUnexpectedEOF got %d %v", n, err)
+	}
+}
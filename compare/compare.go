@@ -0,0 +1,52 @@
+// Package compare provides the comparator type shared by the ordered containers of this module
+package compare
+
+import "cmp"
+
+// Comparator orders two values, returning a negative number when a sorts before b,
+// a positive number when it sorts after b and zero when they are equivalent
+// Plain functions such as strings.Compare convert to it without a cast
+type Comparator[T any] func(a, b T) int
+
+// Natural returns the comparator for the natural order of T
+// NaN sorts before every other float like in cmp.Compare
+func Natural[T cmp.Ordered]() Comparator[T] {
+	return cmp.Compare[T]
+}
+
+// Reverse returns a comparator that orders values opposite to c
+func Reverse[T any](c Comparator[T]) Comparator[T] {
+	return func(a, b T) int {
+		return c(b, a)
+	}
+}
+
+// ByKey returns a comparator that orders values by the keys key extracts from them, compared with c
+func ByKey[T any, K any](key func(T) K, c Comparator[K]) Comparator[T] {
+	return func(a, b T) int {
+		return c(key(a), key(b))
+	}
+}
+
+// Chain returns a comparator that tries each of cs in turn until one tells the values apart
+// Chaining no comparators considers all values equivalent
+func Chain[T any](cs ...Comparator[T]) Comparator[T] {
+	return func(a, b T) int {
+		for _, c := range cs {
+			if r := c(a, b); r != 0 {
+				return r
+			}
+		}
+		return 0
+	}
+}
+
+// Then returns a comparator that orders by c and breaks ties with next
+func (c Comparator[T]) Then(next Comparator[T]) Comparator[T] {
+	return Chain(c, next)
+}
+
+// Less reports whether a sorts before b, for APIs that take a less function
+func (c Comparator[T]) Less(a, b T) bool {
+	return c(a, b) < 0
+}
@@ -0,0 +1,42 @@
+package compare
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+type person struct {
+	name string
+	age  int
+}
+
+func TestComparators(t *testing.T) {
+	ints := []int{3, 1, 2}
+	slices.SortFunc(ints, Reverse(Natural[int]()))
+	if !slices.Equal(ints, []int{3, 2, 1}) {
+		t.Fatalf("expected [3 2 1] got %v", ints)
+	}
+
+	people := []person{{"bo", 30}, {"al", 30}, {"cy", 20}}
+	byAge := ByKey(func(p person) int { return p.age }, Natural[int]())
+	byName := ByKey(func(p person) string { return p.name }, strings.Compare)
+	slices.SortFunc(people, byAge.Then(byName))
+	want := []person{{"cy", 20}, {"al", 30}, {"bo", 30}}
+	if !slices.Equal(people, want) {
+		t.Fatalf("expected %v got %v", want, people)
+	}
+
+	slices.SortFunc(people, Chain(Reverse(byAge), Reverse(byName)))
+	want = []person{{"bo", 30}, {"al", 30}, {"cy", 20}}
+	if !slices.Equal(people, want) {
+		t.Fatalf("expected %v got %v", want, people)
+	}
+
+	if Chain[int]()(1, 2) != 0 {
+		t.Fatalf("expected an empty chain to consider values equivalent")
+	}
+	if !Natural[string]().Less("a", "b") || Natural[string]().Less("b", "a") {
+		t.Fatalf("unexpected Less results")
+	}
+}
@@ -0,0 +1,154 @@
+package concurrent
+
+import (
+	"math/rand"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// cacheLineSize is the assumed cache line width used to keep stripes from false sharing
+const cacheLineSize = 64
+
+type stripe struct {
+	value atomic.Int64
+	_     [cacheLineSize - 8]byte
+}
+
+// StripedCounter is a contention-resistant int64 counter
+// Writers spread their updates over independent cells and readers sum the cells, so Add
+// scales with the number of cores while Sum costs one pass over the stripes
+type StripedCounter struct {
+	stripes []stripe
+	mask    uint32
+}
+
+// NewStripedCounter creates a counter with one stripe per available CPU
+func NewStripedCounter() *StripedCounter {
+	return NewStripedCounterWithStripes(runtime.GOMAXPROCS(0))
+}
+
+// NewStripedCounterWithStripes creates a counter with at least n stripes, rounded up to a power of two
+// Values of n below 1 fall back to a single stripe
+func NewStripedCounterWithStripes(n int) *StripedCounter {
+	size := 1
+	for size < n {
+		size <<= 1
+	}
+	return &StripedCounter{stripes: make([]stripe, size), mask: uint32(size - 1)}
+}
+
+// Add adds delta to the counter
+func (c *StripedCounter) Add(delta int64) {
+	c.stripes[rand.Uint32()&c.mask].value.Add(delta)
+}
+
+// Inc adds one to the counter
+func (c *StripedCounter) Inc() {
+	c.Add(1)
+}
+
+// Dec subtracts one from the counter
+func (c *StripedCounter) Dec() {
+	c.Add(-1)
+}
+
+// Sum returns the current total
+// Concurrent updates may or may not be included, so the result is exact only while the counter is quiescent
+func (c *StripedCounter) Sum() int64 {
+	var sum int64
+	for i := range c.stripes {
+		sum += c.stripes[i].value.Load()
+	}
+	return sum
+}
+
+// Reset sets every stripe back to zero
+func (c *StripedCounter) Reset() {
+	for i := range c.stripes {
+		c.stripes[i].value.Store(0)
+	}
+}
+
+// SumAndReset returns the total and zeroes the counter, without losing concurrent updates
+func (c *StripedCounter) SumAndReset() int64 {
+	var sum int64
+	for i := range c.stripes {
+		sum += c.stripes[i].value.Swap(0)
+	}
+	return sum
+}
+
+// CounterMap is a set of named striped counters created on first use
+type CounterMap[K comparable] struct {
+	mu       sync.RWMutex
+	counters map[K]*StripedCounter
+	stripes  int
+}
+
+// NewCounterMap creates a new empty counter map whose counters have one stripe per available CPU
+func NewCounterMap[K comparable]() *CounterMap[K] {
+	return &CounterMap[K]{
+		counters: make(map[K]*StripedCounter),
+		stripes:  runtime.GOMAXPROCS(0),
+	}
+}
+
+// Counter returns the counter for key, creating it if needed
+func (m *CounterMap[K]) Counter(key K) *StripedCounter {
+	m.mu.RLock()
+	c, ok := m.counters[key]
+	m.mu.RUnlock()
+	if ok {
+		return c
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if c, ok = m.counters[key]; !ok {
+		c = NewStripedCounterWithStripes(m.stripes)
+		m.counters[key] = c
+	}
+	return c
+}
+
+// Add adds delta to the counter for key
+func (m *CounterMap[K]) Add(key K, delta int64) {
+	m.Counter(key).Add(delta)
+}
+
+// Get returns the current total for key, or zero if the key has no counter
+func (m *CounterMap[K]) Get(key K) int64 {
+	m.mu.RLock()
+	c, ok := m.counters[key]
+	m.mu.RUnlock()
+	if !ok {
+		return 0
+	}
+	return c.Sum()
+}
+
+// Delete removes the counter for key
+func (m *CounterMap[K]) Delete(key K) {
+	m.mu.Lock()
+	delete(m.counters, key)
+	m.mu.Unlock()
+}
+
+// Size returns the number of counters in the map
+func (m *CounterMap[K]) Size() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.counters)
+}
+
+// Snapshot returns the current total of every counter
+func (m *CounterMap[K]) Snapshot() map[K]int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	snapshot := make(map[K]int64, len(m.counters))
+	for k, c := range m.counters {
+		snapshot[k] = c.Sum()
+	}
+	return snapshot
+}
@@ -0,0 +1,85 @@
+package concurrent
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestStripedCounter(t *testing.T) {
+	c := NewStripedCounterWithStripes(3)
+	if len(c.stripes) != 4 {
+		t.Fatalf("expected 4 stripes got %d", len(c.stripes))
+	}
+	c.Inc()
+	c.Add(10)
+	c.Dec()
+	if c.Sum() != 10 {
+		t.Fatalf("expected 10 got %d", c.Sum())
+	}
+	if c.SumAndReset() != 10 || c.Sum() != 0 {
+		t.Fatalf("expected SumAndReset to drain the counter")
+	}
+	c.Add(5)
+	c.Reset()
+	if c.Sum() != 0 {
+		t.Fatalf("expected 0 after reset got %d", c.Sum())
+	}
+	if len(NewStripedCounterWithStripes(0).stripes) != 1 {
+		t.Fatalf("expected a single stripe fallback")
+	}
+}
+
+func TestStripedCounterConcurrent(t *testing.T) {
+	c := NewStripedCounter()
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				c.Inc()
+			}
+		}()
+	}
+	wg.Wait()
+	if c.Sum() != 8000 {
+		t.Fatalf("expected 8000 got %d", c.Sum())
+	}
+}
+
+func TestCounterMap(t *testing.T) {
+	m := NewCounterMap[string]()
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 500; i++ {
+				m.Add("hits", 1)
+				m.Counter("misses").Add(2)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if m.Get("hits") != 2000 || m.Get("misses") != 4000 || m.Get("absent") != 0 {
+		t.Fatalf("unexpected totals %v", m.Snapshot())
+	}
+	if m.Size() != 2 {
+		t.Fatalf("expected 2 counters got %d", m.Size())
+	}
+	m.Delete("hits")
+	snapshot := m.Snapshot()
+	if len(snapshot) != 1 || snapshot["misses"] != 4000 {
+		t.Fatalf("unexpected snapshot %v", snapshot)
+	}
+}
+
+func BenchmarkStripedCounterParallel(b *testing.B) {
+	c := NewStripedCounter()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			c.Inc()
+		}
+	})
+}
@@ -0,0 +1,268 @@
+package concurrent
+
+import (
+	"iter"
+	"sync"
+
+	"github.com/profoundwu/containers/internal/utils"
+	"github.com/profoundwu/containers/list"
+)
+
+// List makes any list safe for use by multiple goroutines
+// Writes take the lock exclusively; reads share a read lock when the wrapped list is an ArrayList or
+// a CopyOnWriteArrayList and otherwise take it exclusively too, since reads of other lists may move
+// internal state such as a LinkedList cursor
+// Each method is atomic on its own, so check-then-act sequences such as Contains followed by AddLast
+// belong in Do
+type List[T any] struct {
+	mu   sync.RWMutex
+	list list.List[T]
+	// sharedReads is set when the wrapped list is known to only read on reads
+	sharedReads bool
+}
+
+var _ list.List[int] = (*List[int])(nil)
+
+// NewList wraps l, which must not be used directly afterwards
+func NewList[T any](l list.List[T]) *List[T] {
+	var sharedReads bool
+	switch l.(type) {
+	case *list.ArrayList[T], *list.CopyOnWriteArrayList[T]:
+		sharedReads = true
+	}
+	return &List[T]{list: l, sharedReads: sharedReads}
+}
+
+// rlock locks for a read, shared only when reads of the wrapped list do not write
+func (c *List[T]) rlock() {
+	if c.sharedReads {
+		c.mu.RLock()
+	} else {
+		c.mu.Lock()
+	}
+}
+
+func (c *List[T]) runlock() {
+	if c.sharedReads {
+		c.mu.RUnlock()
+	} else {
+		c.mu.Unlock()
+	}
+}
+
+// Do runs fn with exclusive access to the wrapped list, so several operations happen as one
+// fn must not retain the list or call methods of this wrapper
+func (c *List[T]) Do(fn func(l list.List[T])) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	fn(c.list)
+}
+
+// Size returns the number of elements in the list
+func (c *List[T]) Size() int {
+	c.rlock()
+	defer c.runlock()
+	return c.list.Size()
+}
+
+// IsEmpty checks if the list is empty
+func (c *List[T]) IsEmpty() bool {
+	c.rlock()
+	defer c.runlock()
+	return c.list.IsEmpty()
+}
+
+// AddLast adds an element at the end of the list
+func (c *List[T]) AddLast(elem T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.list.AddLast(elem)
+}
+
+// Add inserts an element at the specified index position
+// Returns error if index is out of bounds
+func (c *List[T]) Add(index int, elem T) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.list.Add(index, elem)
+}
+
+// Get returns the element at the specified index position
+// Returns error if index is out of bounds
+func (c *List[T]) Get(index int) (T, error) {
+	c.rlock()
+	defer c.runlock()
+	return c.list.Get(index)
+}
+
+// GetFirst returns the first element of the list
+// Returns error if list is empty
+func (c *List[T]) GetFirst() (T, error) {
+	c.rlock()
+	defer c.runlock()
+	return c.list.GetFirst()
+}
+
+// GetLast returns the last element of the list
+// Returns error if list is empty
+func (c *List[T]) GetLast() (T, error) {
+	c.rlock()
+	defer c.runlock()
+	return c.list.GetLast()
+}
+
+// MustGet returns the element at the specified index position, panicking if it is out of bounds
+func (c *List[T]) MustGet(index int) T {
+	v, err := c.Get(index)
+	return utils.Must("MustGet", v, err)
+}
+
+// MustGetFirst returns the first element of the list, panicking if it is empty
+func (c *List[T]) MustGetFirst() T {
+	v, err := c.GetFirst()
+	return utils.Must("MustGetFirst", v, err)
+}
+
+// MustGetLast returns the last element of the list, panicking if it is empty
+func (c *List[T]) MustGetLast() T {
+	v, err := c.GetLast()
+	return utils.Must("MustGetLast", v, err)
+}
+
+// MustRemove deletes and returns the element at the specified index position, panicking if it is out of bounds
+func (c *List[T]) MustRemove(index int) T {
+	v, err := c.Remove(index)
+	return utils.Must("MustRemove", v, err)
+}
+
+// MustRemoveFirst deletes and returns the first element of the list, panicking if it is empty
+func (c *List[T]) MustRemoveFirst() T {
+	v, err := c.RemoveFirst()
+	return utils.Must("MustRemoveFirst", v, err)
+}
+
+// MustRemoveLast deletes and returns the last element of the list, panicking if it is empty
+func (c *List[T]) MustRemoveLast() T {
+	v, err := c.RemoveLast()
+	return utils.Must("MustRemoveLast", v, err)
+}
+
+// Set replaces the element at the specified index position
+// Returns error if index is out of bounds
+func (c *List[T]) Set(index int, elem T) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.list.Set(index, elem)
+}
+
+// Remove deletes and returns the element at the specified index position
+// Returns error if index is out of bounds
+func (c *List[T]) Remove(index int) (T, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.list.Remove(index)
+}
+
+// RemoveFirst deletes and returns the first element of the list
+// Returns error if list is empty
+func (c *List[T]) RemoveFirst() (T, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.list.RemoveFirst()
+}
+
+// RemoveLast deletes and returns the last element of the list
+// Returns error if list is empty
+func (c *List[T]) RemoveLast() (T, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.list.RemoveLast()
+}
+
+// RemoveElement deletes the first occurrence of the specified element
+// Returns true if element was found and removed, false otherwise
+func (c *List[T]) RemoveElement(elem T) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.list.RemoveElement(elem)
+}
+
+// Contains checks if the list contains the specified element
+func (c *List[T]) Contains(elem T) bool {
+	c.rlock()
+	defer c.runlock()
+	return c.list.Contains(elem)
+}
+
+// IndexOf returns the first index of the specified element
+// Returns -1 if element is not found
+func (c *List[T]) IndexOf(elem T) int {
+	c.rlock()
+	defer c.runlock()
+	return c.list.IndexOf(elem)
+}
+
+// Clear removes all elements from the list
+func (c *List[T]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.list.Clear()
+}
+
+// ToSlice returns a copy of the elements
+func (c *List[T]) ToSlice() []T {
+	c.rlock()
+	defer c.runlock()
+	return c.list.ToSlice()
+}
+
+// AppendTo appends the elements to dst and returns the extended slice
+func (c *List[T]) AppendTo(dst []T) []T {
+	c.rlock()
+	defer c.runlock()
+	return c.list.AppendTo(dst)
+}
+
+// Values returns a sequence over a snapshot of the elements taken when iteration starts,
+// so the loop body may use the list freely
+func (c *List[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range c.ToSlice() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// All returns a sequence of index-element pairs over a snapshot taken when iteration starts
+func (c *List[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, v := range c.ToSlice() {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+// Reverse reverses the list in place
+func (c *List[T]) Reverse() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.list.Reverse()
+}
+
+// Join renders the elements separated by sep
+func (c *List[T]) Join(sep string) string {
+	c.rlock()
+	defer c.runlock()
+	return c.list.Join(sep)
+}
+
+// String returns a string representation of the list
+func (c *List[T]) String() string {
+	c.rlock()
+	defer c.runlock()
+	return c.list.String()
+}
@@ -0,0 +1,96 @@
+package concurrent
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/profoundwu/containers/list"
+)
+
+func TestList(t *testing.T) {
+	// Reads of a linked sublist move the cursor of the list below it
+	sub, err := list.NewLinkedListFromSlice([]int{-2, -3}).SubList(1, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for name, l := range map[string]*List[int]{
+		"array":          NewList[int](list.NewArrayList[int]()),
+		"copy on write":  NewList[int](list.NewCopyOnWriteArrayList[int]()),
+		"linked":         NewList[int](list.NewLinkedList[int]()),
+		"linked sublist": NewList[int](sub),
+	} {
+		t.Run(name, func(t *testing.T) {
+			var wg sync.WaitGroup
+			for g := 0; g < 4; g++ {
+				wg.Add(2)
+				go func() {
+					defer wg.Done()
+					for i := 0; i < 250; i++ {
+						l.AddLast(i)
+					}
+				}()
+				go func() {
+					defer wg.Done()
+					for i := 0; i < 250; i++ {
+						if n := l.Size(); n > 0 {
+							l.Get(n / 2)
+						}
+						l.Contains(i)
+						l.IndexOf(i)
+						l.GetLast()
+						l.ToSlice()
+					}
+				}()
+			}
+			wg.Wait()
+			if l.Size() != 1000 {
+				t.Fatalf("expected 1000 elements got %d", l.Size())
+			}
+
+			added := 0
+			for g := 0; g < 8; g++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					l.Do(func(inner list.List[int]) {
+						if !inner.Contains(-1) {
+							inner.AddLast(-1)
+							added++
+						}
+					})
+				}()
+			}
+			wg.Wait()
+			if added != 1 {
+				t.Fatalf("expected Do to make check-then-act atomic, added %d times", added)
+			}
+
+			l.Clear()
+			l.AddLast(1)
+			l.Add(0, 0)
+			for v := range l.Values() {
+				// Iteration works on a snapshot, so the list can change underneath
+				l.AddLast(v + 10)
+			}
+			if got := l.ToSlice(); !slices.Equal(got, []int{0, 1, 10, 11}) {
+				t.Fatalf("expected [0 1 10 11] got %v", got)
+			}
+		})
+	}
+}
+
+func TestListMust(t *testing.T) {
+	c := NewList[int](list.NewArrayListFromSlice([]int{1, 2, 3}))
+	if c.MustGet(1) != 2 || c.MustGetFirst() != 1 || c.MustRemoveLast() != 3 || c.MustRemove(0) != 1 {
+		t.Fatalf("unexpected Must results")
+	}
+	defer func() {
+		if err, _ := recover().(error); !errors.Is(err, list.ErrEmptyList) {
+			t.Fatalf("expected panic with ErrEmptyList got %v", err)
+		}
+	}()
+	c.MustRemoveFirst()
+	c.MustGetLast()
+}
@@ -0,0 +1,144 @@
+// Package containermatch provides test assertions over the containers of this module that report
+// what differs instead of a bare reflect.DeepEqual failure
+package containermatch
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// Slicer is implemented by every container that can list its elements in order
+type Slicer[T any] interface {
+	ToSlice() []T
+}
+
+// Equal asserts that got holds exactly expected, in order
+// Returns true if the assertion held
+func Equal[T comparable](t testing.TB, got Slicer[T], expected ...T) bool {
+	t.Helper()
+	return report(t, equalDiff(got.ToSlice(), expected))
+}
+
+// ElementsMatch asserts that got holds the same elements as expected with the same multiplicity,
+// in any order
+// Returns true if the assertion held
+func ElementsMatch[T comparable](t testing.TB, got Slicer[T], expected ...T) bool {
+	t.Helper()
+	return report(t, elementsDiff(got.ToSlice(), expected))
+}
+
+// ContainsInOrder asserts that expected appears in got as a subsequence, allowing other elements between
+// Returns true if the assertion held
+func ContainsInOrder[T comparable](t testing.TB, got Slicer[T], expected ...T) bool {
+	t.Helper()
+	return report(t, inOrderDiff(got.ToSlice(), expected))
+}
+
+// SetEqual asserts that got and expected hold the same distinct elements, ignoring order and duplicates
+// Returns true if the assertion held
+func SetEqual[T comparable](t testing.TB, got Slicer[T], expected ...T) bool {
+	t.Helper()
+	return report(t, setDiff(got.ToSlice(), expected))
+}
+
+func report(t testing.TB, diff string) bool {
+	t.Helper()
+	if diff == "" {
+		return true
+	}
+	t.Errorf("%s", diff)
+	return false
+}
+
+func equalDiff[T comparable](got, expected []T) string {
+	for i := 0; i < min(len(got), len(expected)); i++ {
+		if got[i] != expected[i] {
+			return fmt.Sprintf("elements differ at index %d: got %v, expected %v\ngot:      %v\nexpected: %v",
+				i, got[i], expected[i], got, expected)
+		}
+	}
+	if len(got) != len(expected) {
+		return fmt.Sprintf("length differs: got %d, expected %d\ngot:      %v\nexpected: %v",
+			len(got), len(expected), got, expected)
+	}
+	return ""
+}
+
+func elementsDiff[T comparable](got, expected []T) string {
+	counts := make(map[T]int, len(expected))
+	for _, v := range expected {
+		counts[v]++
+	}
+	var extra []T
+	for _, v := range got {
+		if counts[v] > 0 {
+			counts[v]--
+		} else {
+			extra = append(extra, v)
+		}
+	}
+	var missing []T
+	for _, v := range expected {
+		if counts[v] > 0 {
+			counts[v]--
+			missing = append(missing, v)
+		}
+	}
+	return describeMismatch(extra, missing, got, expected)
+}
+
+func inOrderDiff[T comparable](got, expected []T) string {
+	next := 0
+	for _, v := range got {
+		if next < len(expected) && v == expected[next] {
+			next++
+		}
+	}
+	if next == len(expected) {
+		return ""
+	}
+	return fmt.Sprintf("expected %v in order but only matched the first %d (next %v)\ngot:      %v\nexpected: %v",
+		expected, next, expected[next], got, expected)
+}
+
+func setDiff[T comparable](got, expected []T) string {
+	want := make(map[T]bool, len(expected))
+	for _, v := range expected {
+		want[v] = true
+	}
+	have := make(map[T]bool, len(got))
+	for _, v := range got {
+		have[v] = true
+	}
+	var extra, missing []T
+	for _, v := range got {
+		if !want[v] {
+			extra = append(extra, v)
+			want[v] = true
+		}
+	}
+	for _, v := range expected {
+		if !have[v] {
+			missing = append(missing, v)
+			have[v] = true
+		}
+	}
+	return describeMismatch(extra, missing, got, expected)
+}
+
+func describeMismatch[T any](extra, missing, got, expected []T) string {
+	if len(extra) == 0 && len(missing) == 0 {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString("elements do not match")
+	if len(extra) > 0 {
+		sb.WriteString(fmt.Sprintf("\nunexpected: %v", extra))
+	}
+	if len(missing) > 0 {
+		sb.WriteString(fmt.Sprintf("\nmissing:    %v", missing))
+	}
+	sb.WriteString(fmt.Sprintf("\ngot:        %v\nexpected:   %v", got, expected))
+	return sb.String()
+}
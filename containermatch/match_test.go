@@ -0,0 +1,75 @@
+package containermatch
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/profoundwu/containers/list"
+)
+
+// recorder captures failures instead of failing the surrounding test
+type recorder struct {
+	testing.TB
+	messages []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.messages = append(r.messages, fmt.Sprintf(format, args...))
+}
+
+func newList(values ...int) *list.ArrayList[int] {
+	return list.NewArrayListFromSlice(values)
+}
+
+func TestEqual(t *testing.T) {
+	r := &recorder{TB: t}
+	if !Equal[int](r, newList(1, 2, 3), 1, 2, 3) {
+		t.Fatalf("expected equal lists to match: %v", r.messages)
+	}
+	if Equal[int](r, newList(1, 5, 3), 1, 2, 3) || !strings.Contains(r.messages[0], "index 1: got 5, expected 2") {
+		t.Fatalf("unexpected message %v", r.messages)
+	}
+	if Equal[int](r, newList(1, 2), 1, 2, 3) || !strings.Contains(r.messages[1], "length differs: got 2, expected 3") {
+		t.Fatalf("unexpected message %v", r.messages)
+	}
+}
+
+func TestElementsMatch(t *testing.T) {
+	r := &recorder{TB: t}
+	ll := list.NewLinkedList[int]()
+	for _, v := range []int{3, 1, 2, 1} {
+		ll.AddLast(v)
+	}
+	if !ElementsMatch[int](r, ll, 1, 1, 2, 3) {
+		t.Fatalf("expected permutation to match: %v", r.messages)
+	}
+	if ElementsMatch[int](r, ll, 1, 2, 3, 4) {
+		t.Fatalf("expected mismatch")
+	}
+	if !strings.Contains(r.messages[0], "unexpected: [1]") || !strings.Contains(r.messages[0], "missing:    [4]") {
+		t.Fatalf("unexpected message %v", r.messages)
+	}
+}
+
+func TestContainsInOrder(t *testing.T) {
+	r := &recorder{TB: t}
+	if !ContainsInOrder[int](r, newList(1, 2, 3, 4, 5), 2, 4) {
+		t.Fatalf("expected subsequence to match: %v", r.messages)
+	}
+	if ContainsInOrder[int](r, newList(1, 2, 3, 4, 5), 4, 2) || !strings.Contains(r.messages[0], "first 1 (next 2)") {
+		t.Fatalf("unexpected message %v", r.messages)
+	}
+}
+
+func TestSetEqual(t *testing.T) {
+	r := &recorder{TB: t}
+	if !SetEqual[int](r, newList(1, 1, 2), 2, 1) {
+		t.Fatalf("expected sets to match: %v", r.messages)
+	}
+	if SetEqual[int](r, newList(1, 2), 2, 3) || !strings.Contains(r.messages[0], "unexpected: [1]\nmissing:    [3]") {
+		t.Fatalf("unexpected message %v", r.messages)
+	}
+}
@@ -0,0 +1,49 @@
+package graph
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/profoundwu/containers/internal/utils"
+)
+
+// ExportDOT writes the graph to w in Graphviz DOT format, as a digraph when it is directed
+// Edges whose weight differs from the default of 1 are labelled with it
+func (g *Graph[T]) ExportDOT(w io.Writer) error {
+	return g.ExportDOTFunc(w, nil)
+}
+
+// ExportDOTFunc writes the graph like ExportDOT, filling each vertex with the Graphviz color
+// returned by color, which may return "" to leave a vertex unstyled
+func (g *Graph[T]) ExportDOTFunc(w io.Writer, color func(v T) string) error {
+	var buf bytes.Buffer
+	kind, arrow := "graph", "--"
+	if g.directed {
+		kind, arrow = "digraph", "->"
+	}
+	buf.WriteString(kind + " G {\n")
+
+	ids := make(map[T]int, g.VertexCount())
+	for i, v := range g.vertices.ToSlice() {
+		ids[v] = i
+		fmt.Fprintf(&buf, "\tv%d [label=%s", i, utils.DOTQuote(fmt.Sprint(v)))
+		if color != nil {
+			if c := color(v); c != "" {
+				fmt.Fprintf(&buf, ", style=filled, fillcolor=%s", utils.DOTQuote(c))
+			}
+		}
+		buf.WriteString("];\n")
+	}
+	for _, e := range g.Edges() {
+		fmt.Fprintf(&buf, "\tv%d %s v%d", ids[e.From], arrow, ids[e.To])
+		if e.Weight != 1 {
+			fmt.Fprintf(&buf, " [label=%s]", utils.DOTQuote(fmt.Sprint(e.Weight)))
+		}
+		buf.WriteString(";\n")
+	}
+
+	buf.WriteString("}\n")
+	_, err := buf.WriteTo(w)
+	return err
+}
@@ -0,0 +1,47 @@
+package graph
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGraphExportDOT(t *testing.T) {
+	g := NewDirectedGraph[string]()
+	g.AddEdge("a", "b")
+	g.AddWeightedEdge("b", "c", 2.5)
+
+	var sb strings.Builder
+	err := g.ExportDOTFunc(&sb, func(v string) string {
+		if v == "a" {
+			return "red"
+		}
+		return ""
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `digraph G {
+	v0 [label="a", style=filled, fillcolor="red"];
+	v1 [label="b"];
+	v2 [label="c"];
+	v0 -> v1;
+	v1 -> v2 [label="2.5"];
+}
+`
+	if sb.String() != expected {
+		t.Fatalf("expected %q got %q", expected, sb.String())
+	}
+
+	u := NewUndirectedGraph[int]()
+	u.AddEdge(1, 2)
+	sb.Reset()
+	if err := u.ExportDOT(&sb); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(sb.String(), "graph G {") || !strings.Contains(sb.String(), "v0 -- v1;") {
+		t.Fatalf("unexpected undirected output %q", sb.String())
+	}
+	if strings.Count(sb.String(), "--") != 1 {
+		t.Fatalf("expected the undirected edge once got %q", sb.String())
+	}
+}
@@ -0,0 +1,352 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/profoundwu/containers/list"
+)
+
+var (
+	ErrVertexNotFound = errors.New("vertex not found")
+	ErrNotDirected    = errors.New("graph is not directed")
+	ErrCycleDetected  = errors.New("graph contains a cycle")
+)
+
+// edgeKey identifies a directed edge for weight lookups
+type edgeKey[T comparable] struct {
+	from, to T
+}
+
+// Graph is an adjacency-list graph that is either directed or undirected
+type Graph[T comparable] struct {
+	directed  bool
+	vertices  *list.ArrayList[T]
+	adjacency map[T]*list.LinkedList[T]
+	weights   map[edgeKey[T]]float64
+	edges     int
+}
+
+// NewDirectedGraph creates a new empty directed graph
+func NewDirectedGraph[T comparable]() *Graph[T] {
+	return &Graph[T]{
+		directed:  true,
+		vertices:  list.NewArrayList[T](),
+		adjacency: make(map[T]*list.LinkedList[T]),
+		weights:   make(map[edgeKey[T]]float64),
+	}
+}
+
+// NewUndirectedGraph creates a new empty undirected graph
+func NewUndirectedGraph[T comparable]() *Graph[T] {
+	return &Graph[T]{
+		directed:  false,
+		vertices:  list.NewArrayList[T](),
+		adjacency: make(map[T]*list.LinkedList[T]),
+		weights:   make(map[edgeKey[T]]float64),
+	}
+}
+
+// IsDirected checks if the graph is directed
+func (g *Graph[T]) IsDirected() bool {
+	return g.directed
+}
+
+// VertexCount returns the number of vertices in the graph
+func (g *Graph[T]) VertexCount() int {
+	return g.vertices.Size()
+}
+
+// EdgeCount returns the number of edges in the graph
+// An undirected edge is counted once
+func (g *Graph[T]) EdgeCount() int {
+	return g.edges
+}
+
+// AddVertex adds a vertex to the graph
+// Returns false if the vertex already exists
+func (g *Graph[T]) AddVertex(v T) bool {
+	if _, ok := g.adjacency[v]; ok {
+		return false
+	}
+	g.adjacency[v] = list.NewLinkedList[T]()
+	g.vertices.AddLast(v)
+	return true
+}
+
+// HasVertex checks if the graph contains the specified vertex
+func (g *Graph[T]) HasVertex(v T) bool {
+	_, ok := g.adjacency[v]
+	return ok
+}
+
+// Vertices returns all vertices in insertion order
+func (g *Graph[T]) Vertices() []T {
+	return g.vertices.ToSlice()
+}
+
+// AddEdge adds an edge of weight 1 between from and to, adding missing vertices first
+// Returns false if the edge already exists
+func (g *Graph[T]) AddEdge(from, to T) bool {
+	return g.AddWeightedEdge(from, to, 1)
+}
+
+// AddWeightedEdge adds an edge with the given weight between from and to,
+// adding missing vertices first
+// Returns false if the edge already exists
+func (g *Graph[T]) AddWeightedEdge(from, to T, weight float64) bool {
+	g.AddVertex(from)
+	g.AddVertex(to)
+	if g.adjacency[from].Contains(to) {
+		return false
+	}
+
+	g.adjacency[from].AddLast(to)
+	g.weights[edgeKey[T]{from, to}] = weight
+	if !g.directed && from != to {
+		g.adjacency[to].AddLast(from)
+		g.weights[edgeKey[T]{to, from}] = weight
+	}
+	g.edges++
+	return true
+}
+
+// Weight returns the weight of the edge between from and to
+// Returns false if the edge does not exist
+func (g *Graph[T]) Weight(from, to T) (float64, bool) {
+	w, ok := g.weights[edgeKey[T]{from, to}]
+	return w, ok
+}
+
+// Edges returns all edges in the graph
+// An undirected edge is reported once, oriented as it was first added
+func (g *Graph[T]) Edges() []Edge[T] {
+	edges := make([]Edge[T], 0, g.edges)
+	seen := make(map[edgeKey[T]]bool, g.edges)
+	for _, v := range g.vertices.ToSlice() {
+		for _, n := range g.adjacency[v].ToSlice() {
+			if seen[edgeKey[T]{v, n}] {
+				continue
+			}
+			seen[edgeKey[T]{n, v}] = !g.directed
+			edges = append(edges, Edge[T]{From: v, To: n, Weight: g.weights[edgeKey[T]{v, n}]})
+		}
+	}
+	return edges
+}
+
+// HasEdge checks if the graph contains an edge between from and to
+func (g *Graph[T]) HasEdge(from, to T) bool {
+	adj, ok := g.adjacency[from]
+	return ok && adj.Contains(to)
+}
+
+// RemoveEdge deletes the edge between from and to
+// Returns true if the edge was found and removed, false otherwise
+func (g *Graph[T]) RemoveEdge(from, to T) bool {
+	adj, ok := g.adjacency[from]
+	if !ok || !adj.RemoveElement(to) {
+		return false
+	}
+	delete(g.weights, edgeKey[T]{from, to})
+	if !g.directed && from != to {
+		g.adjacency[to].RemoveElement(from)
+		delete(g.weights, edgeKey[T]{to, from})
+	}
+	g.edges--
+	return true
+}
+
+// Neighbors returns the vertices adjacent to v in edge insertion order
+// Returns error if the vertex does not exist
+func (g *Graph[T]) Neighbors(v T) ([]T, error) {
+	adj, ok := g.adjacency[v]
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", ErrVertexNotFound, v)
+	}
+	return adj.ToSlice(), nil
+}
+
+// BFS returns the vertices reachable from start in breadth-first order
+// Returns error if the start vertex does not exist
+func (g *Graph[T]) BFS(start T) ([]T, error) {
+	if !g.HasVertex(start) {
+		return nil, fmt.Errorf("%w: %v", ErrVertexNotFound, start)
+	}
+
+	visited := map[T]bool{start: true}
+	order := make([]T, 0, g.VertexCount())
+	queue := list.NewLinkedList[T]()
+	queue.AddLast(start)
+
+	for !queue.IsEmpty() {
+		v, _ := queue.RemoveFirst()
+		order = append(order, v)
+		for _, n := range g.adjacency[v].ToSlice() {
+			if !visited[n] {
+				visited[n] = true
+				queue.AddLast(n)
+			}
+		}
+	}
+	return order, nil
+}
+
+// DFS returns the vertices reachable from start in depth-first preorder
+// Returns error if the start vertex does not exist
+func (g *Graph[T]) DFS(start T) ([]T, error) {
+	if !g.HasVertex(start) {
+		return nil, fmt.Errorf("%w: %v", ErrVertexNotFound, start)
+	}
+
+	visited := make(map[T]bool)
+	order := make([]T, 0, g.VertexCount())
+	stack := list.NewArrayList[T]()
+	stack.AddLast(start)
+
+	for !stack.IsEmpty() {
+		v, _ := stack.RemoveLast()
+		if visited[v] {
+			continue
+		}
+		visited[v] = true
+		order = append(order, v)
+
+		// Push neighbors in reverse so the first neighbor is visited first
+		neighbors := g.adjacency[v].ToSlice()
+		for i := len(neighbors) - 1; i >= 0; i-- {
+			if !visited[neighbors[i]] {
+				stack.AddLast(neighbors[i])
+			}
+		}
+	}
+	return order, nil
+}
+
+// TopologicalSort returns the vertices of a directed acyclic graph in topological order
+// Returns error if the graph is undirected or contains a cycle
+func (g *Graph[T]) TopologicalSort() ([]T, error) {
+	if !g.directed {
+		return nil, ErrNotDirected
+	}
+
+	inDegree := make(map[T]int, g.VertexCount())
+	for _, adj := range g.adjacency {
+		for _, n := range adj.ToSlice() {
+			inDegree[n]++
+		}
+	}
+
+	queue := list.NewLinkedList[T]()
+	for _, v := range g.vertices.ToSlice() {
+		if inDegree[v] == 0 {
+			queue.AddLast(v)
+		}
+	}
+
+	order := make([]T, 0, g.VertexCount())
+	for !queue.IsEmpty() {
+		v, _ := queue.RemoveFirst()
+		order = append(order, v)
+		for _, n := range g.adjacency[v].ToSlice() {
+			inDegree[n]--
+			if inDegree[n] == 0 {
+				queue.AddLast(n)
+			}
+		}
+	}
+
+	if len(order) != g.VertexCount() {
+		return nil, ErrCycleDetected
+	}
+	return order, nil
+}
+
+// HasCycle checks if the graph contains a cycle
+// Self-loops count as cycles in both directed and undirected graphs
+func (g *Graph[T]) HasCycle() bool {
+	if g.directed {
+		_, err := g.TopologicalSort()
+		return err != nil
+	}
+
+	visited := make(map[T]bool, g.VertexCount())
+	for _, root := range g.vertices.ToSlice() {
+		if visited[root] {
+			continue
+		}
+		if g.undirectedCycleFrom(root, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// undirectedCycleFrom walks the component containing root and reports whether
+// any vertex is reached through more than one path
+func (g *Graph[T]) undirectedCycleFrom(root T, visited map[T]bool) bool {
+	parent := make(map[T]T)
+	visited[root] = true
+	stack := list.NewArrayList[T]()
+	stack.AddLast(root)
+
+	for !stack.IsEmpty() {
+		v, _ := stack.RemoveLast()
+		for _, n := range g.adjacency[v].ToSlice() {
+			if n == v {
+				return true
+			}
+			if !visited[n] {
+				visited[n] = true
+				parent[n] = v
+				stack.AddLast(n)
+			} else if p, ok := parent[v]; !ok || p != n {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ConnectedComponents returns the connected components of the graph
+// For directed graphs the weakly connected components are returned
+func (g *Graph[T]) ConnectedComponents() [][]T {
+	undirected := g.adjacency
+	if g.directed {
+		undirected = make(map[T]*list.LinkedList[T], g.VertexCount())
+		for _, v := range g.vertices.ToSlice() {
+			undirected[v] = list.NewLinkedList[T]()
+		}
+		for _, v := range g.vertices.ToSlice() {
+			for _, n := range g.adjacency[v].ToSlice() {
+				undirected[v].AddLast(n)
+				undirected[n].AddLast(v)
+			}
+		}
+	}
+
+	visited := make(map[T]bool, g.VertexCount())
+	var components [][]T
+	for _, root := range g.vertices.ToSlice() {
+		if visited[root] {
+			continue
+		}
+
+		visited[root] = true
+		component := []T{root}
+		queue := list.NewLinkedList[T]()
+		queue.AddLast(root)
+		for !queue.IsEmpty() {
+			v, _ := queue.RemoveFirst()
+			for _, n := range undirected[v].ToSlice() {
+				if !visited[n] {
+					visited[n] = true
+					component = append(component, n)
+					queue.AddLast(n)
+				}
+			}
+		}
+		components = append(components, component)
+	}
+	return components
+}
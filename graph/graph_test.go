@@ -0,0 +1,180 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func assertOrder[T comparable](t *testing.T, got, expected []T) {
+	t.Helper()
+	if len(got) != len(expected) {
+		t.Fatalf("length mismatch got %v want %v", got, expected)
+	}
+	for i, v := range expected {
+		if got[i] != v {
+			t.Fatalf("mismatch at %d got %v want %v", i, got, expected)
+		}
+	}
+}
+
+func TestGraphAddVertexAndEdge(t *testing.T) {
+	g := NewDirectedGraph[string]()
+	if !g.AddVertex("a") {
+		t.Fatalf("expected new vertex to be added")
+	}
+	if g.AddVertex("a") {
+		t.Fatalf("duplicate vertex should not be added")
+	}
+	if !g.AddEdge("a", "b") {
+		t.Fatalf("expected new edge to be added")
+	}
+	if g.AddEdge("a", "b") {
+		t.Fatalf("duplicate edge should not be added")
+	}
+	if g.VertexCount() != 2 || g.EdgeCount() != 1 {
+		t.Fatalf("expected 2 vertices and 1 edge got %d/%d", g.VertexCount(), g.EdgeCount())
+	}
+	if !g.HasEdge("a", "b") || g.HasEdge("b", "a") {
+		t.Fatalf("directed edge should only exist from a to b")
+	}
+}
+
+func TestGraphUndirectedEdges(t *testing.T) {
+	g := NewUndirectedGraph[int]()
+	g.AddEdge(1, 2)
+	g.AddEdge(1, 3)
+	if !g.HasEdge(2, 1) {
+		t.Fatalf("undirected edge should exist in both directions")
+	}
+	if g.EdgeCount() != 2 {
+		t.Fatalf("expected 2 edges got %d", g.EdgeCount())
+	}
+	if !g.RemoveEdge(2, 1) {
+		t.Fatalf("expected removal of edge 2-1")
+	}
+	if g.HasEdge(1, 2) || g.EdgeCount() != 1 {
+		t.Fatalf("edge 1-2 should be gone in both directions")
+	}
+	if g.RemoveEdge(2, 3) {
+		t.Fatalf("should not remove absent edge")
+	}
+}
+
+func TestGraphNeighbors(t *testing.T) {
+	g := NewDirectedGraph[int]()
+	g.AddEdge(1, 3)
+	g.AddEdge(1, 2)
+	n, err := g.Neighbors(1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertOrder(t, n, []int{3, 2})
+	if _, err := g.Neighbors(42); !errors.Is(err, ErrVertexNotFound) {
+		t.Fatalf("expected ErrVertexNotFound got %v", err)
+	}
+}
+
+func TestGraphBFSAndDFS(t *testing.T) {
+	g := NewUndirectedGraph[int]()
+	g.AddEdge(1, 2)
+	g.AddEdge(1, 3)
+	g.AddEdge(2, 4)
+	g.AddEdge(3, 5)
+	g.AddVertex(6)
+
+	bfs, err := g.BFS(1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertOrder(t, bfs, []int{1, 2, 3, 4, 5})
+
+	dfs, err := g.DFS(1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertOrder(t, dfs, []int{1, 2, 4, 3, 5})
+
+	if _, err := g.BFS(42); !errors.Is(err, ErrVertexNotFound) {
+		t.Fatalf("expected ErrVertexNotFound got %v", err)
+	}
+	if _, err := g.DFS(42); !errors.Is(err, ErrVertexNotFound) {
+		t.Fatalf("expected ErrVertexNotFound got %v", err)
+	}
+}
+
+func TestGraphTopologicalSort(t *testing.T) {
+	g := NewDirectedGraph[string]()
+	g.AddEdge("shirt", "tie")
+	g.AddEdge("tie", "jacket")
+	g.AddEdge("pants", "shoes")
+	g.AddEdge("pants", "jacket")
+
+	order, err := g.TopologicalSort()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertOrder(t, order, []string{"shirt", "pants", "tie", "shoes", "jacket"})
+
+	g.AddEdge("jacket", "shirt")
+	if _, err := g.TopologicalSort(); !errors.Is(err, ErrCycleDetected) {
+		t.Fatalf("expected ErrCycleDetected got %v", err)
+	}
+	if _, err := NewUndirectedGraph[int]().TopologicalSort(); !errors.Is(err, ErrNotDirected) {
+		t.Fatalf("expected ErrNotDirected got %v", err)
+	}
+}
+
+func TestGraphHasCycle(t *testing.T) {
+	d := NewDirectedGraph[int]()
+	d.AddEdge(1, 2)
+	d.AddEdge(2, 3)
+	if d.HasCycle() {
+		t.Fatalf("directed chain should not have a cycle")
+	}
+	d.AddEdge(3, 1)
+	if !d.HasCycle() {
+		t.Fatalf("directed ring should have a cycle")
+	}
+
+	u := NewUndirectedGraph[int]()
+	u.AddEdge(1, 2)
+	u.AddEdge(1, 3)
+	u.AddEdge(3, 4)
+	if u.HasCycle() {
+		t.Fatalf("undirected tree should not have a cycle")
+	}
+	u.AddEdge(4, 2)
+	if !u.HasCycle() {
+		t.Fatalf("undirected ring should have a cycle")
+	}
+
+	loop := NewUndirectedGraph[int]()
+	loop.AddEdge(7, 7)
+	if !loop.HasCycle() {
+		t.Fatalf("self-loop should count as a cycle")
+	}
+}
+
+func TestGraphConnectedComponents(t *testing.T) {
+	g := NewUndirectedGraph[int]()
+	g.AddEdge(1, 2)
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 5)
+	g.AddVertex(6)
+
+	components := g.ConnectedComponents()
+	if len(components) != 3 {
+		t.Fatalf("expected 3 components got %v", components)
+	}
+	assertOrder(t, components[0], []int{1, 2})
+	assertOrder(t, components[1], []int{3, 4, 5})
+	assertOrder(t, components[2], []int{6})
+
+	d := NewDirectedGraph[int]()
+	d.AddEdge(1, 2)
+	d.AddEdge(3, 2)
+	weak := d.ConnectedComponents()
+	if len(weak) != 1 {
+		t.Fatalf("expected 1 weakly connected component got %v", weak)
+	}
+}
@@ -0,0 +1,222 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/profoundwu/containers/queue"
+	"github.com/profoundwu/containers/set"
+)
+
+var (
+	ErrNoPath         = errors.New("no path between vertices")
+	ErrNegativeWeight = errors.New("graph contains a negative edge weight")
+	ErrNegativeCycle  = errors.New("graph contains a negative cycle")
+	ErrDirected       = errors.New("graph is directed")
+)
+
+// Edge is a weighted edge between two vertices
+type Edge[T comparable] struct {
+	From   T
+	To     T
+	Weight float64
+}
+
+// Path is a sequence of vertices from a source to a target with its total weight
+type Path[T comparable] struct {
+	Vertices []T
+	Weight   float64
+}
+
+// SpanningTree is the set of edges selected by a minimum spanning tree algorithm
+// For disconnected graphs it holds a minimum spanning forest
+type SpanningTree[T comparable] struct {
+	Edges  []Edge[T]
+	Weight float64
+}
+
+// Dijkstra returns the lightest path from source to target
+// Returns error if a vertex does not exist, an edge weight is negative or target is unreachable
+func (g *Graph[T]) Dijkstra(source, target T) (Path[T], error) {
+	if err := g.checkEndpoints(source, target); err != nil {
+		return Path[T]{}, err
+	}
+	for _, w := range g.weights {
+		if w < 0 {
+			return Path[T]{}, ErrNegativeWeight
+		}
+	}
+
+	dist := map[T]float64{source: 0}
+	prev := make(map[T]T)
+	done := make(map[T]bool, g.VertexCount())
+	pq := queue.NewPriorityDeque[float64, T]()
+	pq.Push(0, source)
+
+	for !pq.IsEmpty() {
+		_, v := pq.MustPopMin()
+		if done[v] {
+			continue
+		}
+		done[v] = true
+		if v == target {
+			break
+		}
+
+		for _, n := range g.adjacency[v].ToSlice() {
+			alt := dist[v] + g.weights[edgeKey[T]{v, n}]
+			if d, ok := dist[n]; !ok || alt < d {
+				dist[n] = alt
+				prev[n] = v
+				pq.Push(alt, n)
+			}
+		}
+	}
+
+	if !done[target] {
+		return Path[T]{}, fmt.Errorf("%w: %v -> %v", ErrNoPath, source, target)
+	}
+	return buildPath(prev, source, target, dist[target]), nil
+}
+
+// BellmanFord returns the lightest path from source to target, allowing negative edge weights
+// Returns error if a vertex does not exist, a negative cycle is reachable from source
+// or target is unreachable
+func (g *Graph[T]) BellmanFord(source, target T) (Path[T], error) {
+	if err := g.checkEndpoints(source, target); err != nil {
+		return Path[T]{}, err
+	}
+
+	var edges []Edge[T]
+	for _, e := range g.Edges() {
+		edges = append(edges, e)
+		// Relax undirected edges in both directions
+		if !g.directed && e.From != e.To {
+			edges = append(edges, Edge[T]{From: e.To, To: e.From, Weight: e.Weight})
+		}
+	}
+
+	dist := map[T]float64{source: 0}
+	prev := make(map[T]T)
+	for i := 1; i < g.VertexCount(); i++ {
+		changed := false
+		for _, e := range edges {
+			d, ok := dist[e.From]
+			if !ok {
+				continue
+			}
+			if cur, seen := dist[e.To]; !seen || d+e.Weight < cur {
+				dist[e.To] = d + e.Weight
+				prev[e.To] = e.From
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+
+	for _, e := range edges {
+		if d, ok := dist[e.From]; ok && d+e.Weight < dist[e.To] {
+			return Path[T]{}, ErrNegativeCycle
+		}
+	}
+
+	if _, ok := dist[target]; !ok {
+		return Path[T]{}, fmt.Errorf("%w: %v -> %v", ErrNoPath, source, target)
+	}
+	return buildPath(prev, source, target, dist[target]), nil
+}
+
+// Prim returns a minimum spanning forest of an undirected graph grown from each component in turn
+// Returns error if the graph is directed
+func (g *Graph[T]) Prim() (SpanningTree[T], error) {
+	if g.directed {
+		return SpanningTree[T]{}, ErrDirected
+	}
+
+	var tree SpanningTree[T]
+	inTree := make(map[T]bool, g.VertexCount())
+	for _, root := range g.vertices.ToSlice() {
+		if inTree[root] {
+			continue
+		}
+
+		inTree[root] = true
+		pq := queue.NewPriorityDeque[float64, Edge[T]]()
+		g.pushEdges(pq, root, inTree)
+		for !pq.IsEmpty() {
+			_, e := pq.MustPopMin()
+			if inTree[e.To] {
+				continue
+			}
+			inTree[e.To] = true
+			tree.Edges = append(tree.Edges, e)
+			tree.Weight += e.Weight
+			g.pushEdges(pq, e.To, inTree)
+		}
+	}
+	return tree, nil
+}
+
+// Kruskal returns a minimum spanning forest of an undirected graph by joining the lightest edges first
+// Returns error if the graph is directed
+func (g *Graph[T]) Kruskal() (SpanningTree[T], error) {
+	if g.directed {
+		return SpanningTree[T]{}, ErrDirected
+	}
+
+	edges := g.Edges()
+	slices.SortStableFunc(edges, func(a, b Edge[T]) int {
+		switch {
+		case a.Weight < b.Weight:
+			return -1
+		case a.Weight > b.Weight:
+			return 1
+		}
+		return 0
+	})
+
+	var tree SpanningTree[T]
+	components := set.NewDisjointSet[T]()
+	for _, e := range edges {
+		if components.Union(e.From, e.To) {
+			tree.Edges = append(tree.Edges, e)
+			tree.Weight += e.Weight
+		}
+	}
+	return tree, nil
+}
+
+// checkEndpoints verifies that both path endpoints exist
+func (g *Graph[T]) checkEndpoints(source, target T) error {
+	if !g.HasVertex(source) {
+		return fmt.Errorf("%w: %v", ErrVertexNotFound, source)
+	}
+	if !g.HasVertex(target) {
+		return fmt.Errorf("%w: %v", ErrVertexNotFound, target)
+	}
+	return nil
+}
+
+// pushEdges queues every edge from v to a vertex outside the tree
+func (g *Graph[T]) pushEdges(pq *queue.PriorityDeque[float64, Edge[T]], v T, inTree map[T]bool) {
+	for _, n := range g.adjacency[v].ToSlice() {
+		if !inTree[n] {
+			w := g.weights[edgeKey[T]{v, n}]
+			pq.Push(w, Edge[T]{From: v, To: n, Weight: w})
+		}
+	}
+}
+
+// buildPath walks the predecessor map back from target to source
+func buildPath[T comparable](prev map[T]T, source, target T, weight float64) Path[T] {
+	vertices := []T{target}
+	for v := target; v != source; {
+		v = prev[v]
+		vertices = append(vertices, v)
+	}
+	slices.Reverse(vertices)
+	return Path[T]{Vertices: vertices, Weight: weight}
+}
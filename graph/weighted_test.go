@@ -0,0 +1,150 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestGraphWeights(t *testing.T) {
+	g := NewUndirectedGraph[string]()
+	g.AddWeightedEdge("a", "b", 2.5)
+	g.AddEdge("b", "c")
+	if w, ok := g.Weight("b", "a"); !ok || w != 2.5 {
+		t.Fatalf("expected weight 2.5 for b-a got %v ok=%v", w, ok)
+	}
+	if w, ok := g.Weight("b", "c"); !ok || w != 1 {
+		t.Fatalf("expected default weight 1 got %v ok=%v", w, ok)
+	}
+	g.RemoveEdge("a", "b")
+	if _, ok := g.Weight("b", "a"); ok {
+		t.Fatalf("weight should be removed with the edge")
+	}
+	if len(g.Edges()) != 1 {
+		t.Fatalf("expected a single undirected edge got %v", g.Edges())
+	}
+}
+
+func TestGraphDijkstra(t *testing.T) {
+	g := NewDirectedGraph[string]()
+	g.AddWeightedEdge("a", "b", 4)
+	g.AddWeightedEdge("a", "c", 1)
+	g.AddWeightedEdge("c", "b", 2)
+	g.AddWeightedEdge("b", "d", 1)
+	g.AddVertex("z")
+
+	p, err := g.Dijkstra("a", "d")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertOrder(t, p.Vertices, []string{"a", "c", "b", "d"})
+	if p.Weight != 4 {
+		t.Fatalf("expected weight 4 got %v", p.Weight)
+	}
+
+	same, err := g.Dijkstra("a", "a")
+	if err != nil || len(same.Vertices) != 1 || same.Weight != 0 {
+		t.Fatalf("expected trivial path got %v err=%v", same, err)
+	}
+	if _, err := g.Dijkstra("a", "z"); !errors.Is(err, ErrNoPath) {
+		t.Fatalf("expected ErrNoPath got %v", err)
+	}
+	if _, err := g.Dijkstra("a", "missing"); !errors.Is(err, ErrVertexNotFound) {
+		t.Fatalf("expected ErrVertexNotFound got %v", err)
+	}
+
+	g.AddWeightedEdge("d", "a", -1)
+	if _, err := g.Dijkstra("a", "d"); !errors.Is(err, ErrNegativeWeight) {
+		t.Fatalf("expected ErrNegativeWeight got %v", err)
+	}
+}
+
+func TestGraphBellmanFord(t *testing.T) {
+	g := NewDirectedGraph[int]()
+	g.AddWeightedEdge(1, 2, 4)
+	g.AddWeightedEdge(1, 3, 5)
+	g.AddWeightedEdge(3, 2, -3)
+	g.AddWeightedEdge(2, 4, 2)
+
+	p, err := g.BellmanFord(1, 4)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertOrder(t, p.Vertices, []int{1, 3, 2, 4})
+	if p.Weight != 4 {
+		t.Fatalf("expected weight 4 got %v", p.Weight)
+	}
+
+	g.AddWeightedEdge(4, 3, -1)
+	if _, err := g.BellmanFord(1, 4); !errors.Is(err, ErrNegativeCycle) {
+		t.Fatalf("expected ErrNegativeCycle got %v", err)
+	}
+
+	u := NewUndirectedGraph[int]()
+	u.AddWeightedEdge(1, 2, 3)
+	u.AddVertex(3)
+	back, err := u.BellmanFord(2, 1)
+	if err != nil || back.Weight != 3 {
+		t.Fatalf("expected undirected path of weight 3 got %v err=%v", back, err)
+	}
+	if _, err := u.BellmanFord(1, 3); !errors.Is(err, ErrNoPath) {
+		t.Fatalf("expected ErrNoPath got %v", err)
+	}
+}
+
+func spanningTestGraph() *Graph[string] {
+	g := NewUndirectedGraph[string]()
+	g.AddWeightedEdge("a", "b", 7)
+	g.AddWeightedEdge("a", "d", 5)
+	g.AddWeightedEdge("b", "c", 8)
+	g.AddWeightedEdge("b", "d", 9)
+	g.AddWeightedEdge("b", "e", 7)
+	g.AddWeightedEdge("c", "e", 5)
+	g.AddWeightedEdge("d", "e", 15)
+	g.AddWeightedEdge("d", "f", 6)
+	g.AddWeightedEdge("e", "f", 8)
+	g.AddWeightedEdge("e", "g", 9)
+	g.AddWeightedEdge("f", "g", 11)
+	return g
+}
+
+func TestGraphPrimAndKruskal(t *testing.T) {
+	g := spanningTestGraph()
+
+	prim, err := g.Prim()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	kruskal, err := g.Kruskal()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for name, tree := range map[string]SpanningTree[string]{"prim": prim, "kruskal": kruskal} {
+		if len(tree.Edges) != g.VertexCount()-1 {
+			t.Fatalf("%s: expected %d edges got %d", name, g.VertexCount()-1, len(tree.Edges))
+		}
+		if tree.Weight != 39 {
+			t.Fatalf("%s: expected total weight 39 got %v", name, tree.Weight)
+		}
+	}
+
+	if _, err := NewDirectedGraph[int]().Prim(); !errors.Is(err, ErrDirected) {
+		t.Fatalf("expected ErrDirected got %v", err)
+	}
+	if _, err := NewDirectedGraph[int]().Kruskal(); !errors.Is(err, ErrDirected) {
+		t.Fatalf("expected ErrDirected got %v", err)
+	}
+}
+
+func TestGraphSpanningForest(t *testing.T) {
+	g := NewUndirectedGraph[int]()
+	g.AddWeightedEdge(1, 2, 1)
+	g.AddWeightedEdge(3, 4, 2)
+	prim, _ := g.Prim()
+	kruskal, _ := g.Kruskal()
+	if len(prim.Edges) != 2 || len(kruskal.Edges) != 2 {
+		t.Fatalf("expected a two-edge forest got %v / %v", prim.Edges, kruskal.Edges)
+	}
+	if prim.Weight != 3 || kruskal.Weight != 3 {
+		t.Fatalf("expected forest weight 3 got %v / %v", prim.Weight, kruskal.Weight)
+	}
+}
@@ -0,0 +1,9 @@
+package utils
+
+// CloneValue returns v.Clone() when v has a Clone method returning its own type, and v otherwise
+func CloneValue[T any](v T) T {
+	if c, ok := any(v).(interface{ Clone() T }); ok {
+		return c.Clone()
+	}
+	return v
+}
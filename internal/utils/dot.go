@@ -0,0 +1,10 @@
+package utils
+
+import "strings"
+
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// DOTQuote renders s as a quoted Graphviz string, keeping line breaks as \n
+func DOTQuote(s string) string {
+	return `"` + dotEscaper.Replace(s) + `"`
+}
@@ -0,0 +1,17 @@
+package utils
+
+import "fmt"
+
+// Must returns v, or panics with err wrapped with the name of the Must method that failed,
+// so a recovered value still matches the original error with errors.Is
+func Must[T any](method string, v T, err error) T {
+	MustSucceed(method, err)
+	return v
+}
+
+// MustSucceed panics like Must when err is not nil
+func MustSucceed(method string, err error) {
+	if err != nil {
+		panic(fmt.Errorf("%s: %w", method, err))
+	}
+}
@@ -0,0 +1,226 @@
+package list
+
+import (
+	"testing"
+)
+
+func TestArrayListAppendTo(t *testing.T) {
+	al := NewArrayListFromSlice([]int{1, 2, 3})
+	got := al.AppendTo([]int{0})
+	expected := []int{0, 1, 2, 3}
+	for i, v := range expected {
+		if got[i] != v {
+			t.Fatalf("append mismatch got %v want %v", got, expected)
+		}
+	}
+}
+
+func TestLinkedListAppendTo(t *testing.T) {
+	ll := NewLinkedListFromSlice([]int{1, 2, 3})
+	got := ll.AppendTo([]int{0})
+	expected := []int{0, 1, 2, 3}
+	for i, v := range expected {
+		if got[i] != v {
+			t.Fatalf("append mismatch got %v want %v", got, expected)
+		}
+	}
+}
+
+func TestZeroAllocIteration(t *testing.T) {
+	al := NewArrayListFromSlice(make([]int, 1000))
+	ll := NewLinkedListFromSlice(make([]int, 1000))
+	buf := make([]int, 0, 1000)
+
+	checks := map[string]func(){
+		"ArrayList.Get loop": func() {
+			for i := 0; i < al.Size(); i++ {
+				_, _ = al.Get(i)
+			}
+		},
+		"ArrayList.AppendTo": func() {
+			buf = al.AppendTo(buf[:0])
+		},
+		"LinkedList.AppendTo": func() {
+			buf = ll.AppendTo(buf[:0])
+		},
+		"ArrayList.IndexOf": func() {
+			_ = al.IndexOf(-1)
+		},
+		"LinkedList.IndexOf": func() {
+			_ = ll.IndexOf(-1)
+		},
+	}
+	for name, fn := range checks {
+		if allocs := testing.AllocsPerRun(100, fn); allocs != 0 {
+			t.Errorf("%s allocated %.1f times per run, want 0", name, allocs)
+		}
+	}
+}
+
+func TestArrayListDequeNoAlloc(t *testing.T) {
+	al := NewArrayList[int]()
+	al.AddLast(0)
+	checks := map[string]func(){
+		"AddFirst/RemoveFirst": func() {
+			_ = al.AddFirst(1)
+			_, _ = al.RemoveFirst()
+		},
+		"AddFirst/RemoveLast": func() {
+			for i := 0; i < 8; i++ {
+				_ = al.AddFirst(i)
+				_, _ = al.RemoveLast()
+			}
+		},
+	}
+	for name, fn := range checks {
+		if allocs := testing.AllocsPerRun(100, fn); allocs != 0 {
+			t.Errorf("%s allocated %.1f times per run, want 0", name, allocs)
+		}
+		if al.Size() != 1 || al.Capacity() != smallCapacity {
+			t.Fatalf("%s: expected size 1 in inline storage got size %d capacity %d", name, al.Size(), al.Capacity())
+		}
+		if err := al.Validate(); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+	}
+}
+
+func BenchmarkArrayListGetLoop(b *testing.B) {
+	al := NewArrayListFromSlice(make([]int, 1000))
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		for i := 0; i < al.Size(); i++ {
+			_, _ = al.Get(i)
+		}
+	}
+}
+
+func BenchmarkArrayListToSlice(b *testing.B) {
+	al := NewArrayListFromSlice(make([]int, 1000))
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		_ = al.ToSlice()
+	}
+}
+
+func BenchmarkArrayListAppendTo(b *testing.B) {
+	al := NewArrayListFromSlice(make([]int, 1000))
+	buf := make([]int, 0, al.Size())
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		buf = al.AppendTo(buf[:0])
+	}
+}
+
+func BenchmarkLinkedListToSlice(b *testing.B) {
+	ll := NewLinkedListFromSlice(make([]int, 1000))
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		_ = ll.ToSlice()
+	}
+}
+
+func BenchmarkLinkedListAppendTo(b *testing.B) {
+	ll := NewLinkedListFromSlice(make([]int, 1000))
+	buf := make([]int, 0, ll.Size())
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		buf = ll.AppendTo(buf[:0])
+	}
+}
+
+func TestLinkedListPooled(t *testing.T) {
+	ll := NewLinkedListPooled[int]()
+	ll.AddSlice([]int{1, 2, 3, 4})
+	ll.RemoveFirst()
+	ll.Remove(1)
+	ll.RemoveElement(4)
+	ll.AddLast(5)
+	ll.AddFirst(0)
+	assertElements[int](t, ll, []int{0, 2, 5})
+	ll.Clear()
+	ll.AddSlice([]int{6, 7})
+	ll.RemoveIf(func(v int) bool { return v == 6 })
+	assertElements[int](t, ll, []int{7})
+}
+
+func BenchmarkLinkedListChurn(b *testing.B) {
+	for name, newList := range map[string]func() *LinkedList[int]{
+		"plain":  NewLinkedList[int],
+		"pooled": NewLinkedListPooled[int],
+	} {
+		b.Run(name, func(b *testing.B) {
+			ll := newList()
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				for i := 0; i < 100; i++ {
+					ll.AddLast(i)
+				}
+				for !ll.IsEmpty() {
+					ll.RemoveFirst()
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkArrayListAddFirstRemoveFirst(b *testing.B) {
+	al := NewArrayList[int]()
+	for n := 0; n < b.N; n++ {
+		for i := 0; i < 1000; i++ {
+			al.AddFirst(i)
+		}
+		for i := 0; i < 1000; i++ {
+			al.RemoveFirst()
+		}
+	}
+}
+
+func BenchmarkArrayListQueue(b *testing.B) {
+	al := NewArrayList[int]()
+	for i := 0; i < 100; i++ {
+		al.AddLast(i)
+	}
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		al.AddLast(n)
+		al.RemoveFirst()
+	}
+}
+
+func TestArrayListSmallStorage(t *testing.T) {
+	empty := testing.AllocsPerRun(100, func() {
+		_ = NewArrayList[int]()
+	})
+	filled := testing.AllocsPerRun(100, func() {
+		al := NewArrayList[int]()
+		for i := 0; i < smallCapacity; i++ {
+			al.AddLast(i)
+		}
+	})
+	if filled != empty {
+		t.Fatalf("expected no allocations beyond the list itself got %v, empty list %v", filled, empty)
+	}
+	heap := testing.AllocsPerRun(100, func() {
+		_ = NewArrayListWithCapacity[int](10)
+	})
+	if empty >= heap {
+		t.Fatalf("expected fewer allocations than a heap backed list got %v and %v", empty, heap)
+	}
+
+	al := NewArrayListFromSlice([]int{1, 2, 3})
+	clone := al.Clone()
+	for i := 4; i <= 6; i++ {
+		al.AddLast(i)
+	}
+	assertElements[int](t, al, []int{1, 2, 3, 4, 5, 6})
+	assertElements[int](t, clone, []int{1, 2, 3})
+
+	al.RemoveRange(2, 6)
+	al.TrimToSize()
+	if al.Capacity() != 2 {
+		t.Fatalf("expected capacity 2 got %d", al.Capacity())
+	}
+	al.AddFirst(0)
+	assertElements[int](t, al, []int{0, 1, 2})
+}
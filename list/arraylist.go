@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/profoundwu/containers/internal/utils"
+	"github.com/profoundwu/containers/metrics"
 )
 
 var (
@@ -13,37 +14,68 @@ var (
 	ErrEmptyList        = errors.New("list is empty")
 )
 
-type ArrayList[T comparable] struct {
+// smallCapacity is the number of elements an array list can hold inside itself,
+// before its elements need an allocation of their own
+const smallCapacity = 4
+
+type ArrayList[T any] struct {
+	// elements holds the list from index 0; when head is positive it is backing[head:],
+	// and the free slots before it let AddFirst and RemoveFirst run in amortized O(1)
 	elements []T
+	backing  []T
+	head     int
 	size     int
+	format   FormatFunc[T]
+	layout   *layout
+	equality equality[T]
+	growth   growthPolicy
+	// metrics is nil unless the list was created with WithMetrics
+	metrics *metrics.Counters
+	// pointerFree is set at construction when T holds no pointers, letting Clear skip zeroing
+	pointerFree bool
+	// modCount counts structural changes so iterators can detect modification behind their back
+	modCount int
+	// small is the inline storage elements uses while the list is small
+	small [smallCapacity]T
 }
 
-// NewArrayList creates a new empty array list with default capacity
+// NewArrayList creates a new empty array list that holds its first few elements without
+// allocating a separate backing array
 func NewArrayList[T comparable]() *ArrayList[T] {
-	return &ArrayList[T]{
-		elements: make([]T, utils.DefaultCapacity),
-		size:     0,
-	}
+	return newArrayList(smallCapacity, comparableEquality[T]())
+}
+
+// NewArrayListWithEquals creates a new empty array list like NewArrayList that compares elements with eq
+// This allows element types that are not comparable, or semantic equality for those that are
+// Contains, IndexOf, RemoveElement and the bulk operations all go through eq, so set
+// operations such as RemoveAll and Distinct take quadratic time
+func NewArrayListWithEquals[T any](eq func(a, b T) bool) *ArrayList[T] {
+	return newArrayList(smallCapacity, funcEquality(eq))
 }
 
 // NewArrayListWithCapacity creates a new array list with specified initial capacity
+// Capacities of up to 4 use storage inside the list instead of a separate allocation
 func NewArrayListWithCapacity[T comparable](capacity int) *ArrayList[T] {
+	return newArrayList(capacity, comparableEquality[T]())
+}
+
+func newArrayList[T any](capacity int, e equality[T]) *ArrayList[T] {
 	if capacity < 1 {
 		capacity = utils.DefaultCapacity
 	}
-	return &ArrayList[T]{
-		elements: make([]T, capacity),
-		size:     0,
+	al := &ArrayList[T]{equality: e, pointerFree: utils.PointerFree[T]()}
+	if capacity <= smallCapacity {
+		al.elements = al.small[:capacity]
+	} else {
+		al.elements = make([]T, capacity)
 	}
+	return al
 }
 
 // NewArrayListFromSlice creates an array list from a slice
 func NewArrayListFromSlice[T comparable](slice []T) *ArrayList[T] {
-	al := &ArrayList[T]{
-		elements: make([]T, len(slice)),
-		size:     len(slice),
-	}
-	copy(al.elements, slice)
+	al := newArrayList(max(len(slice), smallCapacity), comparableEquality[T]())
+	al.size = copy(al.elements, slice)
 	return al
 }
 
@@ -57,24 +89,95 @@ func (al *ArrayList[T]) IsEmpty() bool {
 	return al.size == 0
 }
 
-// Capacity returns the current capacity of the underlying array
+// Capacity returns the current capacity of the underlying array, including free slots kept
+// in front of the first element
 func (al *ArrayList[T]) Capacity() int {
-	return len(al.elements)
+	return al.head + len(al.elements)
+}
+
+// Grow makes room for at least n more elements, so the next n additions do not reallocate
+// Non-positive values of n leave the capacity unchanged
+// Panics with ErrCapacityExceeded if the maximum capacity does not allow it
+func (al *ArrayList[T]) Grow(n int) {
+	if n > 0 {
+		al.ensureCapacity(al.size + n)
+	}
 }
 
-// ensureCapacity ensures the array has enough capacity
+// ensureCapacity ensures the array has enough capacity for methods without an error result
+// Panics with ErrCapacityExceeded if the maximum capacity does not allow it
 func (al *ArrayList[T]) ensureCapacity(minCapacity int) {
-	if minCapacity > len(al.elements) {
-		newCapacity := max(len(al.elements)*utils.GrowthFactor, minCapacity)
-		newElements := make([]T, newCapacity)
-		copy(newElements, al.elements[:al.size])
-		al.elements = newElements
+	if err := al.reserve(minCapacity); err != nil {
+		panic(err)
 	}
 }
 
-// AddFirst adds an element to the beginning of the array list
+// reserve ensures the array has room for minCapacity elements from the first one
+// Returns error if the growth policy does not allow it
+func (al *ArrayList[T]) reserve(minCapacity int) error {
+	if minCapacity >= 0 && minCapacity <= len(al.elements) {
+		return nil
+	}
+	if al.head >= al.size && minCapacity <= al.Capacity() {
+		// Slide the elements back to the start of the array; the removals that freed
+		// at least as many slots in front pay for the copy
+		copy(al.backing, al.elements[:al.size])
+		clear(al.backing[al.head : al.head+al.size])
+		al.setElements(al.backing)
+		return nil
+	}
+	newCapacity, err := al.growth.next(len(al.elements), minCapacity)
+	if err != nil {
+		return err
+	}
+	newElements := make([]T, newCapacity)
+	copy(newElements, al.elements[:al.size])
+	al.setElements(newElements)
+	al.metrics.CountGrowth()
+	return nil
+}
+
+// setElements replaces the backing array with elements, which holds the list from index 0
+func (al *ArrayList[T]) setElements(elements []T) {
+	al.elements, al.backing, al.head = elements, nil, 0
+}
+
+// AddFirst adds an element to the beginning of the array list in amortized O(1)
+// Returns error if the maximum capacity has been reached
 func (al *ArrayList[T]) AddFirst(elem T) error {
-	return al.Add(0, elem)
+	if al.head == 0 {
+		if _, err := al.growth.next(0, al.size+1); err != nil {
+			return err
+		}
+		if free := len(al.elements) - al.size; free > 0 && free >= al.size {
+			// At least half of the array is free at the end, so shift the elements into it
+			// once instead of reallocating; the AddFirst calls that fill the gap pay for the copy
+			gap := (free + 1) / 2
+			copy(al.elements[gap:], al.elements[:al.size])
+			clear(al.elements[:min(gap, al.size)])
+			al.backing, al.head = al.elements, gap
+		} else {
+			// Reallocate with as many free slots in front as there are elements, so the
+			// copy is paid for by the AddFirst calls that fill them
+			gap := max(al.size, utils.DefaultCapacity)
+			tail := min(len(al.elements)-al.size, gap)
+			if limit := al.growth.maxCapacity; limit > 0 {
+				gap = min(gap, limit-al.size)
+				tail = min(tail, limit-al.size-gap)
+			}
+			backing := make([]T, gap+al.size+tail)
+			copy(backing[gap:], al.elements[:al.size])
+			al.backing, al.head = backing, gap
+			al.metrics.CountGrowth()
+		}
+	}
+	al.head--
+	al.elements = al.backing[al.head:]
+	al.elements[0] = elem
+	al.size++
+	al.modCount++
+	al.metrics.CountAdd()
+	return nil
 }
 
 // AddLast adds an element to the end of the array list
@@ -82,21 +185,29 @@ func (al *ArrayList[T]) AddLast(elem T) {
 	al.ensureCapacity(al.size + 1)
 	al.elements[al.size] = elem
 	al.size++
+	al.modCount++
+	al.metrics.CountAdd()
 }
 
 // Add inserts an element at the specified index position
-// Returns error if index is out of bounds
+// Returns error if index is out of bounds or the maximum capacity has been reached
 func (al *ArrayList[T]) Add(index int, elem T) error {
 	if index < 0 || index > al.size {
 		return fmt.Errorf("%w: %d, list size: %d", ErrIndexOutOfBounds, index, al.size)
 	}
-
-	al.ensureCapacity(al.size + 1)
+	if index == 0 {
+		return al.AddFirst(elem)
+	}
+	if err := al.reserve(al.size + 1); err != nil {
+		return err
+	}
 
 	// Shift elements to the right
 	copy(al.elements[index+1:], al.elements[index:al.size])
 	al.elements[index] = elem
 	al.size++
+	al.modCount++
+	al.metrics.CountAdd()
 	return nil
 }
 
@@ -107,6 +218,7 @@ func (al *ArrayList[T]) Get(index int) (T, error) {
 	if index < 0 || index >= al.size {
 		return zero, fmt.Errorf("%w: %d, list size: %d", ErrIndexOutOfBounds, index, al.size)
 	}
+	al.metrics.CountLookup()
 	return al.elements[index], nil
 }
 
@@ -147,6 +259,9 @@ func (al *ArrayList[T]) Remove(index int) (T, error) {
 	if index < 0 || index >= al.size {
 		return zero, fmt.Errorf("%w: %d, list size: %d", ErrIndexOutOfBounds, index, al.size)
 	}
+	if index == 0 {
+		return al.removeFirst(), nil
+	}
 
 	removed := al.elements[index]
 
@@ -154,20 +269,39 @@ func (al *ArrayList[T]) Remove(index int) (T, error) {
 	copy(al.elements[index:], al.elements[index+1:al.size])
 
 	al.size--
+	al.modCount++
+	al.metrics.CountRemove()
 	// Clear the last element to help garbage collection
 	al.elements[al.size] = zero
 
 	return removed, nil
 }
 
-// RemoveFirst deletes and returns the first element of the array list
+// RemoveFirst deletes and returns the first element of the array list in O(1)
 // Returns error if list is empty
 func (al *ArrayList[T]) RemoveFirst() (T, error) {
 	if al.IsEmpty() {
 		var zero T
 		return zero, ErrEmptyList
 	}
-	return al.Remove(0)
+	return al.removeFirst(), nil
+}
+
+// removeFirst advances the head past the first element of a non-empty list
+func (al *ArrayList[T]) removeFirst() T {
+	var zero T
+	removed := al.elements[0]
+	// Clear the slot to help garbage collection
+	al.elements[0] = zero
+	if al.backing == nil {
+		al.backing = al.elements
+	}
+	al.head++
+	al.elements = al.backing[al.head:]
+	al.size--
+	al.modCount++
+	al.metrics.CountRemove()
+	return removed
 }
 
 // RemoveLast deletes and returns the last element of the array list
@@ -184,12 +318,14 @@ func (al *ArrayList[T]) RemoveLast() (T, error) {
 // Returns true if element was found and removed, false otherwise
 func (al *ArrayList[T]) RemoveElement(elem T) bool {
 	for i := 0; i < al.size; i++ {
-		if al.elements[i] == elem {
+		if al.equality.eq(al.elements[i], elem) {
 			// 直接实现删除逻辑，避免重复边界检查
 			// Shift elements to the left
 			copy(al.elements[i:], al.elements[i+1:al.size])
 			var zero T
 			al.size--
+			al.modCount++
+			al.metrics.CountRemove()
 			al.elements[al.size] = zero
 			return true
 		}
@@ -205,8 +341,9 @@ func (al *ArrayList[T]) Contains(elem T) bool {
 // IndexOf returns the first index of the specified element in the array list
 // Returns -1 if element is not found
 func (al *ArrayList[T]) IndexOf(elem T) int {
+	al.metrics.CountLookup()
 	for i := 0; i < al.size; i++ {
-		if al.elements[i] == elem {
+		if al.equality.eq(al.elements[i], elem) {
 			return i
 		}
 	}
@@ -217,7 +354,7 @@ func (al *ArrayList[T]) IndexOf(elem T) int {
 // Returns -1 if element is not found
 func (al *ArrayList[T]) LastIndexOf(elem T) int {
 	for i := al.size - 1; i >= 0; i-- {
-		if al.elements[i] == elem {
+		if al.equality.eq(al.elements[i], elem) {
 			return i
 		}
 	}
@@ -225,20 +362,28 @@ func (al *ArrayList[T]) LastIndexOf(elem T) int {
 }
 
 // Clear removes all elements from the array list
+// It takes O(1) for element types without pointers; otherwise the slots are zeroed to help
+// garbage collection
 func (al *ArrayList[T]) Clear() {
-	var zero T
-	// Clear references to help garbage collection
-	for i := 0; i < al.size; i++ {
-		al.elements[i] = zero
+	if !al.pointerFree {
+		clear(al.elements[:al.size])
+	}
+	if al.backing != nil {
+		al.setElements(al.backing)
 	}
 	al.size = 0
+	al.modCount++
 }
 
 // ToSlice converts the array list to a slice
 func (al *ArrayList[T]) ToSlice() []T {
-	slice := make([]T, al.size)
-	copy(slice, al.elements[:al.size])
-	return slice
+	return al.AppendTo(make([]T, 0, al.size))
+}
+
+// AppendTo appends the elements of the array list to dst and returns the extended slice
+// Reusing dst across calls avoids allocating when it already has enough capacity
+func (al *ArrayList[T]) AppendTo(dst []T) []T {
+	return append(dst, al.elements[:al.size]...)
 }
 
 // Reverse reverses the array list in place
@@ -246,29 +391,30 @@ func (al *ArrayList[T]) Reverse() {
 	for i, j := 0, al.size-1; i < j; i, j = i+1, j-1 {
 		al.elements[i], al.elements[j] = al.elements[j], al.elements[i]
 	}
+	al.modCount++
 }
 
 // TrimToSize reduces the capacity of the array to match the current size
 func (al *ArrayList[T]) TrimToSize() {
-	if al.size < len(al.elements) {
+	if al.size < al.Capacity() {
+		if al.size <= smallCapacity {
+			copy(al.small[:], al.elements[:al.size])
+			clear(al.small[al.size:])
+			al.setElements(al.small[:al.size])
+			return
+		}
 		newElements := make([]T, al.size)
 		copy(newElements, al.elements[:al.size])
-		al.elements = newElements
+		al.setElements(newElements)
 	}
 }
 
 // String returns a string representation of the array list
 func (al *ArrayList[T]) String() string {
+	l := al.layout.or(arrayListLayout)
 	var sb strings.Builder
-	sb.WriteString("[")
-
-	for i := 0; i < al.size; i++ {
-		sb.WriteString(fmt.Sprintf("%v", al.elements[i]))
-		if i < al.size-1 {
-			sb.WriteString(", ")
-		}
-	}
-
-	sb.WriteString("]")
+	sb.WriteString(l.prefix)
+	joinElements(&sb, al.format, al.elements[:al.size], l.sep)
+	sb.WriteString(l.suffix)
 	return sb.String()
 }
@@ -105,6 +105,26 @@ func TestArrayListEnsureCapacityGrowth(t *testing.T) {
 	assertSize(t, al.Size(), initialCap+1)
 }
 
+func TestArrayListGrow(t *testing.T) {
+	al := NewArrayListFromSlice([]int{1, 2, 3})
+	al.Grow(1000)
+	if al.Capacity() < 1003 {
+		t.Fatalf("expected capacity of at least 1003 got %d", al.Capacity())
+	}
+	capacity := al.Capacity()
+	for i := 0; i < 1000; i++ {
+		al.AddLast(i)
+	}
+	if al.Capacity() != capacity {
+		t.Fatalf("expected no reallocation got capacity %d", al.Capacity())
+	}
+	al.Grow(-5)
+	al.Grow(0)
+	if al.Capacity() != capacity || al.Size() != 1003 {
+		t.Fatalf("expected non-positive Grow to be a no-op")
+	}
+}
+
 func TestArrayListGetAndErrors(t *testing.T) {
 	al := NewArrayListFromSlice([]int{10, 20, 30})
 	v, err := al.Get(1)
@@ -270,3 +290,80 @@ func TestArrayListString(t *testing.T) {
 		t.Fatalf("empty list string mismatch")
 	}
 }
+
+func TestArrayListHeadOffset(t *testing.T) {
+	al := NewArrayListFromSlice([]int{3, 4})
+	for i := 2; i >= 0; i-- {
+		al.AddFirst(i)
+	}
+	assertElements[int](t, al, []int{0, 1, 2, 3, 4})
+
+	// The free slots in front absorb further AddFirst calls without reallocating
+	capacity := al.Capacity()
+	for al.Size() < 8 {
+		al.AddFirst(-al.Size())
+	}
+	if al.Capacity() != capacity {
+		t.Fatalf("expected capacity %d got %d", capacity, al.Capacity())
+	}
+
+	al.Clear()
+	for i := 0; i < 5; i++ {
+		al.AddLast(i)
+	}
+	for i := 0; i < 3; i++ {
+		if v, err := al.RemoveFirst(); err != nil || v != i {
+			t.Fatalf("expected %d got %d %v", i, v, err)
+		}
+	}
+	al.Add(0, 9)
+	al.Add(1, 8)
+	if v, _ := al.Remove(0); v != 9 {
+		t.Fatalf("expected 9 got %d", v)
+	}
+	assertElements[int](t, al, []int{8, 3, 4})
+	if al.IndexOf(4) != 2 || al.String() != "[8, 3, 4]" {
+		t.Fatalf("unexpected list %s", al)
+	}
+
+	al.TrimToSize()
+	if al.Capacity() != 3 {
+		t.Fatalf("expected capacity 3 got %d", al.Capacity())
+	}
+	assertElements[int](t, al, []int{8, 3, 4})
+	for !al.IsEmpty() {
+		al.RemoveFirst()
+	}
+	if _, err := al.RemoveFirst(); !errors.Is(err, ErrEmptyList) {
+		t.Fatalf("expected ErrEmptyList got %v", err)
+	}
+}
+
+func TestArrayListClearPointerFree(t *testing.T) {
+	type point struct {
+		X, Y int
+		Tags [2]uint8
+	}
+	type named struct {
+		Name string
+	}
+	if !NewArrayList[point]().pointerFree || NewArrayList[named]().pointerFree ||
+		NewArrayList[any]().pointerFree || NewArrayList[*int]().pointerFree {
+		t.Fatalf("unexpected pointer-free detection")
+	}
+
+	v := 1
+	ptrs := NewArrayListFromSlice([]*int{&v, &v})
+	ptrs.Clear()
+	if ptrs.elements[0] != nil || ptrs.elements[1] != nil {
+		t.Fatalf("expected cleared slots to drop their pointers")
+	}
+
+	ints := NewArrayListFromSlice([]int{1, 2})
+	ints.Clear()
+	if !ints.IsEmpty() || ints.String() != "[]" {
+		t.Fatalf("expected an empty list got %s", ints)
+	}
+	ints.AddLast(3)
+	assertElements[int](t, ints, []int{3})
+}
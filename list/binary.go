@@ -0,0 +1,83 @@
+package list
+
+import (
+	"bytes"
+	"encoding/gob"
+)
+
+// binaryList is the gob payload behind MarshalBinary
+type binaryList[T any] struct {
+	Capacity int
+	Elements []T
+}
+
+// RegisterGob registers the list types for element type T with encoding/gob,
+// which is needed only when lists travel inside interface values
+func RegisterGob[T any]() {
+	gob.Register(&ArrayList[T]{})
+	gob.Register(&LinkedList[T]{})
+}
+
+// MarshalBinary encodes the array list, including its capacity, with encoding/gob
+// Elements must be encodable by gob
+func (al *ArrayList[T]) MarshalBinary() ([]byte, error) {
+	return encodeList(binaryList[T]{Capacity: al.Capacity(), Elements: al.elements[:al.size]})
+}
+
+// UnmarshalBinary replaces the array list with one decoded from data produced by MarshalBinary
+// The encoded capacity is only a hint, kept up to twice the number of elements and the maximum capacity
+// Returns error if data is not a valid encoding or the elements exceed the maximum capacity,
+// leaving the list unchanged
+func (al *ArrayList[T]) UnmarshalBinary(data []byte) error {
+	decoded, err := decodeList[T](data)
+	if err != nil {
+		return err
+	}
+	n := len(decoded.Elements)
+	if _, err := al.growth.next(0, n); err != nil {
+		return err
+	}
+	capacity := max(n, min(decoded.Capacity, 2*n))
+	if limit := al.growth.maxCapacity; limit > 0 {
+		capacity = min(capacity, limit)
+	}
+	al.setElements(nil)
+	al.size = 0
+	if err := al.reserve(capacity); err != nil {
+		return err
+	}
+	al.size = copy(al.elements, decoded.Elements)
+	al.modCount++
+	return nil
+}
+
+// MarshalBinary encodes the linked list with encoding/gob
+// Elements must be encodable by gob
+func (ll *LinkedList[T]) MarshalBinary() ([]byte, error) {
+	return encodeList(binaryList[T]{Elements: ll.ToSlice()})
+}
+
+// UnmarshalBinary replaces the linked list with one decoded from data produced by MarshalBinary
+func (ll *LinkedList[T]) UnmarshalBinary(data []byte) error {
+	decoded, err := decodeList[T](data)
+	if err != nil {
+		return err
+	}
+	ll.Clear()
+	ll.AddSlice(decoded.Elements)
+	return nil
+}
+
+func encodeList[T any](payload binaryList[T]) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(payload); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeList[T any](data []byte) (binaryList[T], error) {
+	var decoded binaryList[T]
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&decoded)
+	return decoded, err
+}
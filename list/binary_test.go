@@ -0,0 +1,97 @@
+package list
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"testing"
+)
+
+func TestListBinaryRoundTrip(t *testing.T) {
+	al := NewArrayListWithCapacity[string](5)
+	al.AddSlice([]string{"a", "b", "c"})
+	data, err := al.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var decodedAl ArrayList[string]
+	if err := decodedAl.UnmarshalBinary(data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertElements[string](t, &decodedAl, []string{"a", "b", "c"})
+	if decodedAl.Capacity() != 5 {
+		t.Fatalf("expected capacity 5 got %d", decodedAl.Capacity())
+	}
+
+	ll := NewLinkedListFromSlice([]int{3, 1, 2})
+	data, err = ll.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	decodedLl := NewLinkedListFromSlice([]int{9})
+	if err := decodedLl.UnmarshalBinary(data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertElements[int](t, decodedLl, []int{3, 1, 2})
+
+	if err := decodedLl.UnmarshalBinary([]byte("garbage")); err == nil {
+		t.Fatalf("expected an error for invalid data")
+	}
+}
+
+func TestArrayListUnmarshalBinaryCapacity(t *testing.T) {
+	// A corrupt capacity is only a hint and cannot force a huge allocation
+	data, err := encodeList(binaryList[int]{Capacity: 1 << 62, Elements: []int{1, 2, 3}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var al ArrayList[int]
+	if err := al.UnmarshalBinary(data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertElements[int](t, &al, []int{1, 2, 3})
+	if al.Capacity() != 6 {
+		t.Fatalf("expected capacity 6 got %d", al.Capacity())
+	}
+
+	data, err = encodeList(binaryList[int]{Capacity: 100, Elements: []int{1, 2, 3, 4, 5, 6}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	bounded := New[int](WithMaxCapacity(3), WithElements(7))
+	if err := bounded.UnmarshalBinary(data); !errors.Is(err, ErrCapacityExceeded) {
+		t.Fatalf("expected ErrCapacityExceeded got %v", err)
+	}
+	assertElements[int](t, bounded, []int{7})
+
+	bounded = New[int](WithMaxCapacity(8))
+	if err := bounded.UnmarshalBinary(data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if bounded.Capacity() != 8 || bounded.Size() != 6 {
+		t.Fatalf("expected capacity 8 size 6 got %d %d", bounded.Capacity(), bounded.Size())
+	}
+	if err := bounded.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestListGob(t *testing.T) {
+	RegisterGob[int]()
+	type envelope struct {
+		List  *ArrayList[int]
+		Value any
+	}
+	in := envelope{List: NewArrayListFromSlice([]int{1, 2}), Value: NewLinkedListFromSlice([]int{3})}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var out envelope
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertElements[int](t, out.List, []int{1, 2})
+	assertElements[int](t, out.Value.(*LinkedList[int]), []int{3})
+}
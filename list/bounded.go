@@ -0,0 +1,254 @@
+package list
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/profoundwu/containers/compare"
+	"github.com/profoundwu/containers/internal/utils"
+)
+
+var (
+	ErrListFull = errors.New("bounded list is full")
+)
+
+// EvictionPolicy decides what a full BoundedList gives up to make room for a new element
+type EvictionPolicy[T any] interface {
+	// Victim returns the index of the element of l to evict for elem, or -1 to reject elem
+	Victim(l List[T], elem T) int
+}
+
+// EvictionFunc adapts a function to the EvictionPolicy interface
+type EvictionFunc[T any] func(l List[T], elem T) int
+
+// Victim calls f(l, elem)
+func (f EvictionFunc[T]) Victim(l List[T], elem T) int {
+	return f(l, elem)
+}
+
+// RejectNew returns a policy that keeps the elements already held and turns new ones away
+func RejectNew[T any]() EvictionPolicy[T] {
+	return EvictionFunc[T](func(List[T], T) int { return -1 })
+}
+
+// DropOldest returns a policy that evicts the first element, so the list behaves like a ring buffer
+func DropOldest[T any]() EvictionPolicy[T] {
+	return EvictionFunc[T](func(List[T], T) int { return 0 })
+}
+
+// DropLowestPriority returns a policy that evicts the lowest element by c, or rejects the new element
+// when no held element is lower than it
+// Finding the victim scans the list
+func DropLowestPriority[T any](c compare.Comparator[T]) EvictionPolicy[T] {
+	return EvictionFunc[T](func(l List[T], elem T) int {
+		victim, lowest := -1, elem
+		for i, v := range l.ToSlice() {
+			if c(v, lowest) < 0 {
+				victim, lowest = i, v
+			}
+		}
+		return victim
+	})
+}
+
+// BoundedList wraps a list and holds at most capacity elements, asking its policy what to give up
+// when an element is added to a full list
+// The backing list must only be changed through the wrapper
+type BoundedList[T any] struct {
+	inner    List[T]
+	capacity int
+	policy   EvictionPolicy[T]
+	onEvict  func(elem T)
+}
+
+var _ List[int] = (*BoundedList[int])(nil)
+
+// NewBoundedList creates a bounded list over inner holding at most capacity elements
+// Values of capacity below 1 fall back to the default capacity; elements beyond it already in inner
+// stay until they are removed
+func NewBoundedList[T any](inner List[T], capacity int, policy EvictionPolicy[T]) *BoundedList[T] {
+	if capacity < 1 {
+		capacity = utils.DefaultCapacity
+	}
+	return &BoundedList[T]{inner: inner, capacity: capacity, policy: policy}
+}
+
+// NewBoundedListWithEvict creates a bounded list like NewBoundedList that calls onEvict with every
+// element it gives up, whether evicted to make room or rejected by the policy
+func NewBoundedListWithEvict[T any](inner List[T], capacity int, policy EvictionPolicy[T], onEvict func(elem T)) *BoundedList[T] {
+	bl := NewBoundedList(inner, capacity, policy)
+	bl.onEvict = onEvict
+	return bl
+}
+
+// Capacity returns the maximum number of elements the list holds
+func (bl *BoundedList[T]) Capacity() int {
+	return bl.capacity
+}
+
+// IsFull checks if adding another element needs the policy to make room
+func (bl *BoundedList[T]) IsFull() bool {
+	return bl.inner.Size() >= bl.capacity
+}
+
+// Offer appends elem, evicting an element chosen by the policy if the list is full
+// Returns the evicted element and true, or the zero value and false if nothing was evicted
+// Returns error if the policy rejected elem
+func (bl *BoundedList[T]) Offer(elem T) (T, bool, error) {
+	evicted, victim, err := bl.makeRoom(elem)
+	if err != nil {
+		return evicted, false, err
+	}
+	bl.inner.AddLast(elem)
+	return evicted, victim >= 0, nil
+}
+
+// makeRoom evicts the element the policy picks for elem when the list is full
+// Returns the evicted element and its former index, or -1 if the list had room
+// Returns error if the policy rejected elem
+func (bl *BoundedList[T]) makeRoom(elem T) (T, int, error) {
+	var zero T
+	if !bl.IsFull() {
+		return zero, -1, nil
+	}
+	victim := bl.policy.Victim(bl.inner, elem)
+	if victim < 0 || victim >= bl.inner.Size() {
+		bl.evicted(elem)
+		return zero, -1, fmt.Errorf("%w: capacity %d", ErrListFull, bl.capacity)
+	}
+	removed, err := bl.inner.Remove(victim)
+	if err != nil {
+		return zero, -1, err
+	}
+	bl.evicted(removed)
+	return removed, victim, nil
+}
+
+func (bl *BoundedList[T]) evicted(elem T) {
+	if bl.onEvict != nil {
+		bl.onEvict(elem)
+	}
+}
+
+// Size returns the number of elements in the list
+func (bl *BoundedList[T]) Size() int {
+	return bl.inner.Size()
+}
+
+// IsEmpty checks if the list is empty
+func (bl *BoundedList[T]) IsEmpty() bool {
+	return bl.inner.IsEmpty()
+}
+
+// AddLast appends elem like Offer, dropping it if the policy rejects it
+func (bl *BoundedList[T]) AddLast(elem T) {
+	bl.Offer(elem)
+}
+
+// Add inserts elem at the specified index position, evicting an element chosen by the policy if
+// the list is full
+// An eviction before index shifts the insertion point along with the elements after the victim
+// Returns error if index is out of bounds or the policy rejected elem
+func (bl *BoundedList[T]) Add(index int, elem T) error {
+	size := bl.inner.Size()
+	if index < 0 || index > size {
+		return fmt.Errorf("%w: %d, list size: %d", ErrIndexOutOfBounds, index, size)
+	}
+	_, victim, err := bl.makeRoom(elem)
+	if err != nil {
+		return err
+	}
+	if victim >= 0 && victim < index {
+		index--
+	}
+	return bl.inner.Add(index, elem)
+}
+
+// Get returns the element at the specified index position
+// Returns error if index is out of bounds
+func (bl *BoundedList[T]) Get(index int) (T, error) {
+	return bl.inner.Get(index)
+}
+
+// GetFirst returns the first element of the list
+// Returns error if list is empty
+func (bl *BoundedList[T]) GetFirst() (T, error) {
+	return bl.inner.GetFirst()
+}
+
+// GetLast returns the last element of the list
+// Returns error if list is empty
+func (bl *BoundedList[T]) GetLast() (T, error) {
+	return bl.inner.GetLast()
+}
+
+// Set replaces the element at the specified index position
+// Returns error if index is out of bounds
+func (bl *BoundedList[T]) Set(index int, elem T) error {
+	return bl.inner.Set(index, elem)
+}
+
+// Remove deletes the element at the specified index position and returns its value
+// Returns error if index is out of bounds
+func (bl *BoundedList[T]) Remove(index int) (T, error) {
+	return bl.inner.Remove(index)
+}
+
+// RemoveFirst deletes and returns the first element of the list
+// Returns error if list is empty
+func (bl *BoundedList[T]) RemoveFirst() (T, error) {
+	return bl.inner.RemoveFirst()
+}
+
+// RemoveLast deletes and returns the last element of the list
+// Returns error if list is empty
+func (bl *BoundedList[T]) RemoveLast() (T, error) {
+	return bl.inner.RemoveLast()
+}
+
+// RemoveElement deletes the first occurrence of the specified element from the list
+// Returns true if element was found and removed, false otherwise
+func (bl *BoundedList[T]) RemoveElement(elem T) bool {
+	return bl.inner.RemoveElement(elem)
+}
+
+// Contains checks if the list contains the specified element
+func (bl *BoundedList[T]) Contains(elem T) bool {
+	return bl.inner.Contains(elem)
+}
+
+// IndexOf returns the first index of the specified element in the list
+// Returns -1 if element is not found
+func (bl *BoundedList[T]) IndexOf(elem T) int {
+	return bl.inner.IndexOf(elem)
+}
+
+// Clear removes all elements from the list without calling the eviction callback
+func (bl *BoundedList[T]) Clear() {
+	bl.inner.Clear()
+}
+
+// ToSlice converts the list to a slice
+func (bl *BoundedList[T]) ToSlice() []T {
+	return bl.inner.ToSlice()
+}
+
+// AppendTo appends the elements of the list to dst and returns the extended slice
+func (bl *BoundedList[T]) AppendTo(dst []T) []T {
+	return bl.inner.AppendTo(dst)
+}
+
+// Reverse reverses the list
+func (bl *BoundedList[T]) Reverse() {
+	bl.inner.Reverse()
+}
+
+// Join renders the elements of the list separated by sep
+func (bl *BoundedList[T]) Join(sep string) string {
+	return bl.inner.Join(sep)
+}
+
+// String returns a string representation of the list
+func (bl *BoundedList[T]) String() string {
+	return bl.inner.String()
+}
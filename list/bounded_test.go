@@ -0,0 +1,98 @@
+package list
+
+import (
+	"cmp"
+	"errors"
+	"testing"
+)
+
+func TestBoundedListDropOldest(t *testing.T) {
+	var evicted []int
+	bl := NewBoundedListWithEvict[int](NewArrayList[int](), 3, DropOldest[int](), func(v int) {
+		evicted = append(evicted, v)
+	})
+	for i := 1; i <= 3; i++ {
+		if _, ok, err := bl.Offer(i); ok || err != nil {
+			t.Fatalf("expected %d to fit got %v %v", i, ok, err)
+		}
+	}
+	if !bl.IsFull() || bl.Capacity() != 3 {
+		t.Fatalf("expected a full list of capacity 3 got %d", bl.Size())
+	}
+	old, ok, err := bl.Offer(4)
+	if err != nil || !ok || old != 1 {
+		t.Fatalf("expected 1 evicted got %d %v %v", old, ok, err)
+	}
+	bl.AddLast(5)
+	assertElements[int](t, bl, []int{3, 4, 5})
+
+	// Evicting the front shifts the insertion point with the elements after it
+	if err := bl.Add(2, 9); err != nil {
+		t.Fatalf("expected insert to succeed got %v", err)
+	}
+	assertElements[int](t, bl, []int{4, 9, 5})
+	if len(evicted) != 3 || evicted[2] != 3 {
+		t.Fatalf("expected evictions [1 2 3] got %v", evicted)
+	}
+	if err := bl.Add(7, 1); !errors.Is(err, ErrIndexOutOfBounds) || len(evicted) != 3 {
+		t.Fatalf("expected an out of bounds insert to evict nothing got %v %v", err, evicted)
+	}
+}
+
+func TestBoundedListRejectNew(t *testing.T) {
+	var rejected []string
+	bl := NewBoundedListWithEvict[string](NewLinkedList[string](), 2, RejectNew[string](), func(s string) {
+		rejected = append(rejected, s)
+	})
+	bl.AddLast("a")
+	bl.AddLast("b")
+	bl.AddLast("c")
+	if _, _, err := bl.Offer("d"); !errors.Is(err, ErrListFull) {
+		t.Fatalf("expected ErrListFull got %v", err)
+	}
+	if err := bl.Add(0, "e"); !errors.Is(err, ErrListFull) {
+		t.Fatalf("expected ErrListFull got %v", err)
+	}
+	assertElements[string](t, bl, []string{"a", "b"})
+	if len(rejected) != 3 || rejected[0] != "c" {
+		t.Fatalf("expected [c d e] rejected got %v", rejected)
+	}
+
+	bl.RemoveFirst()
+	if _, _, err := bl.Offer("f"); err != nil {
+		t.Fatalf("expected room after a removal got %v", err)
+	}
+	assertElements[string](t, bl, []string{"b", "f"})
+}
+
+func TestBoundedListDropLowestPriority(t *testing.T) {
+	bl := NewBoundedList[int](NewArrayList[int](), 3, DropLowestPriority[int](cmp.Compare[int]))
+	for _, v := range []int{5, 2, 8} {
+		bl.AddLast(v)
+	}
+	if old, ok, err := bl.Offer(6); err != nil || !ok || old != 2 {
+		t.Fatalf("expected 2 evicted got %d %v %v", old, ok, err)
+	}
+	if _, _, err := bl.Offer(1); !errors.Is(err, ErrListFull) {
+		t.Fatalf("expected the lowest new element rejected got %v", err)
+	}
+	assertElements[int](t, bl, []int{5, 8, 6})
+}
+
+func TestBoundedListEvictionFunc(t *testing.T) {
+	// Evict the newest element instead of the oldest
+	newest := EvictionFunc[int](func(l List[int], _ int) int { return l.Size() - 1 })
+	bl := NewBoundedList[int](NewArrayList[int](), 0, newest)
+	for i := 0; i < bl.Capacity()+2; i++ {
+		bl.AddLast(i)
+	}
+	if bl.Size() != bl.Capacity() {
+		t.Fatalf("expected size %d got %d", bl.Capacity(), bl.Size())
+	}
+	if v, _ := bl.GetLast(); v != bl.Capacity()+1 {
+		t.Fatalf("expected the last offer kept got %d", v)
+	}
+	if v, _ := bl.Get(bl.Capacity() - 2); v != bl.Capacity()-2 {
+		t.Fatalf("expected older elements kept got %d", v)
+	}
+}
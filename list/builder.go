@@ -0,0 +1,154 @@
+package list
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/profoundwu/containers/compare"
+)
+
+// SortedListBuilder accumulates elements cheaply and turns them into a read-only sorted list in one step,
+// for static data loaded at startup
+// Adding is amortized O(1) and Freeze sorts once, where adding to a SortedList shifts elements
+// on every insertion
+type SortedListBuilder[T any] struct {
+	elements []T
+	cmp      compare.Comparator[T]
+}
+
+// NewSortedListBuilder creates a new empty builder for a sorted list ordered by comparator
+func NewSortedListBuilder[T any](comparator compare.Comparator[T]) *SortedListBuilder[T] {
+	return &SortedListBuilder[T]{cmp: comparator}
+}
+
+// Size returns the number of elements added since the last Freeze
+func (b *SortedListBuilder[T]) Size() int {
+	return len(b.elements)
+}
+
+// Grow makes room for at least n more elements, so the next n additions do not reallocate
+func (b *SortedListBuilder[T]) Grow(n int) {
+	if n > 0 {
+		b.elements = slices.Grow(b.elements, n)
+	}
+}
+
+// Add appends elems in any order
+func (b *SortedListBuilder[T]) Add(elems ...T) {
+	b.elements = append(b.elements, elems...)
+}
+
+// Freeze sorts the added elements into a new read-only sorted list and empties the builder
+// Elements that compare equal keep the order they were added in
+// The list takes over the builder's storage without copying, and later additions do not affect it
+func (b *SortedListBuilder[T]) Freeze() *FrozenSortedList[T] {
+	return &FrozenSortedList[T]{list: b.build()}
+}
+
+// FreezeDistinct sorts the added elements into a new read-only sorted list like Freeze, keeping
+// only the first added of the elements that compare equal
+func (b *SortedListBuilder[T]) FreezeDistinct() *FrozenSortedList[T] {
+	sl := b.build()
+	sl.elements = slices.CompactFunc(sl.elements, func(x, y T) bool { return sl.cmp(x, y) == 0 })
+	return &FrozenSortedList[T]{list: sl}
+}
+
+// build sorts the added elements into a sorted list taking over the builder's storage
+func (b *SortedListBuilder[T]) build() *SortedList[T] {
+	elements := b.elements
+	b.elements = nil
+	slices.SortStableFunc(elements, b.cmp)
+	return &SortedList[T]{elements: elements, cmp: b.cmp}
+}
+
+// FrozenSortedList is a read-only sorted list produced by SortedListBuilder
+// It has no mutating methods, so it can be shared freely once built; use Thaw for a mutable copy
+type FrozenSortedList[T any] struct {
+	list *SortedList[T]
+}
+
+// Size returns the number of elements in the list
+func (fl *FrozenSortedList[T]) Size() int {
+	return fl.list.Size()
+}
+
+// IsEmpty checks if the list is empty
+func (fl *FrozenSortedList[T]) IsEmpty() bool {
+	return fl.list.IsEmpty()
+}
+
+// Get returns the element at the specified index position
+// Returns error if index is out of bounds
+func (fl *FrozenSortedList[T]) Get(index int) (T, error) {
+	return fl.list.Get(index)
+}
+
+// GetFirst returns the smallest element
+// Returns error if list is empty
+func (fl *FrozenSortedList[T]) GetFirst() (T, error) {
+	return fl.list.GetFirst()
+}
+
+// GetLast returns the largest element
+// Returns error if list is empty
+func (fl *FrozenSortedList[T]) GetLast() (T, error) {
+	return fl.list.GetLast()
+}
+
+// First returns the smallest element
+// Returns false if the list is empty
+func (fl *FrozenSortedList[T]) First() (T, bool) {
+	return fl.list.First()
+}
+
+// Last returns the largest element
+// Returns false if the list is empty
+func (fl *FrozenSortedList[T]) Last() (T, bool) {
+	return fl.list.Last()
+}
+
+// Contains checks if the list holds an element comparing equal to elem
+func (fl *FrozenSortedList[T]) Contains(elem T) bool {
+	return fl.list.Contains(elem)
+}
+
+// IndexOf returns the index of the first element comparing equal to elem
+// Returns -1 if no such element exists
+func (fl *FrozenSortedList[T]) IndexOf(elem T) int {
+	return fl.list.IndexOf(elem)
+}
+
+// Range returns a copy of the elements in the inclusive range [from, to] in sorted order
+func (fl *FrozenSortedList[T]) Range(from, to T) []T {
+	return fl.list.Range(from, to)
+}
+
+// Values returns a sequence of the elements in sorted order
+func (fl *FrozenSortedList[T]) Values() iter.Seq[T] {
+	return slices.Values(fl.list.elements)
+}
+
+// ToSlice returns a copy of the elements
+func (fl *FrozenSortedList[T]) ToSlice() []T {
+	return fl.list.ToSlice()
+}
+
+// AppendTo appends the elements to dst and returns the extended slice
+func (fl *FrozenSortedList[T]) AppendTo(dst []T) []T {
+	return fl.list.AppendTo(dst)
+}
+
+// Thaw returns a mutable copy of the list
+func (fl *FrozenSortedList[T]) Thaw() *SortedList[T] {
+	return &SortedList[T]{elements: slices.Clone(fl.list.elements), cmp: fl.list.cmp}
+}
+
+// Join renders the elements separated by sep
+func (fl *FrozenSortedList[T]) Join(sep string) string {
+	return fl.list.Join(sep)
+}
+
+// String returns a string representation of the list
+func (fl *FrozenSortedList[T]) String() string {
+	return fl.list.String()
+}
@@ -0,0 +1,56 @@
+package list
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/profoundwu/containers/compare"
+)
+
+func TestSortedListBuilder(t *testing.T) {
+	b := NewSortedListBuilder(compare.Natural[int]())
+	b.Grow(8)
+	b.Add(5, 1, 4)
+	b.Add(1, 3)
+	if b.Size() != 5 {
+		t.Fatalf("expected 5 elements got %d", b.Size())
+	}
+	fl := b.Freeze()
+	if fl.String() != "[1, 1, 3, 4, 5]" || b.Size() != 0 {
+		t.Fatalf("expected [1, 1, 3, 4, 5] and an empty builder got %s %d", fl, b.Size())
+	}
+	if fl.IndexOf(3) != 2 || !slices.Equal(fl.Range(2, 4), []int{3, 4}) {
+		t.Fatalf("unexpected lookups on %s", fl)
+	}
+	if !slices.Equal(slices.Collect(fl.Values()), []int{1, 1, 3, 4, 5}) {
+		t.Fatalf("expected values in sorted order")
+	}
+
+	// Later additions do not reach the frozen list
+	b.Add(2)
+	if fl.Contains(2) {
+		t.Fatalf("expected the frozen list to be independent of the builder")
+	}
+
+	// Thawing copies, so changing the copy leaves the frozen list as it was
+	sl := fl.Thaw()
+	sl.Add(2)
+	if sl.Size() != 6 || fl.Size() != 5 {
+		t.Fatalf("expected a mutable copy got %s %s", sl, fl)
+	}
+	if err := sl.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestSortedListBuilderFreezeDistinct(t *testing.T) {
+	b := NewSortedListBuilder(compare.Comparator[string](func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	}))
+	b.Add("b", "A", "a", "B", "c")
+	fl := b.FreezeDistinct()
+	if fl.String() != "[A, b, c]" {
+		t.Fatalf("expected the first of equal elements kept got %s", fl)
+	}
+}
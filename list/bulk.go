@@ -0,0 +1,336 @@
+package list
+
+import "fmt"
+
+// AddSlice appends every element of slice, growing the array at most once
+// Panics with ErrCapacityExceeded if the elements exceed the maximum capacity; use InsertAll at
+// index Size for an error result instead
+func (al *ArrayList[T]) AddSlice(slice []T) {
+	al.Grow(len(slice))
+	copy(al.elements[al.size:], slice)
+	al.size += len(slice)
+	al.modCount++
+}
+
+// AddAll appends every element of other in order, growing the array at most once
+// Panics with ErrCapacityExceeded if the elements exceed the maximum capacity
+func (al *ArrayList[T]) AddAll(other List[T]) {
+	al.Grow(other.Size())
+	al.size = len(other.AppendTo(al.elements[:al.size]))
+	al.modCount++
+}
+
+// InsertAll inserts elems at the specified index position, shifting the following elements once
+// Returns error if index is out of bounds or the elements exceed the maximum capacity
+func (al *ArrayList[T]) InsertAll(index int, elems ...T) error {
+	if index < 0 || index > al.size {
+		return fmt.Errorf("%w: %d, list size: %d", ErrIndexOutOfBounds, index, al.size)
+	}
+	if err := al.reserve(al.size + len(elems)); err != nil {
+		return err
+	}
+
+	copy(al.elements[index+len(elems):], al.elements[index:al.size])
+	copy(al.elements[index:], elems)
+	al.size += len(elems)
+	al.modCount++
+	return nil
+}
+
+// AddSlice appends every element of slice as one pre-built chain of nodes
+func (ll *LinkedList[T]) AddSlice(slice []T) {
+	ll.spliceSlice(slice)
+}
+
+// AddAll appends every element of other in order
+func (ll *LinkedList[T]) AddAll(other List[T]) {
+	if al, ok := other.(*ArrayList[T]); ok {
+		ll.spliceSlice(al.elements[:al.size])
+		return
+	}
+	// Snapshot first so appending a list to itself terminates
+	ll.spliceSlice(other.ToSlice())
+}
+
+// InsertAll inserts elems at the specified index position, linking them in as one chain
+// Returns error if index is out of bounds
+func (ll *LinkedList[T]) InsertAll(index int, elems ...T) error {
+	if index < 0 || index > ll.size {
+		return fmt.Errorf("%w: %d, list size: %d", ErrIndexOutOfBounds, index, ll.size)
+	}
+	if len(elems) == 0 {
+		return nil
+	}
+
+	switch {
+	case index == ll.size:
+		ll.spliceSlice(elems)
+	case index == 0:
+		first, last := ll.buildChain(elems)
+		last.next = ll.head
+		ll.head = first
+		ll.size += len(elems)
+		ll.modCount++
+		ll.cursorIndex += len(elems)
+	default:
+		// The cursor stays on prev, which keeps its index
+		prev := ll.nodeAt(index - 1)
+		first, last := ll.buildChain(elems)
+		last.next = prev.next
+		prev.next = first
+		ll.size += len(elems)
+		ll.modCount++
+	}
+	return nil
+}
+
+// RemoveRange removes the elements in [from, to), shifting the following elements once
+// Returns the number of elements removed, or error if the range is not within the list
+func (al *ArrayList[T]) RemoveRange(from, to int) (int, error) {
+	if from < 0 || to > al.size || from > to {
+		return 0, fmt.Errorf("%w: [%d, %d), list size: %d", ErrIndexOutOfBounds, from, to, al.size)
+	}
+	if from == to {
+		return 0, nil
+	}
+
+	copy(al.elements[from:], al.elements[to:al.size])
+	removed := to - from
+	// Clear references to help garbage collection
+	clear(al.elements[al.size-removed : al.size])
+	al.size -= removed
+	al.modCount++
+	return removed, nil
+}
+
+// RemoveRange removes the elements in [from, to), unlinking them as one chain
+// Returns the number of elements removed, or error if the range is not within the list
+func (ll *LinkedList[T]) RemoveRange(from, to int) (int, error) {
+	if from < 0 || to > ll.size || from > to {
+		return 0, fmt.Errorf("%w: [%d, %d), list size: %d", ErrIndexOutOfBounds, from, to, ll.size)
+	}
+	if from == to {
+		return 0, nil
+	}
+
+	var prev *node[T]
+	first := ll.head
+	if from > 0 {
+		prev = ll.nodeAt(from - 1)
+		first = prev.next
+	}
+	cur := first
+	for i := from; i < to; i++ {
+		next := cur.next
+		ll.freeNode(cur)
+		cur = next
+	}
+	if prev == nil {
+		ll.head = cur
+		ll.cursor = nil
+	} else {
+		// The cursor stays on prev, which keeps its index
+		prev.next = cur
+	}
+	if cur == nil {
+		ll.tail = prev
+	}
+	removed := to - from
+	ll.size -= removed
+	ll.modCount++
+	return removed, nil
+}
+
+// ContainsAll checks if every element of other is present in the array list
+func (al *ArrayList[T]) ContainsAll(other List[T]) bool {
+	return containsAll[T](al.equality, al, other)
+}
+
+// RemoveAll removes every element that is present in other, compacting the array in one pass
+// Returns the number of elements removed
+func (al *ArrayList[T]) RemoveAll(other List[T]) int {
+	set := al.equality.setOf(other)
+	return al.RemoveIf(set.contains)
+}
+
+// RetainAll removes every element that is not present in other, compacting the array in one pass
+// Returns the number of elements removed
+func (al *ArrayList[T]) RetainAll(other List[T]) int {
+	set := al.equality.setOf(other)
+	return al.RemoveIf(func(elem T) bool {
+		return !set.contains(elem)
+	})
+}
+
+// ContainsAll checks if every element of other is present in the linked list
+func (ll *LinkedList[T]) ContainsAll(other List[T]) bool {
+	return containsAll[T](ll.equality, ll, other)
+}
+
+// RemoveAll removes every element that is present in other in a single traversal
+// Returns the number of elements removed
+func (ll *LinkedList[T]) RemoveAll(other List[T]) int {
+	set := ll.equality.setOf(other)
+	return ll.RemoveIf(set.contains)
+}
+
+// RetainAll removes every element that is not present in other in a single traversal
+// Returns the number of elements removed
+func (ll *LinkedList[T]) RetainAll(other List[T]) int {
+	set := ll.equality.setOf(other)
+	return ll.RemoveIf(func(elem T) bool {
+		return !set.contains(elem)
+	})
+}
+
+// RemoveIf removes every element matching pred, moving the survivors down in a single pass
+// Returns the number of elements removed
+func (al *ArrayList[T]) RemoveIf(pred func(elem T) bool) int {
+	kept := 0
+	for i := 0; i < al.size; i++ {
+		if !pred(al.elements[i]) {
+			al.elements[kept] = al.elements[i]
+			kept++
+		}
+	}
+	removed := al.size - kept
+	// Clear references to help garbage collection
+	clear(al.elements[kept:al.size])
+	al.size = kept
+	if removed > 0 {
+		al.modCount++
+	}
+	return removed
+}
+
+// RemoveIf unlinks every node whose element matches pred in a single traversal
+// Returns the number of elements removed
+func (ll *LinkedList[T]) RemoveIf(pred func(elem T) bool) int {
+	removed := 0
+	var prev *node[T]
+	for cur := ll.head; cur != nil; {
+		next := cur.next
+		if pred(cur.value) {
+			if prev == nil {
+				ll.head = next
+			} else {
+				prev.next = next
+			}
+			ll.freeNode(cur)
+			removed++
+		} else {
+			prev = cur
+		}
+		cur = next
+	}
+	ll.tail = prev
+	ll.size -= removed
+	if removed > 0 {
+		ll.modCount++
+		ll.cursor = nil
+	}
+	return removed
+}
+
+// ReplaceAll replaces every element equal to oldElem with newElem in place
+// Returns the number of elements replaced
+func (al *ArrayList[T]) ReplaceAll(oldElem, newElem T) int {
+	replaced := 0
+	for i, v := range al.elements[:al.size] {
+		if al.equality.eq(v, oldElem) {
+			al.elements[i] = newElem
+			replaced++
+		}
+	}
+	return replaced
+}
+
+// ReplaceAllFunc replaces every element with the result of fn applied to it, in place and in order
+func (al *ArrayList[T]) ReplaceAllFunc(fn func(elem T) T) {
+	for i, v := range al.elements[:al.size] {
+		al.elements[i] = fn(v)
+	}
+}
+
+// ReplaceAll replaces every element equal to oldElem with newElem in a single traversal
+// Returns the number of elements replaced
+func (ll *LinkedList[T]) ReplaceAll(oldElem, newElem T) int {
+	replaced := 0
+	for cur := ll.head; cur != nil; cur = cur.next {
+		if ll.equality.eq(cur.value, oldElem) {
+			cur.value = newElem
+			replaced++
+		}
+	}
+	return replaced
+}
+
+// ReplaceAllFunc replaces every element with the result of fn applied to it, in a single traversal
+func (ll *LinkedList[T]) ReplaceAllFunc(fn func(elem T) T) {
+	for cur := ll.head; cur != nil; cur = cur.next {
+		cur.value = fn(cur.value)
+	}
+}
+
+// predicateRemover is implemented by the lists that can drop elements in a single pass
+type predicateRemover[T any] interface {
+	RemoveIf(pred func(elem T) bool) int
+}
+
+// Distinct removes every repeated element in place, keeping first occurrences in their original order
+// Returns the number of elements removed
+func (al *ArrayList[T]) Distinct() int {
+	return distinct(al, al.equality, al.size)
+}
+
+// Distinct removes every repeated element in place, keeping first occurrences in their original order
+// Returns the number of elements removed
+func (ll *LinkedList[T]) Distinct() int {
+	return distinct(ll, ll.equality, ll.size)
+}
+
+// DistinctFunc removes in place every element whose key was already produced by an earlier element,
+// keeping first occurrences in their original order, in a single pass over l
+// Returns the number of elements removed
+func DistinctFunc[T any, K comparable](l predicateRemover[T], key func(elem T) K) int {
+	seen := make(map[K]struct{})
+	return l.RemoveIf(func(elem T) bool {
+		k := key(elem)
+		if _, ok := seen[k]; ok {
+			return true
+		}
+		seen[k] = struct{}{}
+		return false
+	})
+}
+
+// distinct removes repeated elements of l as decided by e
+func distinct[T any](l predicateRemover[T], e equality[T], size int) int {
+	seen := e.set(size)
+	return l.RemoveIf(func(elem T) bool {
+		return !seen.add(elem)
+	})
+}
+
+// setOf collects the elements of l for membership checks, which take constant time unless
+// elements are compared with a custom function
+func (e equality[T]) setOf(l List[T]) elementSet[T] {
+	set := e.set(l.Size())
+	forEach(l, func(v T) {
+		set.add(v)
+	})
+	return set
+}
+
+func containsAll[T any](e equality[T], l, other List[T]) bool {
+	if other.IsEmpty() {
+		return true
+	}
+	set := e.setOf(l)
+	for _, v := range other.ToSlice() {
+		if !set.contains(v) {
+			return false
+		}
+	}
+	return true
+}
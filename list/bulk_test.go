@@ -0,0 +1,230 @@
+package list
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestArrayListBulkAdd(t *testing.T) {
+	al := NewArrayListWithCapacity[int](2)
+	al.AddSlice([]int{1, 2, 3})
+	al.AddAll(NewLinkedListFromSlice([]int{4, 5}))
+	assertElements(t, al, []int{1, 2, 3, 4, 5})
+
+	al.AddAll(al)
+	assertElements(t, al, []int{1, 2, 3, 4, 5, 1, 2, 3, 4, 5})
+
+	if err := al.InsertAll(1, 7, 8); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertElements(t, al, []int{1, 7, 8, 2, 3, 4, 5, 1, 2, 3, 4, 5})
+	if err := al.InsertAll(13, 0); !errors.Is(err, ErrIndexOutOfBounds) {
+		t.Fatalf("expected ErrIndexOutOfBounds got %v", err)
+	}
+}
+
+func TestArrayListInsertAllGrowsOnce(t *testing.T) {
+	al := NewArrayListWithCapacity[int](4)
+	al.AddSlice([]int{1, 2})
+	al.InsertAll(1, make([]int, 100)...)
+	if al.Capacity() != 102 || al.Size() != 102 {
+		t.Fatalf("expected a single grow to 102 got capacity %d size %d", al.Capacity(), al.Size())
+	}
+	if v, _ := al.GetLast(); v != 2 {
+		t.Fatalf("expected tail element 2 got %d", v)
+	}
+}
+
+func TestArrayListBulkAddBounded(t *testing.T) {
+	al := New[int](WithMaxCapacity(3), WithElements(1))
+	assertPanicsWith(t, ErrCapacityExceeded, func() { al.AddSlice([]int{2, 3, 4}) })
+	assertPanicsWith(t, ErrCapacityExceeded, func() { al.AddAll(NewArrayListFromSlice([]int{2, 3, 4})) })
+	assertElements(t, al, []int{1})
+	if err := al.InsertAll(al.Size(), 2, 3, 4); !errors.Is(err, ErrCapacityExceeded) {
+		t.Fatalf("expected ErrCapacityExceeded got %v", err)
+	}
+}
+
+func TestLinkedListBulkAdd(t *testing.T) {
+	ll := NewLinkedList[int]()
+	ll.AddSlice([]int{1, 2})
+	ll.AddAll(NewArrayListFromSlice([]int{3, 4}))
+	ll.AddAll(ll)
+	assertElements(t, ll, []int{1, 2, 3, 4, 1, 2, 3, 4})
+
+	// Position the cursor, then insert before, at and after it
+	ll.Get(3)
+	ll.InsertAll(0, 10, 11)
+	ll.InsertAll(5, 20)
+	ll.InsertAll(ll.Size(), 30, 31)
+	assertElements(t, ll, []int{10, 11, 1, 2, 3, 20, 4, 1, 2, 3, 4, 30, 31})
+	if last, _ := ll.GetLast(); last != 31 {
+		t.Fatalf("expected tail 31 got %d", last)
+	}
+	if err := ll.InsertAll(-1, 0); !errors.Is(err, ErrIndexOutOfBounds) {
+		t.Fatalf("expected ErrIndexOutOfBounds got %v", err)
+	}
+	if err := ll.InsertAll(2); err != nil || ll.Size() != 13 {
+		t.Fatalf("inserting nothing should be a no-op")
+	}
+}
+
+func TestListRemoveRetainContainsAll(t *testing.T) {
+	for name, newList := range map[string]func([]int) List[int]{
+		"array":  func(s []int) List[int] { return NewArrayListFromSlice(s) },
+		"linked": func(s []int) List[int] { return NewLinkedListFromSlice(s) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			type collection interface {
+				List[int]
+				RemoveAll(other List[int]) int
+				RetainAll(other List[int]) int
+				ContainsAll(other List[int]) bool
+			}
+			l := newList([]int{1, 2, 3, 2, 4, 5}).(collection)
+			other := NewArrayListFromSlice([]int{2, 5, 9})
+
+			if l.ContainsAll(other) || !l.ContainsAll(NewArrayListFromSlice([]int{5, 1})) {
+				t.Fatalf("unexpected ContainsAll results")
+			}
+			if !l.ContainsAll(NewArrayList[int]()) {
+				t.Fatalf("every list contains the empty list")
+			}
+
+			if n := l.RemoveAll(other); n != 3 {
+				t.Fatalf("expected 3 removed got %d", n)
+			}
+			assertElements(t, l, []int{1, 3, 4})
+			l.AddLast(6)
+			if last, _ := l.GetLast(); last != 6 {
+				t.Fatalf("tail should be intact after removal got %d", last)
+			}
+
+			if n := l.RetainAll(NewLinkedListFromSlice([]int{4, 6, 7})); n != 2 {
+				t.Fatalf("expected 2 removed got %d", n)
+			}
+			assertElements(t, l, []int{4, 6})
+			if n := l.RetainAll(NewArrayList[int]()); n != 2 || !l.IsEmpty() {
+				t.Fatalf("retaining nothing should empty the list")
+			}
+			l.AddLast(1)
+			assertElements(t, l, []int{1})
+		})
+	}
+}
+
+func TestListRemoveIf(t *testing.T) {
+	for name, newList := range map[string]func([]int) List[int]{
+		"array":  func(s []int) List[int] { return NewArrayListFromSlice(s) },
+		"linked": func(s []int) List[int] { return NewLinkedListFromSlice(s) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			l := newList([]int{2, 1, 4, 3, 6, 8}).(interface {
+				List[int]
+				RemoveIf(pred func(int) bool) int
+			})
+			even := func(v int) bool { return v%2 == 0 }
+
+			if n := l.RemoveIf(even); n != 4 {
+				t.Fatalf("expected 4 removed got %d", n)
+			}
+			assertElements(t, l, []int{1, 3})
+			if n := l.RemoveIf(even); n != 0 {
+				t.Fatalf("expected 0 removed got %d", n)
+			}
+			l.AddLast(5)
+			if last, _ := l.GetLast(); last != 5 {
+				t.Fatalf("tail should be intact after removal got %d", last)
+			}
+			if n := l.RemoveIf(func(int) bool { return true }); n != 3 || !l.IsEmpty() {
+				t.Fatalf("expected list to be emptied got %v", l.ToSlice())
+			}
+		})
+	}
+}
+
+func TestListDistinct(t *testing.T) {
+	al := NewArrayListFromSlice([]int{3, 1, 3, 2, 1, 3})
+	if n := al.Distinct(); n != 3 {
+		t.Fatalf("expected 3 removed got %d", n)
+	}
+	assertElements[int](t, al, []int{3, 1, 2})
+
+	ll := NewLinkedListFromSlice([]string{"Go", "go", "Rust", "GO", "rust", "Zig"})
+	n := DistinctFunc(ll, strings.ToLower)
+	if n != 3 {
+		t.Fatalf("expected 3 removed got %d", n)
+	}
+	assertElements[string](t, ll, []string{"Go", "Rust", "Zig"})
+	if ll.Distinct() != 0 {
+		t.Fatalf("expected no duplicates left")
+	}
+	ll.AddLast("Go")
+	assertElements[string](t, ll, []string{"Go", "Rust", "Zig", "Go"})
+}
+
+func TestListReplaceAll(t *testing.T) {
+	for name, newList := range map[string]func([]int) List[int]{
+		"array":  func(s []int) List[int] { return NewArrayListFromSlice(s) },
+		"linked": func(s []int) List[int] { return NewLinkedListFromSlice(s) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			l := newList([]int{1, 2, 1, 3, 1}).(interface {
+				List[int]
+				ReplaceAll(oldElem, newElem int) int
+				ReplaceAllFunc(fn func(int) int)
+			})
+
+			if n := l.ReplaceAll(1, 9); n != 3 {
+				t.Fatalf("expected 3 replaced got %d", n)
+			}
+			assertElements(t, l, []int{9, 2, 9, 3, 9})
+			if n := l.ReplaceAll(4, 0); n != 0 {
+				t.Fatalf("expected 0 replaced got %d", n)
+			}
+
+			l.ReplaceAllFunc(func(v int) int { return v * 10 })
+			assertElements(t, l, []int{90, 20, 90, 30, 90})
+		})
+	}
+}
+
+func TestListRemoveRange(t *testing.T) {
+	for name, newList := range map[string]func([]int) List[int]{
+		"array":  func(s []int) List[int] { return NewArrayListFromSlice(s) },
+		"linked": func(s []int) List[int] { return NewLinkedListFromSlice(s) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			l := newList([]int{0, 1, 2, 3, 4, 5, 6, 7}).(interface {
+				List[int]
+				RemoveRange(from, to int) (int, error)
+			})
+
+			if n, err := l.RemoveRange(2, 5); err != nil || n != 3 {
+				t.Fatalf("expected 3 removed got %d, %v", n, err)
+			}
+			assertElements(t, l, []int{0, 1, 5, 6, 7})
+			if n, err := l.RemoveRange(3, 5); err != nil || n != 2 {
+				t.Fatalf("expected 2 removed got %d, %v", n, err)
+			}
+			l.AddLast(8)
+			assertElements(t, l, []int{0, 1, 5, 8})
+			if n, err := l.RemoveRange(0, 2); err != nil || n != 2 {
+				t.Fatalf("expected 2 removed got %d, %v", n, err)
+			}
+			assertElements(t, l, []int{5, 8})
+			if n, _ := l.RemoveRange(1, 1); n != 0 {
+				t.Fatalf("expected an empty range to remove nothing got %d", n)
+			}
+			for _, r := range [][2]int{{-1, 1}, {1, 3}, {2, 1}} {
+				if _, err := l.RemoveRange(r[0], r[1]); !errors.Is(err, ErrIndexOutOfBounds) {
+					t.Fatalf("expected ErrIndexOutOfBounds for %v got %v", r, err)
+				}
+			}
+			l.RemoveRange(0, 2)
+			l.AddLast(9)
+			assertElements(t, l, []int{9})
+		})
+	}
+}
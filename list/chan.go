@@ -0,0 +1,59 @@
+package list
+
+import (
+	"context"
+	"iter"
+)
+
+// ToChan streams the elements of the array list in order over an unbuffered channel, which is closed
+// after the last element or once ctx is done
+// The list must not be modified until the channel is closed
+func (al *ArrayList[T]) ToChan(ctx context.Context) <-chan T {
+	return toChan(ctx, al.Values())
+}
+
+// ToChan streams the elements of the linked list in order over an unbuffered channel, which is closed
+// after the last element or once ctx is done
+// The list must not be modified until the channel is closed
+func (ll *LinkedList[T]) ToChan(ctx context.Context) <-chan T {
+	return toChan(ctx, ll.Values())
+}
+
+func toChan[T any](ctx context.Context, seq iter.Seq[T]) <-chan T {
+	ch := make(chan T)
+	go func() {
+		defer close(ch)
+		for v := range seq {
+			select {
+			case ch <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+// CollectFromChan builds an array list from the values received on ch until it is closed
+// Returns the values received so far and the context's error if ctx is done first
+func CollectFromChan[T comparable](ctx context.Context, ch <-chan T) (*ArrayList[T], error) {
+	return CollectFromChanWithSizeHint(ctx, ch, 0)
+}
+
+// CollectFromChanWithSizeHint builds an array list like CollectFromChan, allocating room for hint
+// values up front
+// Values of hint below 1 fall back to the default capacity
+func CollectFromChanWithSizeHint[T comparable](ctx context.Context, ch <-chan T, hint int) (*ArrayList[T], error) {
+	al := NewArrayListWithCapacity[T](hint)
+	for {
+		select {
+		case v, ok := <-ch:
+			if !ok {
+				return al, nil
+			}
+			al.AddLast(v)
+		case <-ctx.Done():
+			return al, ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package list
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestListChannels(t *testing.T) {
+	ctx := context.Background()
+	al := NewArrayListFromSlice([]int{1, 2, 3})
+	collected, err := CollectFromChan(ctx, al.ToChan(ctx))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertElements[int](t, collected, []int{1, 2, 3})
+
+	ll := NewLinkedListFromSlice([]string{"a", "b", "c"})
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := ll.ToChan(ctx)
+	if v := <-ch; v != "a" {
+		t.Fatalf("expected a got %s", v)
+	}
+	cancel()
+	// The producer stops once the context is done, so the channel drains and closes
+	for range ch {
+	}
+
+	pending := make(chan int, 2)
+	pending <- 7
+	collected, err = CollectFromChan(ctx, pending)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled got %v", err)
+	}
+	if collected.Size() > 1 {
+		t.Fatalf("expected at most the buffered value got %v", collected)
+	}
+}
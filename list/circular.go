@@ -0,0 +1,293 @@
+package list
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrInvalidStep = errors.New("step must be positive")
+)
+
+// CircularLinkedList is a singly linked list whose tail links back to its head
+// Only the tail is stored, the head is always tail.next, so rotating the list is just moving the tail
+type CircularLinkedList[T comparable] struct {
+	tail   *node[T]
+	size   int
+	format FormatFunc[T]
+}
+
+// NewCircularLinkedList creates a new empty circular linked list
+func NewCircularLinkedList[T comparable]() *CircularLinkedList[T] {
+	return &CircularLinkedList[T]{}
+}
+
+// NewCircularLinkedListFromSlice creates a circular linked list from a slice
+func NewCircularLinkedListFromSlice[T comparable](slice []T) *CircularLinkedList[T] {
+	cl := NewCircularLinkedList[T]()
+	for _, v := range slice {
+		cl.AddLast(v)
+	}
+	return cl
+}
+
+// Size returns the number of elements in the list
+func (cl *CircularLinkedList[T]) Size() int {
+	return cl.size
+}
+
+// IsEmpty checks if the list is empty
+func (cl *CircularLinkedList[T]) IsEmpty() bool {
+	return cl.size == 0
+}
+
+// AddFirst adds an element before the head of the list
+func (cl *CircularLinkedList[T]) AddFirst(elem T) {
+	n := &node[T]{value: elem}
+	if cl.tail == nil {
+		n.next = n
+		cl.tail = n
+	} else {
+		n.next = cl.tail.next
+		cl.tail.next = n
+	}
+	cl.size++
+}
+
+// AddLast adds an element after the tail of the list
+func (cl *CircularLinkedList[T]) AddLast(elem T) {
+	cl.AddFirst(elem)
+	cl.tail = cl.tail.next
+}
+
+// Get returns the element at the specified index position, counted from the head
+// Returns error if index is out of bounds
+func (cl *CircularLinkedList[T]) Get(index int) (T, error) {
+	if index < 0 || index >= cl.size {
+		var zero T
+		return zero, fmt.Errorf("%w: %d, list size: %d", ErrIndexOutOfBounds, index, cl.size)
+	}
+	cur := cl.tail.next
+	for i := 0; i < index; i++ {
+		cur = cur.next
+	}
+	return cur.value, nil
+}
+
+// GetFirst returns the head element
+// Returns error if the list is empty
+func (cl *CircularLinkedList[T]) GetFirst() (T, error) {
+	if cl.size == 0 {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	return cl.tail.next.value, nil
+}
+
+// GetLast returns the tail element
+// Returns error if the list is empty
+func (cl *CircularLinkedList[T]) GetLast() (T, error) {
+	if cl.size == 0 {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	return cl.tail.value, nil
+}
+
+// RemoveFirst removes and returns the head element
+// Returns error if the list is empty
+func (cl *CircularLinkedList[T]) RemoveFirst() (T, error) {
+	if cl.size == 0 {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	return cl.removeAfter(cl.tail), nil
+}
+
+// RemoveElement removes the first occurrence of the specified element
+// Returns true if the element was found and removed, false otherwise
+func (cl *CircularLinkedList[T]) RemoveElement(elem T) bool {
+	prev := cl.tail
+	for i := 0; i < cl.size; i++ {
+		if prev.next.value == elem {
+			cl.removeAfter(prev)
+			return true
+		}
+		prev = prev.next
+	}
+	return false
+}
+
+// Contains checks if the list contains the specified element
+func (cl *CircularLinkedList[T]) Contains(elem T) bool {
+	return cl.IndexOf(elem) != -1
+}
+
+// IndexOf returns the index of the first occurrence of the specified element, counted from the head
+// Returns -1 if the element is not found
+func (cl *CircularLinkedList[T]) IndexOf(elem T) int {
+	if cl.size == 0 {
+		return -1
+	}
+	cur := cl.tail.next
+	for i := 0; i < cl.size; i++ {
+		if cur.value == elem {
+			return i
+		}
+		cur = cur.next
+	}
+	return -1
+}
+
+// Rotate moves the head n positions forward, so the element at index n becomes the head
+// Negative n rotates backwards and n wraps around the size of the list
+func (cl *CircularLinkedList[T]) Rotate(n int) {
+	if cl.size == 0 {
+		return
+	}
+	n %= cl.size
+	if n < 0 {
+		n += cl.size
+	}
+	for i := 0; i < n; i++ {
+		cl.tail = cl.tail.next
+	}
+}
+
+// Josephus removes every step-th element, counting around the circle from the head and
+// continuing after each removal, until the list is empty
+// Returns the elements in the order they were removed
+// Returns error if step is less than 1
+func (cl *CircularLinkedList[T]) Josephus(step int) ([]T, error) {
+	if step < 1 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidStep, step)
+	}
+	removed := make([]T, 0, cl.size)
+	c := cl.Cursor()
+	for !cl.IsEmpty() {
+		c.Advance(step - 1)
+		v, _ := c.Remove()
+		removed = append(removed, v)
+	}
+	return removed, nil
+}
+
+// Clear removes all elements from the list
+func (cl *CircularLinkedList[T]) Clear() {
+	if cl.tail != nil {
+		// Break the cycle to help garbage collection
+		cl.tail.next = nil
+	}
+	cl.tail = nil
+	cl.size = 0
+}
+
+// ToSlice converts the list to a slice starting at the head
+func (cl *CircularLinkedList[T]) ToSlice() []T {
+	return cl.AppendTo(make([]T, 0, cl.size))
+}
+
+// AppendTo appends the elements of the list to dst starting at the head and returns the extended slice
+func (cl *CircularLinkedList[T]) AppendTo(dst []T) []T {
+	if cl.size == 0 {
+		return dst
+	}
+	cur := cl.tail.next
+	for i := 0; i < cl.size; i++ {
+		dst = append(dst, cur.value)
+		cur = cur.next
+	}
+	return dst
+}
+
+// SetFormatFunc sets how elements render in String and Join
+// A nil format restores the default %v rendering
+func (cl *CircularLinkedList[T]) SetFormatFunc(format FormatFunc[T]) {
+	cl.format = format
+}
+
+// Join renders the elements of the list from the head separated by sep
+func (cl *CircularLinkedList[T]) Join(sep string) string {
+	var sb strings.Builder
+	joinElements(&sb, cl.format, cl.ToSlice(), sep)
+	return sb.String()
+}
+
+// String returns a string representation of the list, ending with an arrow back to the head
+func (cl *CircularLinkedList[T]) String() string {
+	if cl.size == 0 {
+		return "[]"
+	}
+	return "[" + cl.Join(" -> ") + " -> ...]"
+}
+
+// Cursor returns a cursor positioned at the head of the list
+func (cl *CircularLinkedList[T]) Cursor() *CircularCursor[T] {
+	return &CircularCursor[T]{list: cl, prev: cl.tail}
+}
+
+// removeAfter unlinks and returns the element following prev
+func (cl *CircularLinkedList[T]) removeAfter(prev *node[T]) T {
+	n := prev.next
+	if n == prev {
+		cl.tail = nil
+	} else {
+		prev.next = n.next
+		if n == cl.tail {
+			cl.tail = prev
+		}
+	}
+	n.next = nil
+	cl.size--
+	return n.value
+}
+
+// CircularCursor walks a CircularLinkedList forever, wrapping from the tail back to the head
+// Modifying the list other than through the cursor invalidates it
+type CircularCursor[T comparable] struct {
+	list *CircularLinkedList[T]
+	// prev is the node before the current one, which lets Remove unlink in O(1)
+	prev *node[T]
+}
+
+// Value returns the element under the cursor
+// Returns error if the list is empty
+func (c *CircularCursor[T]) Value() (T, error) {
+	if c.list.size == 0 {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	return c.prev.next.value, nil
+}
+
+// Next moves the cursor to the following element and returns it
+// Returns error if the list is empty
+func (c *CircularCursor[T]) Next() (T, error) {
+	c.Advance(1)
+	return c.Value()
+}
+
+// Advance moves the cursor n elements forward, wrapping around the list
+func (c *CircularCursor[T]) Advance(n int) {
+	if c.list.size == 0 || n <= 0 {
+		return
+	}
+	for i := 0; i < n%c.list.size; i++ {
+		c.prev = c.prev.next
+	}
+}
+
+// Remove removes and returns the element under the cursor, leaving the cursor on the following element
+// Returns error if the list is empty
+func (c *CircularCursor[T]) Remove() (T, error) {
+	if c.list.size == 0 {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	v := c.list.removeAfter(c.prev)
+	if c.list.size == 0 {
+		c.prev = nil
+	}
+	return v, nil
+}
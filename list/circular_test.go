@@ -0,0 +1,115 @@
+package list
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestCircularLinkedListBasics(t *testing.T) {
+	cl := NewCircularLinkedList[int]()
+	if cl.String() != "[]" {
+		t.Fatalf("unexpected empty string %s", cl)
+	}
+	if _, err := cl.GetFirst(); !errors.Is(err, ErrEmptyList) {
+		t.Fatalf("expected ErrEmptyList got %v", err)
+	}
+	cl.AddLast(2)
+	cl.AddLast(3)
+	cl.AddFirst(1)
+	if cl.String() != "[1 -> 2 -> 3 -> ...]" {
+		t.Fatalf("unexpected string %s", cl)
+	}
+	if first, _ := cl.GetFirst(); first != 1 {
+		t.Fatalf("expected head 1 got %d", first)
+	}
+	if last, _ := cl.GetLast(); last != 3 {
+		t.Fatalf("expected tail 3 got %d", last)
+	}
+	if v, _ := cl.Get(2); v != 3 {
+		t.Fatalf("expected 3 got %d", v)
+	}
+	if _, err := cl.Get(3); !errors.Is(err, ErrIndexOutOfBounds) {
+		t.Fatalf("expected ErrIndexOutOfBounds got %v", err)
+	}
+	if cl.IndexOf(3) != 2 || cl.Contains(4) {
+		t.Fatalf("unexpected lookup results")
+	}
+	if !cl.RemoveElement(3) || cl.RemoveElement(3) {
+		t.Fatalf("expected a single successful remove")
+	}
+	if last, _ := cl.GetLast(); last != 2 {
+		t.Fatalf("removing the tail should move it back got %d", last)
+	}
+	if v, _ := cl.RemoveFirst(); v != 1 || cl.Size() != 1 {
+		t.Fatalf("expected to remove 1 got %d", v)
+	}
+	cl.Clear()
+	if !cl.IsEmpty() {
+		t.Fatalf("expected empty list after clear")
+	}
+}
+
+func TestCircularLinkedListRotate(t *testing.T) {
+	cl := NewCircularLinkedListFromSlice([]int{1, 2, 3, 4, 5})
+	cl.Rotate(2)
+	if !slices.Equal(cl.ToSlice(), []int{3, 4, 5, 1, 2}) {
+		t.Fatalf("unexpected rotation %v", cl.ToSlice())
+	}
+	cl.Rotate(-3)
+	if !slices.Equal(cl.ToSlice(), []int{5, 1, 2, 3, 4}) {
+		t.Fatalf("unexpected rotation %v", cl.ToSlice())
+	}
+	cl.Rotate(10)
+	if first, _ := cl.GetFirst(); first != 5 {
+		t.Fatalf("full turns should not move the head got %d", first)
+	}
+}
+
+func TestCircularCursorWraps(t *testing.T) {
+	cl := NewCircularLinkedListFromSlice([]string{"a", "b", "c"})
+	c := cl.Cursor()
+	var seen string
+	for i := 0; i < 7; i++ {
+		v, _ := c.Value()
+		seen += v
+		c.Next()
+	}
+	if seen != "abcabca" {
+		t.Fatalf("expected wrapping iteration got %s", seen)
+	}
+
+	// The cursor sits on b now
+	if v, _ := c.Remove(); v != "b" {
+		t.Fatalf("expected to remove b got %s", v)
+	}
+	if v, _ := c.Value(); v != "c" || cl.Join("") != "ac" {
+		t.Fatalf("unexpected state after remove: cursor %s list %s", v, cl.Join(""))
+	}
+	c.Remove()
+	c.Remove()
+	if _, err := c.Value(); !errors.Is(err, ErrEmptyList) {
+		t.Fatalf("expected ErrEmptyList got %v", err)
+	}
+	cl.AddLast("z")
+	if v, _ := cl.Cursor().Value(); v != "z" {
+		t.Fatalf("expected z got %s", v)
+	}
+}
+
+func TestCircularLinkedListJosephus(t *testing.T) {
+	cl := NewCircularLinkedListFromSlice([]int{1, 2, 3, 4, 5, 6, 7})
+	order, err := cl.Josephus(3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(order, []int{3, 6, 2, 7, 5, 1, 4}) {
+		t.Fatalf("unexpected elimination order %v", order)
+	}
+	if !cl.IsEmpty() {
+		t.Fatalf("expected every element removed")
+	}
+	if _, err := cl.Josephus(0); !errors.Is(err, ErrInvalidStep) {
+		t.Fatalf("expected ErrInvalidStep got %v", err)
+	}
+}
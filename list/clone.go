@@ -0,0 +1,41 @@
+package list
+
+import "github.com/profoundwu/containers/internal/utils"
+
+// Cloner is implemented by elements that know how to copy themselves, such as a pointer to a
+// mutable struct whose Clone returns a pointer to a fresh copy
+type Cloner[T any] interface {
+	Clone() T
+}
+
+// DeepClone returns a copy of the array list like Clone, where every element implementing Cloner
+// is replaced by its Clone
+// Other elements are copied as they are, which is only independent for values without pointers
+func (al *ArrayList[T]) DeepClone() *ArrayList[T] {
+	return al.DeepCloneFunc(utils.CloneValue[T])
+}
+
+// DeepCloneFunc returns a copy of the array list like Clone, with every element passed through clone
+func (al *ArrayList[T]) DeepCloneFunc(clone func(T) T) *ArrayList[T] {
+	c := al.Clone()
+	for i, v := range c.elements[:c.size] {
+		c.elements[i] = clone(v)
+	}
+	return c
+}
+
+// DeepClone returns a copy of the linked list like Clone, where every element implementing Cloner
+// is replaced by its Clone
+// Other elements are copied as they are, which is only independent for values without pointers
+func (ll *LinkedList[T]) DeepClone() *LinkedList[T] {
+	return ll.DeepCloneFunc(utils.CloneValue[T])
+}
+
+// DeepCloneFunc returns a copy of the linked list like Clone, with every element passed through clone
+func (ll *LinkedList[T]) DeepCloneFunc(clone func(T) T) *LinkedList[T] {
+	c := ll.Clone()
+	for cur := c.head; cur != nil; cur = cur.next {
+		cur.value = clone(cur.value)
+	}
+	return c
+}
@@ -0,0 +1,42 @@
+package list
+
+import "testing"
+
+type account struct {
+	name    string
+	balance int
+}
+
+func (a *account) Clone() *account {
+	c := *a
+	return &c
+}
+
+func TestDeepClone(t *testing.T) {
+	al := NewArrayListFromSlice([]*account{{"a", 1}, {"b", 2}})
+	ll := al.ToLinkedList()
+	deep, linked := al.DeepClone(), ll.DeepClone()
+	shallow := al.Clone()
+
+	first, _ := al.Get(0)
+	first.balance = 100
+	if got, _ := deep.Get(0); got.balance != 1 || got == first {
+		t.Fatalf("expected an independent copy got %+v", got)
+	}
+	if got, _ := linked.Get(0); got.balance != 1 || got == first {
+		t.Fatalf("expected an independent copy got %+v", got)
+	}
+	if got, _ := shallow.Get(0); got != first {
+		t.Fatalf("expected Clone to share elements")
+	}
+
+	ints := NewLinkedListWithEquals[[]int](nil)
+	ints.AddLast([]int{1})
+	ints.AddLast([]int{2})
+	copied := ints.DeepCloneFunc(func(s []int) []int { return append([]int(nil), s...) })
+	head, _ := ints.GetFirst()
+	head[0] = 9
+	if got, _ := copied.GetFirst(); got[0] != 1 {
+		t.Fatalf("expected the clone func to copy elements got %v", got)
+	}
+}
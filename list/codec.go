@@ -0,0 +1,41 @@
+package list
+
+import (
+	"io"
+
+	"github.com/profoundwu/containers/codec"
+)
+
+// WriteAll streams every element to w with c, in order
+// Returns error naming the position of the first element that failed
+func (al *ArrayList[T]) WriteAll(w io.Writer, c codec.Codec[T]) error {
+	return codec.WriteSeq(w, al.Values(), c)
+}
+
+// ReadAll decodes elements from r with c until it ends, appending them to the array list
+// Returns the number of elements appended, and error naming the position of the first element that failed,
+// including one that exceeds the maximum capacity
+func (al *ArrayList[T]) ReadAll(r io.Reader, c codec.Codec[T]) (int, error) {
+	return codec.ReadSeq(r, c, func(elem T) error {
+		if err := al.reserve(al.size + 1); err != nil {
+			return err
+		}
+		al.AddLast(elem)
+		return nil
+	})
+}
+
+// WriteAll streams every element to w with c, in order
+// Returns error naming the position of the first element that failed
+func (ll *LinkedList[T]) WriteAll(w io.Writer, c codec.Codec[T]) error {
+	return codec.WriteSeq(w, ll.Values(), c)
+}
+
+// ReadAll decodes elements from r with c until it ends, appending them to the linked list
+// Returns the number of elements appended, and error naming the position of the first element that failed
+func (ll *LinkedList[T]) ReadAll(r io.Reader, c codec.Codec[T]) (int, error) {
+	return codec.ReadSeq(r, c, func(elem T) error {
+		ll.AddLast(elem)
+		return nil
+	})
+}
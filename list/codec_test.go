@@ -0,0 +1,61 @@
+package list
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strconv"
+	"testing"
+
+	"github.com/profoundwu/containers/codec"
+)
+
+func TestListWriteAllReadAll(t *testing.T) {
+	var buf bytes.Buffer
+	src := NewLinkedListFromSlice([]string{"a", "bb", "ccc"})
+	if err := src.WriteAll(&buf, codec.String()); err != nil {
+		t.Fatalf("expected no error got %v", err)
+	}
+
+	dst := NewArrayListFromSlice([]string{"start"})
+	n, err := dst.ReadAll(bytes.NewReader(buf.Bytes()), codec.String())
+	if err != nil || n != 3 {
+		t.Fatalf("expected 3 elements got %d %v", n, err)
+	}
+	assertElements[string](t, dst, []string{"start", "a", "bb", "ccc"})
+
+	ints, _ := codec.Binary[int32]()
+	buf.Reset()
+	NewArrayListFromSlice([]int32{7, 8, 9}).WriteAll(&buf, ints)
+	ll := NewLinkedList[int32]()
+	data := buf.Bytes()[:buf.Len()-1]
+	n, err = ll.ReadAll(bytes.NewReader(data), ints)
+	if n != 2 || !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("expected 2 elements and ErrUnexpectedEOF got %d %v", n, err)
+	}
+	assertElements[int32](t, ll, []int32{7, 8})
+
+	buf.Reset()
+	quoted := codec.Func(
+		func(s string) ([]byte, error) { return []byte(strconv.Quote(s)), nil },
+		func(data []byte) (string, error) { return strconv.Unquote(string(data)) },
+	)
+	if err := src.WriteAll(&buf, quoted); err != nil {
+		t.Fatalf("expected no error got %v", err)
+	}
+	framed := NewLinkedList[string]()
+	if n, err := framed.ReadAll(&buf, quoted); err != nil || n != 3 {
+		t.Fatalf("expected 3 elements got %d %v", n, err)
+	}
+	assertElements[string](t, framed, []string{"a", "bb", "ccc"})
+
+	// Reading past the maximum capacity stops with an error instead of panicking
+	buf.Reset()
+	src.WriteAll(&buf, codec.String())
+	bounded := New[string](WithMaxCapacity(2))
+	n, err = bounded.ReadAll(&buf, codec.String())
+	if n != 2 || !errors.Is(err, ErrCapacityExceeded) {
+		t.Fatalf("expected 2 elements and ErrCapacityExceeded got %d %v", n, err)
+	}
+	assertElements[string](t, bounded, []string{"a", "bb"})
+}
@@ -0,0 +1,71 @@
+package list
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/profoundwu/containers/compare"
+)
+
+// Appender is implemented by every list in this package and is what Collect fills
+type Appender[T any] interface {
+	AddLast(elem T)
+}
+
+// Collect appends every value of seq to into, so an iterator pipeline can end in any list
+// Returns into, for chaining
+func Collect[T any, C Appender[T]](seq iter.Seq[T], into C) C {
+	for v := range seq {
+		into.AddLast(v)
+	}
+	return into
+}
+
+// NewArrayListFromSeq creates an array list holding the values of seq in order
+func NewArrayListFromSeq[T comparable](seq iter.Seq[T]) *ArrayList[T] {
+	return Collect(seq, NewArrayList[T]())
+}
+
+// NewLinkedListFromSeq creates a linked list holding the values of seq in order
+func NewLinkedListFromSeq[T comparable](seq iter.Seq[T]) *LinkedList[T] {
+	return Collect(seq, NewLinkedList[T]())
+}
+
+// NewArrayListWithSizeHint creates an array list holding the values of seq in order, allocating room
+// for hint values up front so a stream of about that length is stored without growing
+// Values of hint below 1 fall back to the default capacity
+func NewArrayListWithSizeHint[T comparable](hint int, seq iter.Seq[T]) *ArrayList[T] {
+	return Collect(seq, NewArrayListWithCapacity[T](hint))
+}
+
+// NewCopyOnWriteArrayListFromSeq creates a copy-on-write list holding the values of seq in order
+// The values are gathered first and published with a single copy
+func NewCopyOnWriteArrayListFromSeq[T comparable](seq iter.Seq[T]) *CopyOnWriteArrayList[T] {
+	return NewCopyOnWriteArrayListWithSizeHint(0, seq)
+}
+
+// NewCopyOnWriteArrayListWithSizeHint creates a copy-on-write list holding the values of seq in order,
+// allocating room for hint values up front
+func NewCopyOnWriteArrayListWithSizeHint[T comparable](hint int, seq iter.Seq[T]) *CopyOnWriteArrayList[T] {
+	l := NewCopyOnWriteArrayList[T]()
+	l.store(collectSlice(hint, seq))
+	return l
+}
+
+// NewSortedListFromSeq creates a sorted list holding the values of seq ordered by comparator
+func NewSortedListFromSeq[T any](seq iter.Seq[T], comparator compare.Comparator[T]) *SortedList[T] {
+	return NewSortedListWithSizeHint(0, seq, comparator)
+}
+
+// NewSortedListWithSizeHint creates a sorted list holding the values of seq ordered by comparator,
+// allocating room for hint values up front and sorting once at the end
+func NewSortedListWithSizeHint[T any](hint int, seq iter.Seq[T], comparator compare.Comparator[T]) *SortedList[T] {
+	sl := &SortedList[T]{elements: collectSlice(hint, seq), cmp: comparator}
+	slices.SortStableFunc(sl.elements, comparator)
+	return sl
+}
+
+// collectSlice gathers the values of seq into a slice with room for hint values
+func collectSlice[T any](hint int, seq iter.Seq[T]) []T {
+	return slices.AppendSeq(make([]T, 0, max(hint, 0)), seq)
+}
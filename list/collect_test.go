@@ -0,0 +1,55 @@
+package list
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestCollect(t *testing.T) {
+	evens := func(yield func(int) bool) {
+		for i := 0; i < 10; i += 2 {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	assertElements[int](t, NewArrayListFromSeq(evens), []int{0, 2, 4, 6, 8})
+	assertElements[int](t, NewLinkedListFromSeq(evens), []int{0, 2, 4, 6, 8})
+	if got := NewCopyOnWriteArrayListFromSeq(evens).ToSlice(); !slices.Equal(got, []int{0, 2, 4, 6, 8}) {
+		t.Fatalf("expected [0 2 4 6 8] got %v", got)
+	}
+
+	al := NewArrayListFromSlice([]int{1})
+	if got := Collect(evens, al); got != al {
+		t.Fatalf("expected Collect to return its destination")
+	}
+	assertElements[int](t, al, []int{1, 0, 2, 4, 6, 8})
+
+	keys := Collect(maps.Keys(map[string]int{"a": 1}), NewLinkedList[string]())
+	assertElements[string](t, keys, []string{"a"})
+}
+
+func TestSizeHintConstructors(t *testing.T) {
+	seq := slices.Values([]int{5, 1, 4, 2, 3})
+	al := NewArrayListWithSizeHint(5, seq)
+	assertElements[int](t, al, []int{5, 1, 4, 2, 3})
+	if al.Capacity() != 5 {
+		t.Fatalf("expected the hint to size the list exactly got capacity %d", al.Capacity())
+	}
+	if got := NewCopyOnWriteArrayListWithSizeHint(2, seq).ToSlice(); !slices.Equal(got, []int{5, 1, 4, 2, 3}) {
+		t.Fatalf("expected [5 1 4 2 3] got %v", got)
+	}
+	sl := NewSortedListWithSizeHint(5, seq, func(a, b int) int { return a - b })
+	if got := sl.ToSlice(); !slices.Equal(got, []int{1, 2, 3, 4, 5}) {
+		t.Fatalf("expected [1 2 3 4 5] got %v", got)
+	}
+	if NewSortedListFromSeq(seq, func(a, b int) int { return b - a }).String() != "[5, 4, 3, 2, 1]" {
+		t.Fatalf("unexpected descending sorted list")
+	}
+
+	if cap(sl.elements) != 5 {
+		t.Fatalf("expected the hint to size the elements exactly got capacity %d", cap(sl.elements))
+	}
+}
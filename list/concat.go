@@ -0,0 +1,158 @@
+package list
+
+import (
+	"fmt"
+	"iter"
+)
+
+// Concat moves every element of other to the end of the array list with a single grow and copy,
+// leaving other empty
+// Concatenating a list with itself has no effect
+// Panics with ErrCapacityExceeded if the elements exceed the maximum capacity, leaving both lists
+// unchanged; InsertListAt at the end returns the error instead
+func (al *ArrayList[T]) Concat(other *ArrayList[T]) {
+	if other == al || other.size == 0 {
+		return
+	}
+	al.AddSlice(other.elements[:other.size])
+	other.Clear()
+}
+
+// Concat moves every element of other to the end of the linked list by relinking its nodes in O(1),
+// leaving other empty
+// When other allocates from an arena or allocator its values are copied, since its nodes are released with it
+// Concatenating a list with itself has no effect
+func (ll *LinkedList[T]) Concat(other *LinkedList[T]) {
+	if other == ll || other.size == 0 {
+		return
+	}
+	if other.alloc != nil {
+		ll.AddAll(other)
+		other.Clear()
+		return
+	}
+
+	if ll.tail == nil {
+		ll.head = other.head
+	} else {
+		ll.tail.next = other.head
+	}
+	ll.tail = other.tail
+	ll.size += other.size
+	ll.modCount++
+	other.disown()
+}
+
+// InsertListAt moves every element of other into the array list at the specified index position,
+// shifting the following elements once and leaving other empty
+// Inserting a list into itself has no effect
+// Returns error if index is out of bounds or the elements exceed the maximum capacity, leaving other unchanged
+func (al *ArrayList[T]) InsertListAt(index int, other *ArrayList[T]) error {
+	if index < 0 || index > al.size {
+		return fmt.Errorf("%w: %d, list size: %d", ErrIndexOutOfBounds, index, al.size)
+	}
+	if other == al || other.size == 0 {
+		return nil
+	}
+	if err := al.InsertAll(index, other.elements[:other.size]...); err != nil {
+		return err
+	}
+	other.Clear()
+	return nil
+}
+
+// Splice moves every element of other into the linked list at the specified index position,
+// leaving other empty
+// The nodes of other are relinked, which is O(1) at either end and otherwise costs the walk to index
+// When other allocates from an arena or allocator its values are copied, since its nodes are released with it
+// Splicing a list into itself has no effect
+// Returns error if index is out of bounds
+func (ll *LinkedList[T]) Splice(index int, other *LinkedList[T]) error {
+	if index < 0 || index > ll.size {
+		return fmt.Errorf("%w: %d, list size: %d", ErrIndexOutOfBounds, index, ll.size)
+	}
+	if other == ll || other.size == 0 {
+		return nil
+	}
+	if other.alloc != nil {
+		_ = ll.InsertAll(index, other.ToSlice()...)
+		other.Clear()
+		return nil
+	}
+
+	switch index {
+	case ll.size:
+		ll.Concat(other)
+		return nil
+	case 0:
+		other.tail.next = ll.head
+		ll.head = other.head
+		ll.cursorIndex += other.size
+	default:
+		// The cursor stays on prev, which keeps its index
+		prev := ll.nodeAt(index - 1)
+		other.tail.next = prev.next
+		prev.next = other.head
+	}
+	ll.size += other.size
+	ll.modCount++
+	other.disown()
+	return nil
+}
+
+// disown empties the linked list without touching its nodes, which another list has taken over
+func (ll *LinkedList[T]) disown() {
+	ll.head, ll.tail, ll.size = nil, nil, 0
+	ll.modCount++
+	ll.cursor = nil
+}
+
+// MergeSorted merges two lists that are each sorted by cmp into a new sorted array list in O(n+m)
+// The merge is stable: among equal elements those of a come first, each in its original order
+// The result compares elements the same way a does
+func MergeSorted[T any](a, b List[T], cmp func(x, y T) int) *ArrayList[T] {
+	result := newArrayList(a.Size()+b.Size(), equalityOf(a))
+	nextA, stopA := iter.Pull(values(a))
+	defer stopA()
+	nextB, stopB := iter.Pull(values(b))
+	defer stopB()
+
+	x, okA := nextA()
+	y, okB := nextB()
+	for okA && okB {
+		if cmp(y, x) < 0 {
+			result.AddLast(y)
+			y, okB = nextB()
+		} else {
+			result.AddLast(x)
+			x, okA = nextA()
+		}
+	}
+	for ; okA; x, okA = nextA() {
+		result.AddLast(x)
+	}
+	for ; okB; y, okB = nextB() {
+		result.AddLast(y)
+	}
+	return result
+}
+
+// values returns a sequence over any list, without copying when the implementation is known
+func values[T any](l List[T]) iter.Seq[T] {
+	switch l := l.(type) {
+	case *ArrayList[T]:
+		return l.Values()
+	case *LinkedList[T]:
+		return l.Values()
+	case *UnrolledLinkedList[T]:
+		return l.Values()
+	default:
+		return func(yield func(T) bool) {
+			for _, v := range l.ToSlice() {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package list
+
+import (
+	"cmp"
+	"errors"
+	"testing"
+)
+
+func TestArrayListConcat(t *testing.T) {
+	a := NewArrayListFromSlice([]int{1, 2})
+	b := NewArrayListFromSlice([]int{3, 4, 5})
+	a.Concat(b)
+	assertElements[int](t, a, []int{1, 2, 3, 4, 5})
+	if !b.IsEmpty() {
+		t.Fatalf("expected other to be emptied got %v", b)
+	}
+	a.Concat(a)
+	assertElements[int](t, a, []int{1, 2, 3, 4, 5})
+
+	bounded := New[int](WithMaxCapacity(3), WithElements(1, 2))
+	other := NewArrayListFromSlice([]int{3, 4})
+	func() {
+		defer func() {
+			if err, _ := recover().(error); !errors.Is(err, ErrCapacityExceeded) {
+				t.Fatalf("expected a panic with ErrCapacityExceeded got %v", err)
+			}
+		}()
+		bounded.Concat(other)
+	}()
+	assertElements[int](t, bounded, []int{1, 2})
+	assertElements[int](t, other, []int{3, 4})
+}
+
+func TestLinkedListConcat(t *testing.T) {
+	a := NewLinkedList[int]()
+	b := NewLinkedListFromSlice([]int{1, 2})
+	a.Concat(b)
+	assertElements[int](t, a, []int{1, 2})
+	if !b.IsEmpty() {
+		t.Fatalf("expected other to be emptied got %v", b)
+	}
+
+	b.AddLast(9)
+	a.Concat(b)
+	a.AddLast(10)
+	assertElements[int](t, a, []int{1, 2, 9, 10})
+	assertElements[int](t, b, []int{})
+
+	// Nodes owned by an arena are copied, so clearing the arena cannot corrupt the receiver
+	c := NewLinkedListWithArena[int](4)
+	c.AddSlice([]int{11, 12})
+	a.Concat(c)
+	c.AddLast(99)
+	c.Clear()
+	assertElements[int](t, a, []int{1, 2, 9, 10, 11, 12})
+}
+
+func TestArrayListInsertListAt(t *testing.T) {
+	a := NewArrayListFromSlice([]int{1, 5})
+	b := NewArrayListFromSlice([]int{2, 3, 4})
+	if err := a.InsertListAt(1, b); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertElements[int](t, a, []int{1, 2, 3, 4, 5})
+	if !b.IsEmpty() {
+		t.Fatalf("expected other to be emptied got %v", b)
+	}
+	if err := a.InsertListAt(6, NewArrayListFromSlice([]int{0})); !errors.Is(err, ErrIndexOutOfBounds) {
+		t.Fatalf("expected ErrIndexOutOfBounds got %v", err)
+	}
+	a.InsertListAt(0, a)
+	assertElements[int](t, a, []int{1, 2, 3, 4, 5})
+}
+
+func TestLinkedListSplice(t *testing.T) {
+	a := NewLinkedListFromSlice([]int{1, 5})
+	b := NewLinkedListFromSlice([]int{2, 3, 4})
+	if err := a.Splice(1, b); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertElements[int](t, a, []int{1, 2, 3, 4, 5})
+	if !b.IsEmpty() {
+		t.Fatalf("expected other to be emptied got %v", b)
+	}
+	b.AddLast(6)
+	assertElements[int](t, b, []int{6})
+
+	// Position the cursor past the head, then splice in front of it
+	a.Get(2)
+	a.Splice(0, NewLinkedListFromSlice([]int{-1, 0}))
+	if v, _ := a.Get(4); v != 3 {
+		t.Fatalf("expected 3 at index 4 got %d", v)
+	}
+	a.Splice(a.Size(), b)
+	a.AddLast(7)
+	assertElements[int](t, a, []int{-1, 0, 1, 2, 3, 4, 5, 6, 7})
+
+	c := NewLinkedListWithArena[int](4)
+	c.AddSlice([]int{10, 11})
+	a.Splice(2, c)
+	c.AddLast(99)
+	c.Clear()
+	assertElements[int](t, a, []int{-1, 0, 10, 11, 1, 2, 3, 4, 5, 6, 7})
+
+	if err := a.Splice(-1, NewLinkedList[int]()); !errors.Is(err, ErrIndexOutOfBounds) {
+		t.Fatalf("expected ErrIndexOutOfBounds got %v", err)
+	}
+}
+
+func TestMergeSorted(t *testing.T) {
+	type item struct {
+		key int
+		tag string
+	}
+	byKey := func(x, y item) int { return cmp.Compare(x.key, y.key) }
+	a := NewArrayListFromSlice([]item{{1, "a"}, {3, "a"}, {5, "a"}})
+	b := NewLinkedListFromSlice([]item{{1, "b"}, {2, "b"}, {5, "b"}, {7, "b"}})
+
+	merged := MergeSorted[item](a, b, byKey)
+	assertElements[item](t, merged, []item{{1, "a"}, {1, "b"}, {2, "b"}, {3, "a"}, {5, "a"}, {5, "b"}, {7, "b"}})
+
+	sub, _ := NewArrayListFromSlice([]int{0, 4, 8}).SubList(1, 3)
+	assertElements[int](t, MergeSorted[int](sub, NewArrayList[int](), cmp.Compare[int]), []int{4, 8})
+}
@@ -0,0 +1,306 @@
+package list
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// CopyOnWriteArrayList is a goroutine-safe list for read-heavy sharing
+// Reads work lock-free on an immutable snapshot of the elements, while every mutation copies the
+// elements under a mutex and publishes the copy, so writes cost O(n) and never disturb readers
+// Iteration walks the snapshot taken when it started and never sees later changes
+type CopyOnWriteArrayList[T any] struct {
+	mu       sync.Mutex
+	elements atomic.Pointer[[]T]
+	equality equality[T]
+}
+
+var _ List[int] = (*CopyOnWriteArrayList[int])(nil)
+
+// NewCopyOnWriteArrayList creates a new empty copy-on-write list
+func NewCopyOnWriteArrayList[T comparable]() *CopyOnWriteArrayList[T] {
+	return &CopyOnWriteArrayList[T]{equality: comparableEquality[T]()}
+}
+
+// NewCopyOnWriteArrayListFromSlice creates a copy-on-write list holding a copy of slice
+func NewCopyOnWriteArrayListFromSlice[T comparable](slice []T) *CopyOnWriteArrayList[T] {
+	l := NewCopyOnWriteArrayList[T]()
+	l.store(slices.Clone(slice))
+	return l
+}
+
+// NewCopyOnWriteArrayListWithEquals creates a new empty copy-on-write list that compares elements with eq
+func NewCopyOnWriteArrayListWithEquals[T any](eq func(a, b T) bool) *CopyOnWriteArrayList[T] {
+	return &CopyOnWriteArrayList[T]{equality: funcEquality(eq)}
+}
+
+// snapshot returns the current elements, which must not be modified
+func (l *CopyOnWriteArrayList[T]) snapshot() []T {
+	if p := l.elements.Load(); p != nil {
+		return *p
+	}
+	return nil
+}
+
+func (l *CopyOnWriteArrayList[T]) store(elements []T) {
+	l.elements.Store(&elements)
+}
+
+// Size returns the number of elements in the list
+func (l *CopyOnWriteArrayList[T]) Size() int {
+	return len(l.snapshot())
+}
+
+// IsEmpty checks if the list is empty
+func (l *CopyOnWriteArrayList[T]) IsEmpty() bool {
+	return len(l.snapshot()) == 0
+}
+
+// AddLast adds an element at the end of the list
+func (l *CopyOnWriteArrayList[T]) AddLast(elem T) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.store(append(slices.Clip(l.snapshot()), elem))
+}
+
+// AddSlice appends every element of slice with a single copy
+func (l *CopyOnWriteArrayList[T]) AddSlice(slice []T) {
+	if len(slice) == 0 {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.store(append(slices.Clip(l.snapshot()), slice...))
+}
+
+// AddIfAbsent adds elem at the end of the list unless it is already present
+// Returns true if the element was added
+func (l *CopyOnWriteArrayList[T]) AddIfAbsent(elem T) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	old := l.snapshot()
+	if l.indexIn(old, elem) != -1 {
+		return false
+	}
+	l.store(append(slices.Clip(old), elem))
+	return true
+}
+
+// Add inserts an element at the specified index position
+// Returns error if index is out of bounds
+func (l *CopyOnWriteArrayList[T]) Add(index int, elem T) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	old := l.snapshot()
+	if index < 0 || index > len(old) {
+		return fmt.Errorf("%w: %d, list size: %d", ErrIndexOutOfBounds, index, len(old))
+	}
+	l.store(slices.Insert(slices.Clip(old), index, elem))
+	return nil
+}
+
+// Get returns the element at the specified index position
+// Returns error if index is out of bounds
+func (l *CopyOnWriteArrayList[T]) Get(index int) (T, error) {
+	elements := l.snapshot()
+	if index < 0 || index >= len(elements) {
+		var zero T
+		return zero, fmt.Errorf("%w: %d, list size: %d", ErrIndexOutOfBounds, index, len(elements))
+	}
+	return elements[index], nil
+}
+
+// GetFirst returns the first element of the list
+// Returns error if list is empty
+func (l *CopyOnWriteArrayList[T]) GetFirst() (T, error) {
+	elements := l.snapshot()
+	if len(elements) == 0 {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	return elements[0], nil
+}
+
+// GetLast returns the last element of the list
+// Returns error if list is empty
+func (l *CopyOnWriteArrayList[T]) GetLast() (T, error) {
+	elements := l.snapshot()
+	if len(elements) == 0 {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	return elements[len(elements)-1], nil
+}
+
+// Set replaces the element at the specified index position
+// Returns error if index is out of bounds
+func (l *CopyOnWriteArrayList[T]) Set(index int, elem T) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	old := l.snapshot()
+	if index < 0 || index >= len(old) {
+		return fmt.Errorf("%w: %d, list size: %d", ErrIndexOutOfBounds, index, len(old))
+	}
+	elements := slices.Clone(old)
+	elements[index] = elem
+	l.store(elements)
+	return nil
+}
+
+// Remove deletes and returns the element at the specified index position
+// Returns error if index is out of bounds
+func (l *CopyOnWriteArrayList[T]) Remove(index int) (T, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.removeAt(index)
+}
+
+// RemoveFirst deletes and returns the first element of the list
+// Returns error if list is empty
+func (l *CopyOnWriteArrayList[T]) RemoveFirst() (T, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if len(l.snapshot()) == 0 {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	return l.removeAt(0)
+}
+
+// RemoveLast deletes and returns the last element of the list
+// Returns error if list is empty
+func (l *CopyOnWriteArrayList[T]) RemoveLast() (T, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	n := len(l.snapshot())
+	if n == 0 {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	return l.removeAt(n - 1)
+}
+
+// RemoveElement deletes the first occurrence of the specified element
+// Returns true if element was found and removed, false otherwise
+func (l *CopyOnWriteArrayList[T]) RemoveElement(elem T) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	index := l.indexIn(l.snapshot(), elem)
+	if index == -1 {
+		return false
+	}
+	_, err := l.removeAt(index)
+	return err == nil
+}
+
+// RemoveIf removes every element matching pred with a single copy
+// Returns the number of elements removed
+func (l *CopyOnWriteArrayList[T]) RemoveIf(pred func(elem T) bool) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	old := l.snapshot()
+	elements := slices.DeleteFunc(slices.Clone(old), pred)
+	if removed := len(old) - len(elements); removed > 0 {
+		l.store(elements)
+		return removed
+	}
+	return 0
+}
+
+// removeAt publishes a copy without the element at index
+// The caller must hold the lock
+func (l *CopyOnWriteArrayList[T]) removeAt(index int) (T, error) {
+	old := l.snapshot()
+	if index < 0 || index >= len(old) {
+		var zero T
+		return zero, fmt.Errorf("%w: %d, list size: %d", ErrIndexOutOfBounds, index, len(old))
+	}
+	elements := make([]T, 0, len(old)-1)
+	elements = append(elements, old[:index]...)
+	l.store(append(elements, old[index+1:]...))
+	return old[index], nil
+}
+
+// Contains checks if the list contains the specified element
+func (l *CopyOnWriteArrayList[T]) Contains(elem T) bool {
+	return l.IndexOf(elem) != -1
+}
+
+// IndexOf returns the first index of the specified element
+// Returns -1 if element is not found
+func (l *CopyOnWriteArrayList[T]) IndexOf(elem T) int {
+	return l.indexIn(l.snapshot(), elem)
+}
+
+func (l *CopyOnWriteArrayList[T]) indexIn(elements []T, elem T) int {
+	for i, v := range elements {
+		if l.equality.eq(v, elem) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Clear removes all elements from the list
+func (l *CopyOnWriteArrayList[T]) Clear() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.elements.Store(nil)
+}
+
+// ToSlice returns a copy of the current elements
+func (l *CopyOnWriteArrayList[T]) ToSlice() []T {
+	return slices.Clone(l.snapshot())
+}
+
+// AppendTo appends the current elements to dst and returns the extended slice
+func (l *CopyOnWriteArrayList[T]) AppendTo(dst []T) []T {
+	return append(dst, l.snapshot()...)
+}
+
+// Reverse publishes a reversed copy of the list
+func (l *CopyOnWriteArrayList[T]) Reverse() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	elements := slices.Clone(l.snapshot())
+	slices.Reverse(elements)
+	l.store(elements)
+}
+
+// All returns a sequence of index-element pairs over the snapshot taken when iteration starts
+func (l *CopyOnWriteArrayList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, v := range l.snapshot() {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a sequence of the elements of the snapshot taken when iteration starts
+func (l *CopyOnWriteArrayList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range l.snapshot() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Join renders the elements of the list separated by sep
+func (l *CopyOnWriteArrayList[T]) Join(sep string) string {
+	var sb strings.Builder
+	joinElements(&sb, nil, l.snapshot(), sep)
+	return sb.String()
+}
+
+// String returns a string representation of the list
+func (l *CopyOnWriteArrayList[T]) String() string {
+	return "[" + l.Join(", ") + "]"
+}
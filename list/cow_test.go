@@ -0,0 +1,105 @@
+package list
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestCopyOnWriteArrayList(t *testing.T) {
+	l := NewCopyOnWriteArrayListFromSlice([]int{1, 2, 3})
+	l.AddLast(4)
+	if err := l.Add(0, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertElements[int](t, l, []int{0, 1, 2, 3, 4})
+
+	if err := l.Set(2, 20); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v, _ := l.Get(2); v != 20 {
+		t.Fatalf("expected 20 got %d", v)
+	}
+	if _, err := l.Get(5); !errors.Is(err, ErrIndexOutOfBounds) {
+		t.Fatalf("expected ErrIndexOutOfBounds got %v", err)
+	}
+	if v, _ := l.Remove(1); v != 1 {
+		t.Fatalf("expected 1 got %d", v)
+	}
+	if first, _ := l.RemoveFirst(); first != 0 {
+		t.Fatalf("expected 0 got %d", first)
+	}
+	if last, _ := l.RemoveLast(); last != 4 {
+		t.Fatalf("expected 4 got %d", last)
+	}
+	assertElements[int](t, l, []int{20, 3})
+
+	if l.AddIfAbsent(3) || !l.AddIfAbsent(5) {
+		t.Fatalf("unexpected AddIfAbsent results")
+	}
+	if !l.RemoveElement(20) || l.Contains(20) || l.IndexOf(5) != 1 {
+		t.Fatalf("unexpected contents %v", l)
+	}
+	l.AddSlice([]int{6, 7, 8})
+	if n := l.RemoveIf(func(v int) bool { return v%2 == 0 }); n != 2 {
+		t.Fatalf("expected 2 removed got %d", n)
+	}
+	l.Reverse()
+	if l.String() != "[7, 5, 3]" {
+		t.Fatalf("expected [7, 5, 3] got %s", l)
+	}
+	l.Clear()
+	if !l.IsEmpty() {
+		t.Fatalf("expected an empty list")
+	}
+	if _, err := l.GetFirst(); !errors.Is(err, ErrEmptyList) {
+		t.Fatalf("expected ErrEmptyList got %v", err)
+	}
+}
+
+func TestCopyOnWriteArrayListSnapshotIteration(t *testing.T) {
+	l := NewCopyOnWriteArrayListFromSlice([]int{1, 2, 3})
+	var seen []int
+	for v := range l.Values() {
+		// Changes made during iteration are not visible to it
+		l.AddLast(v * 10)
+		seen = append(seen, v)
+	}
+	if !slices.Equal(seen, []int{1, 2, 3}) {
+		t.Fatalf("expected [1 2 3] got %v", seen)
+	}
+	assertElements[int](t, l, []int{1, 2, 3, 10, 20, 30})
+
+	out := l.ToSlice()
+	out[0] = 100
+	if v, _ := l.Get(0); v != 1 {
+		t.Fatalf("expected ToSlice to return a copy")
+	}
+}
+
+func TestCopyOnWriteArrayListConcurrent(t *testing.T) {
+	l := NewCopyOnWriteArrayList[int]()
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				l.AddLast(i)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				for range l.Values() {
+				}
+				l.Contains(i)
+			}
+		}()
+	}
+	wg.Wait()
+	if l.Size() != 400 {
+		t.Fatalf("expected 400 elements got %d", l.Size())
+	}
+}
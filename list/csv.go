@@ -0,0 +1,73 @@
+package list
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ReadCSV reads every CSV record from r and maps it into an element of a new array list
+// Returns error if the input is malformed or mapper fails, naming the offending record
+func ReadCSV[T comparable](r io.Reader, mapper func(record []string) (T, error)) (*ArrayList[T], error) {
+	return readCSV(csv.NewReader(r), 1, mapper)
+}
+
+// ReadCSVWithHeader reads a header record from r, then maps every following record
+// into an element of a new array list, passing the header so columns can be looked up by name
+// Returns error if the input is malformed or mapper fails, naming the offending record
+func ReadCSVWithHeader[T comparable](r io.Reader, mapper func(header, record []string) (T, error)) (*ArrayList[T], error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return NewArrayList[T](), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("csv header: %w", err)
+	}
+	return readCSV(cr, 2, func(record []string) (T, error) {
+		return mapper(header, record)
+	})
+}
+
+// readCSV maps the remaining records of cr, numbering them from line
+func readCSV[T comparable](cr *csv.Reader, line int, mapper func(record []string) (T, error)) (*ArrayList[T], error) {
+	al := NewArrayList[T]()
+	for ; ; line++ {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return al, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("csv record %d: %w", line, err)
+		}
+		elem, err := mapper(record)
+		if err != nil {
+			return nil, fmt.Errorf("csv record %d: %w", line, err)
+		}
+		al.AddLast(elem)
+	}
+}
+
+// WriteCSV writes every element of l to w as the CSV record produced by mapper
+func WriteCSV[T comparable](w io.Writer, l List[T], mapper func(elem T) []string) error {
+	return WriteCSVWithHeader(w, nil, l, mapper)
+}
+
+// WriteCSVWithHeader writes header followed by every element of l as the CSV record produced by mapper
+// A nil header is omitted
+func WriteCSVWithHeader[T comparable](w io.Writer, header []string, l List[T], mapper func(elem T) []string) error {
+	cw := csv.NewWriter(w)
+	if header != nil {
+		if err := cw.Write(header); err != nil {
+			return err
+		}
+	}
+	for _, elem := range l.ToSlice() {
+		if err := cw.Write(mapper(elem)); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
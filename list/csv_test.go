@@ -0,0 +1,95 @@
+package list
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+type csvPerson struct {
+	name string
+	age  int
+}
+
+func TestReadCSV(t *testing.T) {
+	input := "alice,30\nbob,25\n"
+	al, err := ReadCSV(strings.NewReader(input), func(record []string) (csvPerson, error) {
+		age, err := strconv.Atoi(record[1])
+		return csvPerson{name: record[0], age: age}, err
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if al.Size() != 2 {
+		t.Fatalf("expected 2 records got %d", al.Size())
+	}
+	if p, _ := al.Get(1); p.name != "bob" || p.age != 25 {
+		t.Fatalf("unexpected record %+v", p)
+	}
+}
+
+func TestReadCSVMapperError(t *testing.T) {
+	input := "alice,30\nbob,old\n"
+	_, err := ReadCSV(strings.NewReader(input), func(record []string) (int, error) {
+		return strconv.Atoi(record[1])
+	})
+	if err == nil || !strings.Contains(err.Error(), "record 2") {
+		t.Fatalf("expected error naming record 2 got %v", err)
+	}
+	var numErr *strconv.NumError
+	if !errors.As(err, &numErr) {
+		t.Fatalf("mapper error should be wrapped got %v", err)
+	}
+}
+
+func TestReadCSVWithHeader(t *testing.T) {
+	input := "age,name\n41,carol\n"
+	al, err := ReadCSVWithHeader(strings.NewReader(input), func(header, record []string) (csvPerson, error) {
+		var p csvPerson
+		for i, col := range header {
+			switch col {
+			case "name":
+				p.name = record[i]
+			case "age":
+				p.age, _ = strconv.Atoi(record[i])
+			}
+		}
+		return p, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p, _ := al.GetFirst(); p.name != "carol" || p.age != 41 {
+		t.Fatalf("unexpected record %+v", p)
+	}
+
+	empty, err := ReadCSVWithHeader(strings.NewReader(""), func(_, _ []string) (int, error) { return 0, nil })
+	if err != nil || !empty.IsEmpty() {
+		t.Fatalf("expected empty list for empty input got %v err=%v", empty, err)
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	ll := NewLinkedListFromSlice([]csvPerson{{"alice", 30}, {"bob, jr", 25}})
+	var buf bytes.Buffer
+	err := WriteCSVWithHeader[csvPerson](&buf, []string{"name", "age"}, ll, func(p csvPerson) []string {
+		return []string{p.name, strconv.Itoa(p.age)}
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := "name,age\nalice,30\n\"bob, jr\",25\n"
+	if buf.String() != expected {
+		t.Fatalf("unexpected csv %q", buf.String())
+	}
+
+	buf.Reset()
+	WriteCSV[int](&buf, NewArrayListFromSlice([]int{1, 2}), func(v int) []string {
+		return []string{strconv.Itoa(v)}
+	})
+	if buf.String() != "1\n2\n" {
+		t.Fatalf("unexpected csv %q", buf.String())
+	}
+}
@@ -0,0 +1,125 @@
+package list
+
+// equality decides when two elements of a list are the same
+// Lists built by the comparable constructors use == and hash based sets, lists built
+// with a custom equality function fall back to linear scans for their set operations
+// The zero value compares elements through their interface values, which panics for
+// element types that are not comparable
+type equality[T any] struct {
+	equal func(a, b T) bool
+	// newSet is nil when elements can only be compared through equal
+	newSet func(size int) elementSet[T]
+}
+
+// elementSet answers membership questions for the bulk operations
+type elementSet[T any] interface {
+	// add inserts elem and reports whether it was not already present
+	add(elem T) bool
+	contains(elem T) bool
+}
+
+// comparableEquality compares elements with ==
+func comparableEquality[T comparable]() equality[T] {
+	return equality[T]{
+		equal:  func(a, b T) bool { return a == b },
+		newSet: func(size int) elementSet[T] { return make(hashSet[T], size) },
+	}
+}
+
+// funcEquality compares elements with a user supplied function
+// A nil eq falls back to comparing interface values
+func funcEquality[T any](eq func(a, b T) bool) equality[T] {
+	return equality[T]{equal: eq}
+}
+
+func (e equality[T]) eq(a, b T) bool {
+	if e.equal == nil {
+		return any(a) == any(b)
+	}
+	return e.equal(a, b)
+}
+
+func (e equality[T]) set(size int) elementSet[T] {
+	switch {
+	case e.newSet != nil:
+		return e.newSet(size)
+	case e.equal == nil:
+		return make(anySet[T], size)
+	default:
+		return &scanSet[T]{equal: e.equal}
+	}
+}
+
+// equalityOf returns the equality used by l, or the zero equality for unknown implementations
+func equalityOf[T any](l List[T]) equality[T] {
+	switch l := l.(type) {
+	case *ArrayList[T]:
+		return l.equality
+	case *LinkedList[T]:
+		return l.equality
+	case *SubList[T]:
+		return l.equality
+	case *CopyOnWriteArrayList[T]:
+		return l.equality
+	case *UnrolledLinkedList[T]:
+		return l.equality
+	case *ImmutableList[T]:
+		return equalityOf(l.list)
+	default:
+		return equality[T]{}
+	}
+}
+
+type hashSet[T comparable] map[T]struct{}
+
+func (s hashSet[T]) add(elem T) bool {
+	if _, ok := s[elem]; ok {
+		return false
+	}
+	s[elem] = struct{}{}
+	return true
+}
+
+func (s hashSet[T]) contains(elem T) bool {
+	_, ok := s[elem]
+	return ok
+}
+
+// anySet keys elements by their interface values
+type anySet[T any] map[any]struct{}
+
+func (s anySet[T]) add(elem T) bool {
+	if _, ok := s[elem]; ok {
+		return false
+	}
+	s[elem] = struct{}{}
+	return true
+}
+
+func (s anySet[T]) contains(elem T) bool {
+	_, ok := s[elem]
+	return ok
+}
+
+// scanSet holds elements that can only be compared with a function, so every lookup is linear
+type scanSet[T any] struct {
+	elems []T
+	equal func(a, b T) bool
+}
+
+func (s *scanSet[T]) add(elem T) bool {
+	if s.contains(elem) {
+		return false
+	}
+	s.elems = append(s.elems, elem)
+	return true
+}
+
+func (s *scanSet[T]) contains(elem T) bool {
+	for _, v := range s.elems {
+		if s.equal(v, elem) {
+			return true
+		}
+	}
+	return false
+}
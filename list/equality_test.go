@@ -0,0 +1,84 @@
+package list
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+type tagged struct {
+	name string
+	tags []string
+}
+
+func sameTagged(a, b tagged) bool {
+	return a.name == b.name && slices.Equal(a.tags, b.tags)
+}
+
+func TestListWithEqualsNonComparable(t *testing.T) {
+	for name, newList := range map[string]func() List[tagged]{
+		"array":  func() List[tagged] { return NewArrayListWithEquals(sameTagged) },
+		"linked": func() List[tagged] { return NewLinkedListWithEquals(sameTagged) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			l := newList()
+			l.AddLast(tagged{"a", []string{"x"}})
+			l.AddLast(tagged{"b", []string{"y", "z"}})
+			l.AddLast(tagged{"a", []string{"x"}})
+
+			if i := l.IndexOf(tagged{"b", []string{"y", "z"}}); i != 1 {
+				t.Fatalf("expected index 1 got %d", i)
+			}
+			if l.Contains(tagged{"b", []string{"y"}}) {
+				t.Fatalf("expected tags to be compared element by element")
+			}
+			if !l.RemoveElement(tagged{"a", []string{"x"}}) || l.Size() != 2 {
+				t.Fatalf("expected first match removed got %v", l)
+			}
+			if first, _ := l.GetFirst(); first.name != "b" {
+				t.Fatalf("expected b first got %v", first)
+			}
+
+			sub, _ := l.(interface {
+				SubList(from, to int) (*SubList[tagged], error)
+			}).SubList(1, 2)
+			if sub.IndexOf(tagged{"a", []string{"x"}}) != 0 {
+				t.Fatalf("expected the view to use the list's equality")
+			}
+		})
+	}
+}
+
+func TestListWithEqualsBulk(t *testing.T) {
+	fold := strings.EqualFold
+	al := NewArrayListWithEquals(fold)
+	al.AddSlice([]string{"Go", "go", "Rust", "GO", "rust", "Zig"})
+	if n := al.Distinct(); n != 3 {
+		t.Fatalf("expected 3 removed got %d", n)
+	}
+	assertElements[string](t, al, []string{"Go", "Rust", "Zig"})
+
+	ll := NewLinkedListWithEquals(fold)
+	ll.AddSlice([]string{"zig", "c"})
+	if !al.ContainsAll(ll.Filter(func(s string) bool { return s != "c" })) {
+		t.Fatalf("expected case-insensitive ContainsAll")
+	}
+	if n := al.RemoveAll(ll); n != 1 {
+		t.Fatalf("expected 1 removed got %d", n)
+	}
+	assertElements[string](t, al, []string{"Go", "Rust"})
+	if n := ll.RetainAll(NewArrayListFromSlice([]string{"C"})); n != 1 {
+		t.Fatalf("expected 1 removed got %d", n)
+	}
+	assertElements[string](t, ll, []string{"c"})
+
+	if !al.Equals(NewLinkedListFromSlice([]string{"GO", "rust"})) {
+		t.Fatalf("expected Equals to use the receiver's equality")
+	}
+	if clone := al.Clone(); !clone.Contains("RUST") {
+		t.Fatalf("expected the clone to keep the equality")
+	}
+	if linked := al.ToLinkedList(); linked.IndexOf("go") != 0 {
+		t.Fatalf("expected the conversion to keep the equality")
+	}
+}
@@ -0,0 +1,126 @@
+package list
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FormatFunc renders a single element for String and Join
+type FormatFunc[T any] func(elem T) string
+
+// Format controls how String renders a list: Prefix and Suffix surround the elements,
+// Sep goes between them and Elem renders each one
+// Fields are used as given, so a zero Format renders the elements back to back with %v
+type Format[T any] struct {
+	Prefix string
+	Suffix string
+	Sep    string
+	Elem   FormatFunc[T]
+}
+
+// layout is the part of a Format that String uses around the elements
+type layout struct {
+	prefix, suffix, sep string
+}
+
+var (
+	arrayListLayout  = layout{prefix: "[", suffix: "]", sep: ", "}
+	linkedListLayout = layout{prefix: "[", suffix: "]", sep: " -> "}
+)
+
+// or returns the layout, or def when none has been set
+func (l *layout) or(def layout) layout {
+	if l == nil {
+		return def
+	}
+	return *l
+}
+
+// formatElement renders elem with format, falling back to the %v verb
+func formatElement[T any](format FormatFunc[T], elem T) string {
+	if format == nil {
+		return fmt.Sprintf("%v", elem)
+	}
+	return format(elem)
+}
+
+// joinElements renders elements with format and places sep between them
+func joinElements[T any](sb *strings.Builder, format FormatFunc[T], elements []T, sep string) {
+	for i, v := range elements {
+		sb.WriteString(formatElement(format, v))
+		if i < len(elements)-1 {
+			sb.WriteString(sep)
+		}
+	}
+}
+
+// SetFormatFunc sets how elements render in String and Join
+// A nil format restores the default %v rendering
+func (al *ArrayList[T]) SetFormatFunc(format FormatFunc[T]) {
+	al.format = format
+}
+
+// SetFormat sets how String renders the array list, replacing any FormatFunc
+func (al *ArrayList[T]) SetFormat(f Format[T]) {
+	al.format, al.layout = f.Elem, &layout{prefix: f.Prefix, suffix: f.Suffix, sep: f.Sep}
+}
+
+// ResetFormat restores the default "[a, b, c]" rendering
+func (al *ArrayList[T]) ResetFormat() {
+	al.format, al.layout = nil, nil
+}
+
+// MarshalText implements encoding.TextMarshaler with the String rendering
+func (al *ArrayList[T]) MarshalText() ([]byte, error) {
+	return []byte(al.String()), nil
+}
+
+// Join renders the elements of the array list separated by sep
+func (al *ArrayList[T]) Join(sep string) string {
+	var sb strings.Builder
+	joinElements(&sb, al.format, al.elements[:al.size], sep)
+	return sb.String()
+}
+
+// SetFormatFunc sets how elements render in String and Join
+// A nil format restores the default %v rendering
+func (ll *LinkedList[T]) SetFormatFunc(format FormatFunc[T]) {
+	ll.format = format
+}
+
+// SetFormat sets how String renders the linked list, replacing any FormatFunc
+func (ll *LinkedList[T]) SetFormat(f Format[T]) {
+	ll.format, ll.layout = f.Elem, &layout{prefix: f.Prefix, suffix: f.Suffix, sep: f.Sep}
+}
+
+// ResetFormat restores the default "[a -> b -> c]" rendering
+func (ll *LinkedList[T]) ResetFormat() {
+	ll.format, ll.layout = nil, nil
+}
+
+// MarshalText implements encoding.TextMarshaler with the String rendering
+func (ll *LinkedList[T]) MarshalText() ([]byte, error) {
+	return []byte(ll.String()), nil
+}
+
+// Join renders the elements of the linked list separated by sep
+func (ll *LinkedList[T]) Join(sep string) string {
+	var sb strings.Builder
+	for cur := ll.head; cur != nil; cur = cur.next {
+		sb.WriteString(formatElement(ll.format, cur.value))
+		if cur.next != nil {
+			sb.WriteString(sep)
+		}
+	}
+	return sb.String()
+}
+
+// MarshalText implements encoding.TextMarshaler with the String rendering
+func (l *CopyOnWriteArrayList[T]) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// MarshalText implements encoding.TextMarshaler with the String rendering of the wrapped list
+func (il *ImmutableList[T]) MarshalText() ([]byte, error) {
+	return []byte(il.String()), nil
+}
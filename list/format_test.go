@@ -0,0 +1,74 @@
+package list
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestArrayListJoinAndFormat(t *testing.T) {
+	al := NewArrayListFromSlice([]string{"a", "b", "c"})
+	if al.Join("|") != "a|b|c" {
+		t.Fatalf("unexpected join %s", al.Join("|"))
+	}
+	al.SetFormatFunc(strconv.Quote)
+	if al.String() != `["a", "b", "c"]` {
+		t.Fatalf("unexpected formatted string %s", al)
+	}
+	if al.Join(",") != `"a","b","c"` {
+		t.Fatalf("unexpected formatted join %s", al.Join(","))
+	}
+	al.SetFormatFunc(nil)
+	if al.String() != "[a, b, c]" {
+		t.Fatalf("nil format should restore the default got %s", al)
+	}
+	if NewArrayList[int]().Join(",") != "" {
+		t.Fatalf("empty list should join to an empty string")
+	}
+}
+
+func TestLinkedListJoinAndFormat(t *testing.T) {
+	ll := NewLinkedListFromSlice([]float64{1.5, 2.25})
+	if ll.Join(" ") != "1.5 2.25" {
+		t.Fatalf("unexpected join %s", ll.Join(" "))
+	}
+	ll.SetFormatFunc(func(f float64) string { return strconv.FormatFloat(f, 'f', 2, 64) })
+	if ll.String() != "[1.50 -> 2.25]" {
+		t.Fatalf("unexpected formatted string %s", ll)
+	}
+	if NewLinkedList[int]().Join(",") != "" {
+		t.Fatalf("empty list should join to an empty string")
+	}
+}
+
+func TestListSetFormat(t *testing.T) {
+	al := NewArrayListFromSlice([]int{1, 2, 3})
+	al.SetFormat(Format[int]{Prefix: "<", Suffix: ">", Sep: " ", Elem: func(v int) string { return strconv.Itoa(v * 10) }})
+	if al.String() != "<10 20 30>" {
+		t.Fatalf("expected <10 20 30> got %s", al)
+	}
+	if clone := al.Clone(); clone.String() != "<10 20 30>" {
+		t.Fatalf("expected the clone to keep the format got %s", clone)
+	}
+	text, err := al.MarshalText()
+	if err != nil || string(text) != "<10 20 30>" {
+		t.Fatalf("expected <10 20 30> got %s, %v", text, err)
+	}
+	al.ResetFormat()
+	if al.String() != "[1, 2, 3]" {
+		t.Fatalf("expected the default format got %s", al)
+	}
+
+	ll := NewLinkedListFromSlice([]string{"a", "b"})
+	ll.SetFormat(Format[string]{Sep: ","})
+	if ll.String() != "a,b" {
+		t.Fatalf("expected a,b got %s", ll)
+	}
+	ll.SetFormatFunc(strconv.Quote)
+	if ll.String() != `"a","b"` {
+		t.Fatalf(`expected "a","b" got %s`, ll)
+	}
+	ll.ResetFormat()
+	if text, _ := ll.MarshalText(); string(text) != "[a -> b]" {
+		t.Fatalf("expected [a -> b] got %s", text)
+	}
+}
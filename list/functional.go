@@ -0,0 +1,219 @@
+package list
+
+import (
+	"iter"
+
+	"github.com/profoundwu/containers/internal/utils"
+)
+
+// ForEach calls fn for every element in order
+func (al *ArrayList[T]) ForEach(fn func(elem T)) {
+	for _, v := range al.elements[:al.size] {
+		fn(v)
+	}
+}
+
+// Filter returns a new array list holding the elements that match pred, in order
+func (al *ArrayList[T]) Filter(pred func(elem T) bool) *ArrayList[T] {
+	result := newArrayList(utils.DefaultCapacity, al.equality)
+	for _, v := range al.elements[:al.size] {
+		if pred(v) {
+			result.AddLast(v)
+		}
+	}
+	result.format, result.layout = al.format, al.layout
+	return result
+}
+
+// ForEach calls fn for every element in order
+func (ll *LinkedList[T]) ForEach(fn func(elem T)) {
+	for cur := ll.head; cur != nil; cur = cur.next {
+		fn(cur.value)
+	}
+}
+
+// Filter returns a new linked list holding the elements that match pred, in order
+func (ll *LinkedList[T]) Filter(pred func(elem T) bool) *LinkedList[T] {
+	result := &LinkedList[T]{format: ll.format, layout: ll.layout, equality: ll.equality}
+	for cur := ll.head; cur != nil; cur = cur.next {
+		if pred(cur.value) {
+			result.AddLast(cur.value)
+		}
+	}
+	return result
+}
+
+// IndexFunc returns the index of the first element matching pred
+// Returns -1 if no element matches
+func (al *ArrayList[T]) IndexFunc(pred func(elem T) bool) int {
+	for i, v := range al.elements[:al.size] {
+		if pred(v) {
+			return i
+		}
+	}
+	return -1
+}
+
+// ContainsFunc checks if any element matches pred
+func (al *ArrayList[T]) ContainsFunc(pred func(elem T) bool) bool {
+	return al.IndexFunc(pred) != -1
+}
+
+// Find returns the first element matching pred
+// Returns false if no element matches
+func (al *ArrayList[T]) Find(pred func(elem T) bool) (T, bool) {
+	if i := al.IndexFunc(pred); i != -1 {
+		return al.elements[i], true
+	}
+	var zero T
+	return zero, false
+}
+
+// IndexFunc returns the index of the first element matching pred
+// Returns -1 if no element matches
+func (ll *LinkedList[T]) IndexFunc(pred func(elem T) bool) int {
+	i := 0
+	for cur := ll.head; cur != nil; cur = cur.next {
+		if pred(cur.value) {
+			return i
+		}
+		i++
+	}
+	return -1
+}
+
+// ContainsFunc checks if any element matches pred
+func (ll *LinkedList[T]) ContainsFunc(pred func(elem T) bool) bool {
+	return ll.IndexFunc(pred) != -1
+}
+
+// Find returns the first element matching pred
+// Returns false if no element matches
+func (ll *LinkedList[T]) Find(pred func(elem T) bool) (T, bool) {
+	for cur := ll.head; cur != nil; cur = cur.next {
+		if pred(cur.value) {
+			return cur.value, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// MinFunc returns the smallest element according to less, the first one among equals
+// Returns error if list is empty
+func (al *ArrayList[T]) MinFunc(less func(a, b T) bool) (T, error) {
+	_, v, err := extreme(al.All(), less)
+	return v, err
+}
+
+// MaxFunc returns the largest element according to less, the first one among equals
+// Returns error if list is empty
+func (al *ArrayList[T]) MaxFunc(less func(a, b T) bool) (T, error) {
+	_, v, err := extreme(al.All(), greater(less))
+	return v, err
+}
+
+// MinIndexFunc returns the index of the smallest element according to less, the first one among equals
+// Returns error if list is empty
+func (al *ArrayList[T]) MinIndexFunc(less func(a, b T) bool) (int, error) {
+	i, _, err := extreme(al.All(), less)
+	return i, err
+}
+
+// MaxIndexFunc returns the index of the largest element according to less, the first one among equals
+// Returns error if list is empty
+func (al *ArrayList[T]) MaxIndexFunc(less func(a, b T) bool) (int, error) {
+	i, _, err := extreme(al.All(), greater(less))
+	return i, err
+}
+
+// MinFunc returns the smallest element according to less, the first one among equals
+// Returns error if list is empty
+func (ll *LinkedList[T]) MinFunc(less func(a, b T) bool) (T, error) {
+	_, v, err := extreme(ll.All(), less)
+	return v, err
+}
+
+// MaxFunc returns the largest element according to less, the first one among equals
+// Returns error if list is empty
+func (ll *LinkedList[T]) MaxFunc(less func(a, b T) bool) (T, error) {
+	_, v, err := extreme(ll.All(), greater(less))
+	return v, err
+}
+
+// MinIndexFunc returns the index of the smallest element according to less, the first one among equals
+// Returns error if list is empty
+func (ll *LinkedList[T]) MinIndexFunc(less func(a, b T) bool) (int, error) {
+	i, _, err := extreme(ll.All(), less)
+	return i, err
+}
+
+// MaxIndexFunc returns the index of the largest element according to less, the first one among equals
+// Returns error if list is empty
+func (ll *LinkedList[T]) MaxIndexFunc(less func(a, b T) bool) (int, error) {
+	i, _, err := extreme(ll.All(), greater(less))
+	return i, err
+}
+
+// Map returns a new list holding fn applied to every element of l, in order
+// The result is a LinkedList when l is one and an ArrayList otherwise
+func Map[T any, U comparable](l List[T], fn func(elem T) U) List[U] {
+	if ll, ok := l.(*LinkedList[T]); ok {
+		result := NewLinkedList[U]()
+		ll.ForEach(func(v T) {
+			result.AddLast(fn(v))
+		})
+		return result
+	}
+
+	result := NewArrayListWithCapacity[U](l.Size())
+	forEach(l, func(v T) {
+		result.AddLast(fn(v))
+	})
+	return result
+}
+
+// Reduce folds the elements of l into an accumulator, starting from initial, in order
+func Reduce[T any, A any](l List[T], initial A, fn func(acc A, elem T) A) A {
+	acc := initial
+	forEach(l, func(v T) {
+		acc = fn(acc, v)
+	})
+	return acc
+}
+
+// forEach visits the elements of any list without copying when the implementation is known
+func forEach[T any](l List[T], fn func(elem T)) {
+	switch l := l.(type) {
+	case *ArrayList[T]:
+		l.ForEach(fn)
+	case *LinkedList[T]:
+		l.ForEach(fn)
+	default:
+		for _, v := range l.ToSlice() {
+			fn(v)
+		}
+	}
+}
+
+// extreme returns the first element of seq that no later element beats
+func extreme[T any](seq iter.Seq2[int, T], beats func(a, b T) bool) (int, T, error) {
+	index := -1
+	var best T
+	for i, v := range seq {
+		if index < 0 || beats(v, best) {
+			index, best = i, v
+		}
+	}
+	if index < 0 {
+		return -1, best, ErrEmptyList
+	}
+	return index, best, nil
+}
+
+// greater turns a less function into one reporting whether a is strictly greater than b
+func greater[T any](less func(a, b T) bool) func(a, b T) bool {
+	return func(a, b T) bool {
+		return less(b, a)
+	}
+}
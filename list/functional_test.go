@@ -0,0 +1,133 @@
+package list
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestListForEachAndFilter(t *testing.T) {
+	al := NewArrayListFromSlice([]int{1, 2, 3, 4, 5})
+	ll := NewLinkedListFromSlice([]int{1, 2, 3, 4, 5})
+
+	sum := 0
+	al.ForEach(func(v int) { sum += v })
+	ll.ForEach(func(v int) { sum += v })
+	if sum != 30 {
+		t.Fatalf("expected sum 30 got %d", sum)
+	}
+
+	odd := func(v int) bool { return v%2 == 1 }
+	assertElements[int](t, al.Filter(odd), []int{1, 3, 5})
+	filtered := ll.Filter(odd)
+	assertElements[int](t, filtered, []int{1, 3, 5})
+	filtered.AddLast(7)
+	assertElements[int](t, ll, []int{1, 2, 3, 4, 5})
+	if last, _ := filtered.GetLast(); last != 7 {
+		t.Fatalf("expected filtered tail 7 got %d", last)
+	}
+}
+
+func TestMapAndReduce(t *testing.T) {
+	al := NewArrayListFromSlice([]int{1, 2, 3})
+	ll := NewLinkedListFromSlice([]int{1, 2, 3})
+
+	mapped := Map[int, string](al, strconv.Itoa)
+	if _, ok := mapped.(*ArrayList[string]); !ok {
+		t.Fatalf("expected an ArrayList got %T", mapped)
+	}
+	assertElements(t, mapped, []string{"1", "2", "3"})
+
+	doubled := Map[int, int](ll, func(v int) int { return v * 2 })
+	if _, ok := doubled.(*LinkedList[int]); !ok {
+		t.Fatalf("expected a LinkedList got %T", doubled)
+	}
+	assertElements(t, doubled, []int{2, 4, 6})
+
+	sub, _ := al.SubList(1, 3)
+	assertElements(t, Map[int, int](sub, func(v int) int { return -v }), []int{-2, -3})
+
+	joined := Reduce[int, string](ll, "", func(acc string, v int) string { return acc + strconv.Itoa(v) })
+	if joined != "123" {
+		t.Fatalf("expected 123 got %s", joined)
+	}
+	if total := Reduce[int, int](NewArrayList[int](), 7, func(acc, v int) int { return acc + v }); total != 7 {
+		t.Fatalf("expected initial value for empty list got %d", total)
+	}
+}
+
+func TestListMinMaxFunc(t *testing.T) {
+	type minMax interface {
+		List[string]
+		MinFunc(less func(a, b string) bool) (string, error)
+		MaxFunc(less func(a, b string) bool) (string, error)
+		MinIndexFunc(less func(a, b string) bool) (int, error)
+		MaxIndexFunc(less func(a, b string) bool) (int, error)
+	}
+	byLen := func(a, b string) bool { return len(a) < len(b) }
+
+	for name, l := range map[string]minMax{
+		"array":  NewArrayListFromSlice([]string{"ccc", "a", "bb", "b", "ddd"}),
+		"linked": NewLinkedListFromSlice([]string{"ccc", "a", "bb", "b", "ddd"}),
+	} {
+		t.Run(name, func(t *testing.T) {
+			if v, err := l.MinFunc(byLen); err != nil || v != "a" {
+				t.Fatalf("expected a got %s %v", v, err)
+			}
+			if v, _ := l.MaxFunc(byLen); v != "ccc" {
+				t.Fatalf("expected first longest ccc got %s", v)
+			}
+			if i, _ := l.MinIndexFunc(byLen); i != 1 {
+				t.Fatalf("expected index 1 got %d", i)
+			}
+			if i, _ := l.MaxIndexFunc(byLen); i != 0 {
+				t.Fatalf("expected index 0 got %d", i)
+			}
+
+			l.Clear()
+			if _, err := l.MinFunc(byLen); err != ErrEmptyList {
+				t.Fatalf("expected ErrEmptyList got %v", err)
+			}
+			if i, err := l.MaxIndexFunc(byLen); err != ErrEmptyList || i != -1 {
+				t.Fatalf("expected -1 and ErrEmptyList got %d %v", i, err)
+			}
+		})
+	}
+}
+
+func TestListPredicateSearch(t *testing.T) {
+	type user struct {
+		name string
+		age  int
+	}
+	users := []user{{"ann", 31}, {"bob", 17}, {"cid", 45}, {"dee", 17}}
+	type searcher interface {
+		IndexFunc(pred func(user) bool) int
+		ContainsFunc(pred func(user) bool) bool
+		Find(pred func(user) bool) (user, bool)
+	}
+	minor := func(u user) bool { return u.age < 18 }
+	nobody := func(u user) bool { return u.name == "zed" }
+
+	for name, l := range map[string]searcher{
+		"array":  NewArrayListFromSlice(users),
+		"linked": NewLinkedListFromSlice(users),
+	} {
+		t.Run(name, func(t *testing.T) {
+			if i := l.IndexFunc(minor); i != 1 {
+				t.Fatalf("expected index 1 got %d", i)
+			}
+			if u, ok := l.Find(minor); !ok || u.name != "bob" {
+				t.Fatalf("expected bob got %v %v", u, ok)
+			}
+			if !l.ContainsFunc(minor) || l.ContainsFunc(nobody) {
+				t.Fatalf("unexpected ContainsFunc results")
+			}
+			if l.IndexFunc(nobody) != -1 {
+				t.Fatalf("expected -1 for no match")
+			}
+			if _, ok := l.Find(nobody); ok {
+				t.Fatalf("expected Find to report no match")
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package list
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/profoundwu/containers/internal/utils"
+)
+
+var (
+	ErrCapacityExceeded = errors.New("array list capacity exceeded")
+)
+
+// growthPolicy decides how far an array list's backing array grows
+// The zero value grows by utils.GrowthFactor without limit
+type growthPolicy struct {
+	factor      float64
+	maxCapacity int
+}
+
+// next returns the capacity to grow to from current so that at least minCapacity elements fit
+// Returns error if minCapacity overflowed or exceeds the maximum capacity
+func (g growthPolicy) next(current, minCapacity int) (int, error) {
+	if minCapacity < 0 || (g.maxCapacity > 0 && minCapacity > g.maxCapacity) {
+		return 0, fmt.Errorf("%w: need %d, max capacity: %d", ErrCapacityExceeded, minCapacity, g.maxCapacity)
+	}
+	factor := g.factor
+	if factor == 0 {
+		factor = utils.GrowthFactor
+	}
+	limit := math.MaxInt
+	if g.maxCapacity > 0 {
+		limit = g.maxCapacity
+	}
+	// Multiply in floating point so large capacities saturate at the limit instead of overflowing
+	grown := float64(current) * factor
+	if grown >= float64(limit) {
+		return limit, nil
+	}
+	return max(int(grown), minCapacity), nil
+}
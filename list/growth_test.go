@@ -0,0 +1,76 @@
+package list
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestArrayListGrowthFactor(t *testing.T) {
+	al := New[int](WithCapacity(4), WithGrowthFactor(1.5))
+	for i := 0; i < 5; i++ {
+		al.AddLast(i)
+	}
+	if al.Capacity() != 6 {
+		t.Fatalf("expected capacity 6 got %d", al.Capacity())
+	}
+
+	// An unusable factor keeps the default doubling
+	al = New[int](WithCapacity(4), WithGrowthFactor(0.5))
+	for i := 0; i < 5; i++ {
+		al.AddLast(i)
+	}
+	if al.Capacity() != 8 {
+		t.Fatalf("expected capacity 8 got %d", al.Capacity())
+	}
+}
+
+func TestArrayListMaxCapacity(t *testing.T) {
+	al := New[int](WithCapacity(2), WithMaxCapacity(3))
+	for i := 0; i < 3; i++ {
+		if err := al.Add(al.Size(), i); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if al.Capacity() != 3 {
+		t.Fatalf("expected capacity 3 got %d", al.Capacity())
+	}
+	if err := al.Add(1, 9); !errors.Is(err, ErrCapacityExceeded) {
+		t.Fatalf("expected ErrCapacityExceeded got %v", err)
+	}
+	if err := al.AddFirst(9); !errors.Is(err, ErrCapacityExceeded) {
+		t.Fatalf("expected ErrCapacityExceeded got %v", err)
+	}
+	if err := al.InsertAll(0, 7, 8); !errors.Is(err, ErrCapacityExceeded) {
+		t.Fatalf("expected ErrCapacityExceeded got %v", err)
+	}
+	if err := al.Resize(4, 0); !errors.Is(err, ErrCapacityExceeded) {
+		t.Fatalf("expected ErrCapacityExceeded got %v", err)
+	}
+	assertPanicsWith(t, ErrCapacityExceeded, func() { al.AddLast(3) })
+	assertElements[int](t, al, []int{0, 1, 2})
+
+	// Room freed at the front is reused without going over the bound
+	al.RemoveFirst()
+	if err := al.AddFirst(5); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	al.RemoveLast()
+	if err := al.AddFirst(6); err != nil || al.Capacity() > 3 {
+		t.Fatalf("expected capacity at most 3 got %d %v", al.Capacity(), err)
+	}
+	assertElements[int](t, al, []int{6, 5, 1})
+	if clone := al.Clone(); !errors.Is(clone.Add(1, 0), ErrCapacityExceeded) {
+		t.Fatalf("expected the clone to keep the maximum capacity")
+	}
+}
+
+func TestGrowthPolicyOverflow(t *testing.T) {
+	g := growthPolicy{}
+	if c, err := g.next(math.MaxInt/2+1, math.MaxInt/2+2); err != nil || c != math.MaxInt {
+		t.Fatalf("expected saturation at MaxInt got %d %v", c, err)
+	}
+	if _, err := g.next(1, -1); !errors.Is(err, ErrCapacityExceeded) {
+		t.Fatalf("expected ErrCapacityExceeded for an overflowed size got %v", err)
+	}
+}
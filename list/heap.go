@@ -0,0 +1,58 @@
+package list
+
+import "container/heap"
+
+// HeapAdapter lets container/heap maintain a binary heap inside an array list ordered by less
+// It implements heap.Interface, so existing code built on the heap package can keep its calls
+// while the elements live in an ArrayList; Push and Pop on the adapter are for the heap package,
+// use PushValue and PopValue directly
+type HeapAdapter[T any] struct {
+	sortAdapter[T]
+}
+
+var _ heap.Interface = (*HeapAdapter[int])(nil)
+
+// NewHeapAdapter wraps al and arranges its elements into a heap ordered by less
+// The smallest element by less sits at index 0
+func NewHeapAdapter[T any](al *ArrayList[T], less func(a, b T) bool) *HeapAdapter[T] {
+	h := &HeapAdapter[T]{sortAdapter[T]{al: al, less: less}}
+	heap.Init(h)
+	return h
+}
+
+// List returns the array list holding the heap
+func (h *HeapAdapter[T]) List() *ArrayList[T] {
+	return h.al
+}
+
+// Push appends x for the heap package, which restores the heap order afterwards
+func (h *HeapAdapter[T]) Push(x any) {
+	h.al.AddLast(x.(T))
+}
+
+// Pop removes the last element for the heap package, which has moved the top there
+func (h *HeapAdapter[T]) Pop() any {
+	v, _ := h.al.RemoveLast()
+	return v
+}
+
+// PushValue adds elem to the heap
+func (h *HeapAdapter[T]) PushValue(elem T) {
+	heap.Push(h, elem)
+}
+
+// PopValue removes and returns the smallest element
+// Returns error if the heap is empty
+func (h *HeapAdapter[T]) PopValue() (T, error) {
+	if h.al.IsEmpty() {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	return heap.Pop(h).(T), nil
+}
+
+// Peek returns the smallest element without removing it
+// Returns error if the heap is empty
+func (h *HeapAdapter[T]) Peek() (T, error) {
+	return h.al.GetFirst()
+}
@@ -0,0 +1,38 @@
+package list
+
+import (
+	"container/heap"
+	"errors"
+	"testing"
+)
+
+func TestHeapAdapter(t *testing.T) {
+	al := NewArrayListFromSlice([]int{5, 3, 8, 1})
+	h := NewHeapAdapter(al, func(a, b int) bool { return a < b })
+	if top, _ := h.Peek(); top != 1 {
+		t.Fatalf("expected 1 on top got %d", top)
+	}
+
+	h.PushValue(0)
+	heap.Push(h, 4)
+	var got []int
+	for h.Len() > 0 {
+		v, err := h.PopValue()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got = append(got, v)
+	}
+	want := []int{0, 1, 3, 4, 5, 8}
+	for i, v := range want {
+		if got[i] != v {
+			t.Fatalf("expected %v got %v", want, got)
+		}
+	}
+	if _, err := h.PopValue(); !errors.Is(err, ErrEmptyList) {
+		t.Fatalf("expected ErrEmptyList got %v", err)
+	}
+	if h.List() != al || !al.IsEmpty() {
+		t.Fatalf("expected the heap to live in the wrapped list")
+	}
+}
@@ -0,0 +1,163 @@
+package list
+
+import (
+	"errors"
+	"iter"
+)
+
+var (
+	ErrImmutable = errors.New("list is immutable")
+)
+
+// ImmutableList is a read-only view of a list, for handing a list to code that must not change it
+// It implements List so it can be passed wherever a list is read; mutating methods that return an
+// error return ErrImmutable, and AddLast, Clear and Reverse panic with it
+type ImmutableList[T any] struct {
+	list List[T]
+}
+
+var _ List[int] = (*ImmutableList[int])(nil)
+
+// NewImmutableList creates a read-only view of l
+// Changes made to l directly remain visible through the view
+func NewImmutableList[T any](l List[T]) *ImmutableList[T] {
+	if il, ok := l.(*ImmutableList[T]); ok {
+		return il
+	}
+	return &ImmutableList[T]{list: l}
+}
+
+// Freeze returns a read-only copy of the array list that later changes to it do not affect
+func (al *ArrayList[T]) Freeze() *ImmutableList[T] {
+	return &ImmutableList[T]{list: al.Clone()}
+}
+
+// Freeze returns a read-only copy of the linked list that later changes to it do not affect
+func (ll *LinkedList[T]) Freeze() *ImmutableList[T] {
+	return &ImmutableList[T]{list: ll.Clone()}
+}
+
+// Size returns the number of elements in the list
+func (il *ImmutableList[T]) Size() int {
+	return il.list.Size()
+}
+
+// IsEmpty checks if the list is empty
+func (il *ImmutableList[T]) IsEmpty() bool {
+	return il.list.IsEmpty()
+}
+
+// Get returns the element at the specified index position
+// Returns error if index is out of bounds
+func (il *ImmutableList[T]) Get(index int) (T, error) {
+	return il.list.Get(index)
+}
+
+// GetFirst returns the first element of the list
+// Returns error if list is empty
+func (il *ImmutableList[T]) GetFirst() (T, error) {
+	return il.list.GetFirst()
+}
+
+// GetLast returns the last element of the list
+// Returns error if list is empty
+func (il *ImmutableList[T]) GetLast() (T, error) {
+	return il.list.GetLast()
+}
+
+// Contains checks if the list contains the specified element
+func (il *ImmutableList[T]) Contains(elem T) bool {
+	return il.list.Contains(elem)
+}
+
+// IndexOf returns the first index of the specified element
+// Returns -1 if element is not found
+func (il *ImmutableList[T]) IndexOf(elem T) int {
+	return il.list.IndexOf(elem)
+}
+
+// ToSlice returns a copy of the elements
+func (il *ImmutableList[T]) ToSlice() []T {
+	return il.list.ToSlice()
+}
+
+// AppendTo appends the elements to dst and returns the extended slice
+func (il *ImmutableList[T]) AppendTo(dst []T) []T {
+	return il.list.AppendTo(dst)
+}
+
+// Values returns a sequence of the elements in order
+func (il *ImmutableList[T]) Values() iter.Seq[T] {
+	return values(il.list)
+}
+
+// All returns a sequence of index-element pairs in order
+func (il *ImmutableList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for v := range il.Values() {
+			if !yield(i, v) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Join renders the elements separated by sep
+func (il *ImmutableList[T]) Join(sep string) string {
+	return il.list.Join(sep)
+}
+
+// String returns a string representation of the list
+func (il *ImmutableList[T]) String() string {
+	return il.list.String()
+}
+
+// AddLast panics with ErrImmutable
+func (il *ImmutableList[T]) AddLast(T) {
+	panic(ErrImmutable)
+}
+
+// Add returns ErrImmutable
+func (il *ImmutableList[T]) Add(int, T) error {
+	return ErrImmutable
+}
+
+// Set returns ErrImmutable
+func (il *ImmutableList[T]) Set(int, T) error {
+	return ErrImmutable
+}
+
+// Remove returns ErrImmutable
+func (il *ImmutableList[T]) Remove(int) (T, error) {
+	var zero T
+	return zero, ErrImmutable
+}
+
+// RemoveFirst returns ErrImmutable
+func (il *ImmutableList[T]) RemoveFirst() (T, error) {
+	var zero T
+	return zero, ErrImmutable
+}
+
+// RemoveLast returns ErrImmutable
+func (il *ImmutableList[T]) RemoveLast() (T, error) {
+	var zero T
+	return zero, ErrImmutable
+}
+
+// RemoveElement always returns false, since nothing can be removed
+func (il *ImmutableList[T]) RemoveElement(T) bool {
+	return false
+}
+
+// Clear panics with ErrImmutable
+func (il *ImmutableList[T]) Clear() {
+	panic(ErrImmutable)
+}
+
+// Reverse panics with ErrImmutable
+func (il *ImmutableList[T]) Reverse() {
+	panic(ErrImmutable)
+}
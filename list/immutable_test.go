@@ -0,0 +1,73 @@
+package list
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestImmutableList(t *testing.T) {
+	al := NewArrayListFromSlice([]int{1, 2, 3})
+	view := NewImmutableList[int](al)
+	if NewImmutableList[int](view) != view {
+		t.Fatalf("expected wrapping a view to return it")
+	}
+
+	if v, _ := view.Get(1); v != 2 || view.Size() != 3 || !view.Contains(3) || view.IndexOf(3) != 2 {
+		t.Fatalf("unexpected reads from %v", view)
+	}
+	for name, err := range map[string]error{
+		"Add": view.Add(0, 1),
+		"Set": view.Set(0, 1),
+	} {
+		if !errors.Is(err, ErrImmutable) {
+			t.Fatalf("expected ErrImmutable from %s got %v", name, err)
+		}
+	}
+	if _, err := view.Remove(0); !errors.Is(err, ErrImmutable) {
+		t.Fatalf("expected ErrImmutable got %v", err)
+	}
+	if _, err := view.RemoveLast(); !errors.Is(err, ErrImmutable) {
+		t.Fatalf("expected ErrImmutable got %v", err)
+	}
+	if view.RemoveElement(1) {
+		t.Fatalf("expected RemoveElement to refuse")
+	}
+	assertPanicsWith(t, ErrImmutable, func() { view.AddLast(4) })
+	assertPanicsWith(t, ErrImmutable, view.Clear)
+	assertPanicsWith(t, ErrImmutable, view.Reverse)
+
+	// The view follows the backing list
+	al.AddLast(4)
+	if got := slices.Collect(view.Values()); !slices.Equal(got, []int{1, 2, 3, 4}) {
+		t.Fatalf("expected [1 2 3 4] got %v", got)
+	}
+}
+
+func TestFreeze(t *testing.T) {
+	ll := NewLinkedListFromSlice([]string{"a", "b"})
+	frozen := ll.Freeze()
+	ll.AddLast("c")
+	assertElements[string](t, frozen, []string{"a", "b"})
+	for i, v := range frozen.All() {
+		if want, _ := ll.Get(i); v != want {
+			t.Fatalf("expected %s at %d got %s", want, i, v)
+		}
+	}
+
+	al := NewArrayListFromSlice([]int{1})
+	if frozenAl := al.Freeze(); al.Set(0, 2) != nil || frozenAl.String() != "[1]" {
+		t.Fatalf("expected the frozen copy to be unaffected got %v", frozenAl)
+	}
+}
+
+func assertPanicsWith(t *testing.T, want error, fn func()) {
+	t.Helper()
+	defer func() {
+		err, _ := recover().(error)
+		if !errors.Is(err, want) {
+			t.Fatalf("expected panic with %v got %v", want, err)
+		}
+	}()
+	fn()
+}
@@ -0,0 +1,93 @@
+package list
+
+import "iter"
+
+// The sequences below panic with ErrConcurrentModification if the loop body adds or removes
+// elements, since the walk could otherwise skip or repeat elements silently
+
+// All returns a sequence of index-element pairs in order
+func (al *ArrayList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		modCount := al.modCount
+		for i := 0; i < al.size; i++ {
+			if !yield(i, al.elements[i]) {
+				return
+			}
+			checkModCount(modCount, al.modCount)
+		}
+	}
+}
+
+// Values returns a sequence of the elements in order
+func (al *ArrayList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		modCount := al.modCount
+		for i := 0; i < al.size; i++ {
+			if !yield(al.elements[i]) {
+				return
+			}
+			checkModCount(modCount, al.modCount)
+		}
+	}
+}
+
+// Backward returns a sequence of index-element pairs from the last element to the first
+func (al *ArrayList[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		modCount := al.modCount
+		for i := al.size - 1; i >= 0; i-- {
+			if !yield(i, al.elements[i]) {
+				return
+			}
+			checkModCount(modCount, al.modCount)
+		}
+	}
+}
+
+// All returns a sequence of index-element pairs in order
+func (ll *LinkedList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i, modCount := 0, ll.modCount
+		for cur := ll.head; cur != nil; cur = cur.next {
+			if !yield(i, cur.value) {
+				return
+			}
+			checkModCount(modCount, ll.modCount)
+			i++
+		}
+	}
+}
+
+// Values returns a sequence of the elements in order
+func (ll *LinkedList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		modCount := ll.modCount
+		for cur := ll.head; cur != nil; cur = cur.next {
+			if !yield(cur.value) {
+				return
+			}
+			checkModCount(modCount, ll.modCount)
+		}
+	}
+}
+
+// Backward returns a sequence of index-element pairs from the last element to the first
+// The list is singly linked, so the sequence first records every node, using O(n) extra memory
+func (ll *LinkedList[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		modCount, nodes := ll.modCount, ll.nodes()
+		for i := len(nodes) - 1; i >= 0; i-- {
+			if !yield(i, nodes[i].value) {
+				return
+			}
+			checkModCount(modCount, ll.modCount)
+		}
+	}
+}
+
+// checkModCount panics if the list changed structurally since the sequence started
+func checkModCount(expected, actual int) {
+	if expected != actual {
+		panic(ErrConcurrentModification)
+	}
+}
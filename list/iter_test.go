@@ -0,0 +1,107 @@
+package list
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestArrayListSequences(t *testing.T) {
+	al := NewArrayListFromSlice([]string{"a", "b", "c"})
+
+	var indexes []int
+	var values []string
+	for i, v := range al.All() {
+		indexes = append(indexes, i)
+		values = append(values, v)
+	}
+	if !slices.Equal(indexes, []int{0, 1, 2}) || !slices.Equal(values, []string{"a", "b", "c"}) {
+		t.Fatalf("unexpected All sequence %v %v", indexes, values)
+	}
+	if got := slices.Collect(al.Values()); !slices.Equal(got, []string{"a", "b", "c"}) {
+		t.Fatalf("expected [a b c] got %v", got)
+	}
+
+	values = values[:0]
+	for i, v := range al.Backward() {
+		if i == 0 {
+			break
+		}
+		values = append(values, v)
+	}
+	if !slices.Equal(values, []string{"c", "b"}) {
+		t.Fatalf("expected [c b] got %v", values)
+	}
+}
+
+func TestLinkedListSequences(t *testing.T) {
+	ll := NewLinkedListFromSlice([]int{10, 20, 30})
+
+	sum := 0
+	for i, v := range ll.All() {
+		sum += i * v
+	}
+	if sum != 80 {
+		t.Fatalf("expected 80 got %d", sum)
+	}
+
+	var values []int
+	for v := range ll.Values() {
+		if v == 30 {
+			break
+		}
+		values = append(values, v)
+	}
+	if !slices.Equal(values, []int{10, 20}) {
+		t.Fatalf("expected [10 20] got %v", values)
+	}
+	if got := slices.Collect(NewLinkedList[int]().Values()); len(got) != 0 {
+		t.Fatalf("expected empty sequence got %v", got)
+	}
+
+	var indices []int
+	values = values[:0]
+	for i, v := range ll.Backward() {
+		indices = append(indices, i)
+		values = append(values, v)
+	}
+	if !slices.Equal(indices, []int{2, 1, 0}) || !slices.Equal(values, []int{30, 20, 10}) {
+		t.Fatalf("expected backward walk got indices %v values %v", indices, values)
+	}
+}
+
+func TestSequencesConcurrentModification(t *testing.T) {
+	expectPanic := func(name string, walk func()) {
+		t.Helper()
+		defer func() {
+			if r := recover(); r != ErrConcurrentModification {
+				t.Fatalf("%s: expected ErrConcurrentModification panic got %v", name, r)
+			}
+		}()
+		walk()
+	}
+
+	al := NewArrayListFromSlice([]int{1, 2, 3})
+	expectPanic("array All", func() {
+		for range al.All() {
+			al.AddLast(0)
+		}
+	})
+	expectPanic("array Backward", func() {
+		for range al.Backward() {
+			al.RemoveFirst()
+		}
+	})
+
+	ll := NewLinkedListFromSlice([]int{1, 2, 3})
+	expectPanic("linked Values", func() {
+		for v := range ll.Values() {
+			ll.RemoveElement(v)
+		}
+	})
+
+	// Breaking out right after a change is allowed
+	for range ll.All() {
+		ll.AddFirst(9)
+		break
+	}
+}
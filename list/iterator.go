@@ -0,0 +1,295 @@
+package list
+
+import "errors"
+
+var (
+	ErrNoMoreElements         = errors.New("iterator has no more elements")
+	ErrNoCurrent              = errors.New("iterator has no current element")
+	ErrConcurrentModification = errors.New("list modified during iteration")
+)
+
+// Iterator walks a list front to back, or back to front when descending, and can modify the element it last returned
+// Adding or removing elements other than through the iterator makes its next call fail
+// with ErrConcurrentModification
+type Iterator[T any] interface {
+	// HasNext checks if Next has an element to return
+	HasNext() bool
+	// Next advances to and returns the next element
+	// Returns error if there are no more elements or the list was modified
+	Next() (T, error)
+	// Remove deletes the element last returned by Next
+	// Returns error if Next has not been called, the element was already removed or the list was modified
+	Remove() error
+	// Set replaces the element last returned by Next
+	// Returns error if Next has not been called, the element was removed or the list was modified
+	Set(elem T) error
+}
+
+type arrayListIterator[T any] struct {
+	al       *ArrayList[T]
+	cursor   int
+	last     int
+	modCount int
+}
+
+// Iterator returns an iterator positioned before the first element
+// Remove shifts the following elements, so it costs O(n)
+func (al *ArrayList[T]) Iterator() Iterator[T] {
+	return &arrayListIterator[T]{al: al, last: -1, modCount: al.modCount}
+}
+
+func (it *arrayListIterator[T]) HasNext() bool {
+	return it.cursor < it.al.size
+}
+
+func (it *arrayListIterator[T]) Next() (T, error) {
+	if it.modCount != it.al.modCount {
+		var zero T
+		return zero, ErrConcurrentModification
+	}
+	if !it.HasNext() {
+		var zero T
+		return zero, ErrNoMoreElements
+	}
+	it.last = it.cursor
+	it.cursor++
+	return it.al.elements[it.last], nil
+}
+
+func (it *arrayListIterator[T]) Remove() error {
+	if it.modCount != it.al.modCount {
+		return ErrConcurrentModification
+	}
+	if it.last < 0 {
+		return ErrNoCurrent
+	}
+	if _, err := it.al.Remove(it.last); err != nil {
+		return err
+	}
+	it.modCount = it.al.modCount
+	it.cursor = it.last
+	it.last = -1
+	return nil
+}
+
+func (it *arrayListIterator[T]) Set(elem T) error {
+	if it.modCount != it.al.modCount {
+		return ErrConcurrentModification
+	}
+	if it.last < 0 {
+		return ErrNoCurrent
+	}
+	it.al.elements[it.last] = elem
+	return nil
+}
+
+type arrayListDescendingIterator[T any] struct {
+	al       *ArrayList[T]
+	cursor   int
+	last     int
+	modCount int
+}
+
+// DescendingIterator returns an iterator positioned after the last element that walks towards the first
+// Remove shifts the following elements, so it costs O(n)
+func (al *ArrayList[T]) DescendingIterator() Iterator[T] {
+	return &arrayListDescendingIterator[T]{al: al, cursor: al.size - 1, last: -1, modCount: al.modCount}
+}
+
+func (it *arrayListDescendingIterator[T]) HasNext() bool {
+	return it.cursor >= 0
+}
+
+func (it *arrayListDescendingIterator[T]) Next() (T, error) {
+	if it.modCount != it.al.modCount {
+		var zero T
+		return zero, ErrConcurrentModification
+	}
+	if !it.HasNext() {
+		var zero T
+		return zero, ErrNoMoreElements
+	}
+	it.last = it.cursor
+	it.cursor--
+	return it.al.elements[it.last], nil
+}
+
+func (it *arrayListDescendingIterator[T]) Remove() error {
+	if it.modCount != it.al.modCount {
+		return ErrConcurrentModification
+	}
+	if it.last < 0 {
+		return ErrNoCurrent
+	}
+	if _, err := it.al.Remove(it.last); err != nil {
+		return err
+	}
+	it.modCount = it.al.modCount
+	it.last = -1
+	return nil
+}
+
+func (it *arrayListDescendingIterator[T]) Set(elem T) error {
+	if it.modCount != it.al.modCount {
+		return ErrConcurrentModification
+	}
+	if it.last < 0 {
+		return ErrNoCurrent
+	}
+	it.al.elements[it.last] = elem
+	return nil
+}
+
+type linkedListIterator[T any] struct {
+	ll   *LinkedList[T]
+	next *node[T]
+	// before is the node preceding next, lastPrev the node preceding last
+	before   *node[T]
+	last     *node[T]
+	lastPrev *node[T]
+	modCount int
+}
+
+// Iterator returns an iterator positioned before the first element
+// The iterator tracks the predecessor of the current node, so Remove costs O(1)
+func (ll *LinkedList[T]) Iterator() Iterator[T] {
+	return &linkedListIterator[T]{ll: ll, next: ll.head, modCount: ll.modCount}
+}
+
+func (it *linkedListIterator[T]) HasNext() bool {
+	return it.next != nil
+}
+
+func (it *linkedListIterator[T]) Next() (T, error) {
+	if it.modCount != it.ll.modCount {
+		var zero T
+		return zero, ErrConcurrentModification
+	}
+	if it.next == nil {
+		var zero T
+		return zero, ErrNoMoreElements
+	}
+	it.lastPrev = it.before
+	it.last = it.next
+	it.before = it.next
+	it.next = it.next.next
+	return it.last.value, nil
+}
+
+func (it *linkedListIterator[T]) Remove() error {
+	if it.modCount != it.ll.modCount {
+		return ErrConcurrentModification
+	}
+	if it.last == nil {
+		return ErrNoCurrent
+	}
+
+	ll := it.ll
+	if it.lastPrev == nil {
+		ll.head = it.next
+	} else {
+		it.lastPrev.next = it.next
+	}
+	if ll.tail == it.last {
+		ll.tail = it.lastPrev
+	}
+	ll.freeNode(it.last)
+	ll.size--
+	ll.modCount++
+	ll.cursor = nil
+	it.modCount = ll.modCount
+
+	it.before = it.lastPrev
+	it.last = nil
+	return nil
+}
+
+func (it *linkedListIterator[T]) Set(elem T) error {
+	if it.modCount != it.ll.modCount {
+		return ErrConcurrentModification
+	}
+	if it.last == nil {
+		return ErrNoCurrent
+	}
+	it.last.value = elem
+	return nil
+}
+
+type linkedListDescendingIterator[T any] struct {
+	ll *LinkedList[T]
+	// nodes holds the list as it was when the iterator was created; removals only ever
+	// unlink the node at last, whose predecessor at last-1 has not been visited yet
+	nodes    []*node[T]
+	cursor   int
+	last     int
+	modCount int
+}
+
+// DescendingIterator returns an iterator positioned after the last element that walks towards the first
+// The list is singly linked, so the iterator first records every node, using O(n) extra memory
+// Remove costs O(1)
+func (ll *LinkedList[T]) DescendingIterator() Iterator[T] {
+	nodes := ll.nodes()
+	return &linkedListDescendingIterator[T]{ll: ll, nodes: nodes, cursor: len(nodes) - 1, last: -1, modCount: ll.modCount}
+}
+
+func (it *linkedListDescendingIterator[T]) HasNext() bool {
+	return it.cursor >= 0
+}
+
+func (it *linkedListDescendingIterator[T]) Next() (T, error) {
+	if it.modCount != it.ll.modCount {
+		var zero T
+		return zero, ErrConcurrentModification
+	}
+	if !it.HasNext() {
+		var zero T
+		return zero, ErrNoMoreElements
+	}
+	it.last = it.cursor
+	it.cursor--
+	return it.nodes[it.last].value, nil
+}
+
+func (it *linkedListDescendingIterator[T]) Remove() error {
+	if it.modCount != it.ll.modCount {
+		return ErrConcurrentModification
+	}
+	if it.last < 0 {
+		return ErrNoCurrent
+	}
+
+	ll, removed := it.ll, it.nodes[it.last]
+	var prev *node[T]
+	if it.last > 0 {
+		prev = it.nodes[it.last-1]
+	}
+	if prev == nil {
+		ll.head = removed.next
+	} else {
+		prev.next = removed.next
+	}
+	if ll.tail == removed {
+		ll.tail = prev
+	}
+	ll.freeNode(removed)
+	ll.size--
+	ll.modCount++
+	ll.cursor = nil
+	it.modCount = ll.modCount
+
+	it.nodes[it.last] = nil
+	it.last = -1
+	return nil
+}
+
+func (it *linkedListDescendingIterator[T]) Set(elem T) error {
+	if it.modCount != it.ll.modCount {
+		return ErrConcurrentModification
+	}
+	if it.last < 0 {
+		return ErrNoCurrent
+	}
+	it.nodes[it.last].value = elem
+	return nil
+}
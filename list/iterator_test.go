@@ -0,0 +1,149 @@
+package list
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestIteratorRemoveAndSet(t *testing.T) {
+	for name, newList := range map[string]func([]int) List[int]{
+		"array":  func(s []int) List[int] { return NewArrayListFromSlice(s) },
+		"linked": func(s []int) List[int] { return NewLinkedListFromSlice(s) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			l := newList([]int{1, 2, 3, 4, 5, 6})
+			it := l.(interface{ Iterator() Iterator[int] }).Iterator()
+
+			if err := it.Remove(); !errors.Is(err, ErrNoCurrent) {
+				t.Fatalf("expected ErrNoCurrent got %v", err)
+			}
+			var seen []int
+			for it.HasNext() {
+				v, err := it.Next()
+				if err != nil {
+					t.Fatalf("expected no error got %v", err)
+				}
+				seen = append(seen, v)
+				switch {
+				case v%2 == 0:
+					if err := it.Remove(); err != nil {
+						t.Fatalf("expected no error got %v", err)
+					}
+					if err := it.Set(0); !errors.Is(err, ErrNoCurrent) {
+						t.Fatalf("expected ErrNoCurrent after Remove got %v", err)
+					}
+				case v == 3:
+					it.Set(30)
+				}
+			}
+			if len(seen) != 6 {
+				t.Fatalf("expected every element to be visited once got %v", seen)
+			}
+			if _, err := it.Next(); !errors.Is(err, ErrNoMoreElements) {
+				t.Fatalf("expected ErrNoMoreElements got %v", err)
+			}
+			assertElements(t, l, []int{1, 30, 5})
+
+			// The last element was removed, so the tail must have moved back
+			l.AddLast(7)
+			assertElements(t, l, []int{1, 30, 5, 7})
+		})
+	}
+}
+
+func TestIteratorRemoveAll(t *testing.T) {
+	ll := NewLinkedListFromSlice([]int{1, 2, 3})
+	it := ll.Iterator()
+	for it.HasNext() {
+		it.Next()
+		it.Remove()
+	}
+	if !ll.IsEmpty() {
+		t.Fatalf("expected empty list got %v", ll)
+	}
+	ll.AddLast(4)
+	ll.AddFirst(3)
+	assertElements[int](t, ll, []int{3, 4})
+}
+
+func TestIteratorConcurrentModification(t *testing.T) {
+	for name, newList := range map[string]func([]int) List[int]{
+		"array":  func(s []int) List[int] { return NewArrayListFromSlice(s) },
+		"linked": func(s []int) List[int] { return NewLinkedListFromSlice(s) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			l := newList([]int{1, 2, 3})
+			it := l.(interface{ Iterator() Iterator[int] }).Iterator()
+			it.Next()
+			it.Remove()
+			// Changes made through the iterator keep it valid
+			if v, err := it.Next(); err != nil || v != 2 {
+				t.Fatalf("expected 2 got %d %v", v, err)
+			}
+			if err := it.Set(20); err != nil {
+				t.Fatalf("expected no error got %v", err)
+			}
+
+			l.Set(1, 30)
+			if _, err := it.Next(); err != nil {
+				t.Fatalf("Set is not a structural change, got %v", err)
+			}
+			l.AddLast(4)
+			if _, err := it.Next(); !errors.Is(err, ErrConcurrentModification) {
+				t.Fatalf("expected ErrConcurrentModification got %v", err)
+			}
+			if err := it.Remove(); !errors.Is(err, ErrConcurrentModification) {
+				t.Fatalf("expected ErrConcurrentModification got %v", err)
+			}
+		})
+	}
+}
+
+func TestDescendingIterator(t *testing.T) {
+	for name, newList := range map[string]func([]int) List[int]{
+		"array":  func(s []int) List[int] { return NewArrayListFromSlice(s) },
+		"linked": func(s []int) List[int] { return NewLinkedListFromSlice(s) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			l := newList([]int{1, 2, 3, 4, 5, 6})
+			it := l.(interface{ DescendingIterator() Iterator[int] }).DescendingIterator()
+
+			var seen []int
+			for it.HasNext() {
+				v, err := it.Next()
+				if err != nil {
+					t.Fatalf("expected no error got %v", err)
+				}
+				seen = append(seen, v)
+				switch {
+				case v%2 == 0:
+					if err := it.Remove(); err != nil {
+						t.Fatalf("expected no error got %v", err)
+					}
+					if err := it.Remove(); !errors.Is(err, ErrNoCurrent) {
+						t.Fatalf("expected ErrNoCurrent after Remove got %v", err)
+					}
+				case v == 1:
+					it.Set(10)
+				}
+			}
+			if len(seen) != 6 || seen[0] != 6 || seen[5] != 1 {
+				t.Fatalf("expected every element back to front got %v", seen)
+			}
+			if _, err := it.Next(); !errors.Is(err, ErrNoMoreElements) {
+				t.Fatalf("expected ErrNoMoreElements got %v", err)
+			}
+			assertElements(t, l, []int{10, 3, 5})
+
+			// The last element was removed, so the tail must have moved back
+			l.AddLast(7)
+			assertElements(t, l, []int{10, 3, 5, 7})
+
+			it = l.(interface{ DescendingIterator() Iterator[int] }).DescendingIterator()
+			l.AddLast(8)
+			if _, err := it.Next(); !errors.Is(err, ErrConcurrentModification) {
+				t.Fatalf("expected ErrConcurrentModification got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package list
+
+import "encoding/json"
+
+// MarshalJSON encodes the array list as a JSON array
+func (al *ArrayList[T]) MarshalJSON() ([]byte, error) {
+	return marshalElements(al.elements[:al.size])
+}
+
+// UnmarshalJSON replaces the contents of the array list with the elements of a JSON array
+func (al *ArrayList[T]) UnmarshalJSON(data []byte) error {
+	var elements []T
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	al.Clear()
+	al.AddSlice(elements)
+	return nil
+}
+
+// MarshalJSON encodes the linked list as a JSON array
+func (ll *LinkedList[T]) MarshalJSON() ([]byte, error) {
+	return marshalElements(ll.ToSlice())
+}
+
+// UnmarshalJSON replaces the contents of the linked list with the elements of a JSON array
+func (ll *LinkedList[T]) UnmarshalJSON(data []byte) error {
+	var elements []T
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	ll.Clear()
+	ll.AddSlice(elements)
+	return nil
+}
+
+// MarshalJSON encodes the current snapshot of the list as a JSON array
+func (l *CopyOnWriteArrayList[T]) MarshalJSON() ([]byte, error) {
+	return marshalElements(l.snapshot())
+}
+
+// UnmarshalJSON replaces the contents of the list with the elements of a JSON array
+func (l *CopyOnWriteArrayList[T]) UnmarshalJSON(data []byte) error {
+	var elements []T
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.store(elements)
+	return nil
+}
+
+// MarshalJSON encodes the list as a JSON array
+func (il *ImmutableList[T]) MarshalJSON() ([]byte, error) {
+	return marshalElements(il.list.ToSlice())
+}
+
+// marshalElements encodes elements as a JSON array, which is empty rather than null for no elements
+func marshalElements[T any](elements []T) ([]byte, error) {
+	if elements == nil {
+		elements = []T{}
+	}
+	return json.Marshal(elements)
+}
@@ -0,0 +1,54 @@
+package list
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestListJSONRoundTrip(t *testing.T) {
+	type payload struct {
+		Names *ArrayList[string]            `json:"names"`
+		IDs   *LinkedList[int]              `json:"ids"`
+		Tags  *CopyOnWriteArrayList[string] `json:"tags"`
+		Empty *ArrayList[int]               `json:"empty"`
+	}
+	in := payload{
+		Names: NewArrayListFromSlice([]string{"a", "b"}),
+		IDs:   NewLinkedListFromSlice([]int{3, 1, 2}),
+		Tags:  NewCopyOnWriteArrayListFromSlice([]string{"x"}),
+		Empty: NewArrayList[int](),
+	}
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `{"names":["a","b"],"ids":[3,1,2],"tags":["x"],"empty":[]}`
+	if string(data) != want {
+		t.Fatalf("expected %s got %s", want, data)
+	}
+
+	var out payload
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertElements[string](t, out.Names, []string{"a", "b"})
+	assertElements[int](t, out.IDs, []int{3, 1, 2})
+	assertElements[string](t, out.Tags, []string{"x"})
+	assertElements[int](t, out.Empty, []int{})
+
+	// Decoded lists are fully usable
+	out.Names.AddLast("c")
+	if !out.Names.Contains("c") || out.IDs.IndexOf(2) != 2 {
+		t.Fatalf("unexpected decoded lists %v %v", out.Names, out.IDs)
+	}
+
+	if err := out.IDs.UnmarshalJSON([]byte(`["x"]`)); err == nil {
+		t.Fatalf("expected an error for mismatched element types")
+	}
+	assertElements[int](t, out.IDs, []int{3, 1, 2})
+
+	frozen, _ := json.Marshal(out.IDs.Freeze())
+	if string(frozen) != "[3,1,2]" {
+		t.Fatalf("expected [3,1,2] got %s", frozen)
+	}
+}
@@ -3,27 +3,79 @@ package list
 import (
 	"fmt"
 	"strings"
+	"sync"
+
+	"github.com/profoundwu/containers/arena"
 )
 
-type node[T comparable] struct {
+type node[T any] struct {
 	value T
 	next  *node[T]
 }
 
-type LinkedList[T comparable] struct {
+type LinkedList[T any] struct {
 	head *node[T]
 	tail *node[T]
 	size int
+	// alloc hands out nodes when they come from an allocator; the list resets arena on Clear
+	// when it owns it
+	alloc    func() *node[T]
+	arena    *arena.Arena
+	pool     *sync.Pool
+	format   FormatFunc[T]
+	layout   *layout
+	equality equality[T]
+	// modCount counts structural changes so iterators can detect modification behind their back
+	modCount int
+
+	// cursor remembers the most recently reached node so nearby positional
+	// accesses can continue from it instead of walking from the head
+	cursor      *node[T]
+	cursorIndex int
 }
 
 // NewLinkedList creates a new empty linked list
 func NewLinkedList[T comparable]() *LinkedList[T] {
-	return &LinkedList[T]{}
+	return &LinkedList[T]{equality: comparableEquality[T]()}
+}
+
+// NewLinkedListWithEquals creates a new empty linked list that compares elements with eq
+// This allows element types that are not comparable, or semantic equality for those that are
+// Contains, IndexOf, RemoveElement and the bulk operations all go through eq, so set
+// operations such as RemoveAll and Distinct take quadratic time
+func NewLinkedListWithEquals[T any](eq func(a, b T) bool) *LinkedList[T] {
+	return &LinkedList[T]{equality: funcEquality(eq)}
+}
+
+// NewLinkedListWithArena creates a new empty linked list that allocates its nodes
+// from an arena in chunks of chunkSize nodes
+// Removed nodes are not reclaimed individually; Clear releases all of them at once
+func NewLinkedListWithArena[T comparable](chunkSize int) *LinkedList[T] {
+	a := arena.New(chunkSize)
+	return &LinkedList[T]{alloc: arena.Allocate[node[T]](a), arena: a, equality: comparableEquality[T]()}
+}
+
+// NewLinkedListWithAllocator creates a new empty linked list that allocates its nodes from a,
+// which other containers may share
+// Removed nodes are not reclaimed individually, not even by Clear; they are released when the
+// allocator is, such as by resetting an arena.Arena once every container using it is discarded
+func NewLinkedListWithAllocator[T comparable](a arena.Allocator) *LinkedList[T] {
+	return &LinkedList[T]{alloc: arena.Allocate[node[T]](a), equality: comparableEquality[T]()}
+}
+
+// NewLinkedListPooled creates a new empty linked list that recycles its nodes through a sync.Pool
+// Removed nodes go back to the pool and later additions reuse them, which cuts allocations for
+// lists with heavy add and remove churn
+func NewLinkedListPooled[T comparable]() *LinkedList[T] {
+	return &LinkedList[T]{
+		pool:     &sync.Pool{New: func() any { return new(node[T]) }},
+		equality: comparableEquality[T](),
+	}
 }
 
 // NewLinkedListFromSlice creates a linked list from a slice
 func NewLinkedListFromSlice[T comparable](slice []T) *LinkedList[T] {
-	list := &LinkedList[T]{}
+	list := &LinkedList[T]{equality: comparableEquality[T]()}
 	for _, v := range slice {
 		list.AddLast(v)
 	}
@@ -42,12 +94,14 @@ func (ll *LinkedList[T]) IsEmpty() bool {
 
 // AddFirst adds an element to the beginning of the linked list
 func (ll *LinkedList[T]) AddFirst(elem T) {
-	newNode := &node[T]{value: elem, next: ll.head}
+	newNode := ll.newNode(elem, ll.head)
 	ll.head = newNode
 	if ll.tail == nil {
 		ll.tail = newNode
 	}
 	ll.size++
+	ll.modCount++
+	ll.cursorIndex++
 }
 
 // AddLast adds an element to the end of the linked list
@@ -57,10 +111,11 @@ func (ll *LinkedList[T]) AddLast(elem T) {
 		return
 	}
 
-	newNode := &node[T]{value: elem}
+	newNode := ll.newNode(elem, nil)
 	ll.tail.next = newNode
 	ll.tail = newNode
 	ll.size++
+	ll.modCount++
 }
 
 // Add inserts an element at the specified index position
@@ -80,9 +135,11 @@ func (ll *LinkedList[T]) Add(index int, elem T) error {
 		if err != nil {
 			return err
 		}
-		newNode := &node[T]{value: elem, next: prev.next}
+		newNode := ll.newNode(elem, prev.next)
 		prev.next = newNode
 		ll.size++
+		ll.modCount++
+		ll.cursor, ll.cursorIndex = newNode, index
 	}
 	return nil
 }
@@ -95,15 +152,7 @@ func (ll *LinkedList[T]) Get(index int) (T, error) {
 		return zero, fmt.Errorf("%w: %d, list size: %d", ErrIndexOutOfBounds, index, ll.size)
 	}
 
-	if index == ll.size-1 && ll.tail != nil {
-		return ll.tail.value, nil
-	}
-
-	cur := ll.head
-	for i := 0; i < index; i++ {
-		cur = cur.next
-	}
-	return cur.value, nil
+	return ll.nodeAt(index).value, nil
 }
 
 // GetFirst returns the first element of the linked list
@@ -133,11 +182,7 @@ func (ll *LinkedList[T]) Set(index int, elem T) error {
 		return fmt.Errorf("%w: %d, list size: %d", ErrIndexOutOfBounds, index, ll.size)
 	}
 
-	cur := ll.head
-	for i := 0; i < index; i++ {
-		cur = cur.next
-	}
-	cur.value = elem
+	ll.nodeAt(index).value = elem
 	return nil
 }
 
@@ -154,11 +199,15 @@ func (ll *LinkedList[T]) Remove(index int) (T, error) {
 		removed = ll.head.value
 		oldHead := ll.head
 		ll.head = ll.head.next
-		oldHead.next = nil
+		ll.freeNode(oldHead)
 
 		if ll.head == nil {
 			ll.tail = nil
 		}
+		if ll.cursor == oldHead {
+			ll.cursor = nil
+		}
+		ll.cursorIndex--
 	} else {
 		prev, err := ll.findPreviousNode(index)
 		if err != nil {
@@ -167,7 +216,7 @@ func (ll *LinkedList[T]) Remove(index int) (T, error) {
 		removed = prev.next.value
 		oldNode := prev.next
 		prev.next = prev.next.next
-		oldNode.next = nil
+		ll.freeNode(oldNode)
 
 		if index == ll.size-1 {
 			ll.tail = prev
@@ -175,6 +224,7 @@ func (ll *LinkedList[T]) Remove(index int) (T, error) {
 	}
 
 	ll.size--
+	ll.modCount++
 	return removed, nil
 }
 
@@ -205,29 +255,33 @@ func (ll *LinkedList[T]) RemoveElement(elem T) bool {
 		return false
 	}
 
-	if ll.head.value == elem {
+	if ll.equality.eq(ll.head.value, elem) {
 		oldHead := ll.head
 		ll.head = ll.head.next
-		oldHead.next = nil
+		ll.freeNode(oldHead)
 
 		if ll.head == nil {
 			ll.tail = nil
 		}
 		ll.size--
+		ll.modCount++
+		ll.cursor = nil
 		return true
 	}
 
 	cur := ll.head
 	for cur.next != nil {
-		if cur.next.value == elem {
+		if ll.equality.eq(cur.next.value, elem) {
 			oldNode := cur.next
 			cur.next = cur.next.next
-			oldNode.next = nil
+			ll.freeNode(oldNode)
 
 			if cur.next == nil {
 				ll.tail = cur
 			}
 			ll.size--
+			ll.modCount++
+			ll.cursor = nil
 			return true
 		}
 		cur = cur.next
@@ -247,7 +301,7 @@ func (ll *LinkedList[T]) IndexOf(elem T) int {
 	cur := ll.head
 	index := 0
 	for cur != nil {
-		if cur.value == elem {
+		if ll.equality.eq(cur.value, elem) {
 			return index
 		}
 		cur = cur.next
@@ -256,28 +310,70 @@ func (ll *LinkedList[T]) IndexOf(elem T) int {
 	return -1
 }
 
+// LastIndexOf returns the last index of the specified element in the linked list
+// The list is singly linked, so this always walks every node
+// Returns -1 if element is not found
+func (ll *LinkedList[T]) LastIndexOf(elem T) int {
+	last := -1
+	index := 0
+	for cur := ll.head; cur != nil; cur = cur.next {
+		if ll.equality.eq(cur.value, elem) {
+			last = index
+		}
+		index++
+	}
+	return last
+}
+
+// nodes returns the nodes of the linked list in order, for walks that need to go backwards
+func (ll *LinkedList[T]) nodes() []*node[T] {
+	nodes := make([]*node[T], 0, ll.size)
+	for cur := ll.head; cur != nil; cur = cur.next {
+		nodes = append(nodes, cur)
+	}
+	return nodes
+}
+
 // Clear removes all elements from the linked list
 func (ll *LinkedList[T]) Clear() {
+	if ll.alloc != nil {
+		// Resetting an owned arena zeroes every node, so there is nothing to unlink
+		if ll.arena != nil {
+			ll.arena.Reset()
+		}
+		ll.head = nil
+		ll.tail = nil
+		ll.size = 0
+		ll.modCount++
+		ll.cursor = nil
+		return
+	}
+
 	cur := ll.head
 	for cur != nil {
 		next := cur.next
-		cur.next = nil
+		ll.freeNode(cur)
 		cur = next
 	}
 	ll.head = nil
 	ll.tail = nil
 	ll.size = 0
+	ll.modCount++
+	ll.cursor = nil
 }
 
 // ToSlice converts the linked list to a slice
 func (ll *LinkedList[T]) ToSlice() []T {
-	slice := make([]T, 0, ll.size)
-	cur := ll.head
-	for cur != nil {
-		slice = append(slice, cur.value)
-		cur = cur.next
+	return ll.AppendTo(make([]T, 0, ll.size))
+}
+
+// AppendTo appends the elements of the linked list to dst and returns the extended slice
+// Reusing dst across calls avoids allocating when it already has enough capacity
+func (ll *LinkedList[T]) AppendTo(dst []T) []T {
+	for cur := ll.head; cur != nil; cur = cur.next {
+		dst = append(dst, cur.value)
 	}
-	return slice
+	return dst
 }
 
 // Reverse reverses the linked list
@@ -293,23 +389,45 @@ func (ll *LinkedList[T]) Reverse() {
 		cur = next
 	}
 	ll.head = prev
+	ll.modCount++
+	ll.cursor = nil
 }
 
 // String returns a string representation of the linked list
 func (ll *LinkedList[T]) String() string {
+	l := ll.layout.or(linkedListLayout)
 	var sb strings.Builder
-	sb.WriteString("[")
+	sb.WriteString(l.prefix)
+	sb.WriteString(ll.Join(l.sep))
+	sb.WriteString(l.suffix)
+	return sb.String()
+}
 
-	cur := ll.head
-	for cur != nil {
-		sb.WriteString(fmt.Sprintf("%v", cur.value))
-		if cur.next != nil {
-			sb.WriteString(" -> ")
-		}
-		cur = cur.next
+// newNode allocates a node from the allocator or the pool when one is configured
+func (ll *LinkedList[T]) newNode(elem T, next *node[T]) *node[T] {
+	var n *node[T]
+	switch {
+	case ll.alloc != nil:
+		n = ll.alloc()
+	case ll.pool != nil:
+		n = ll.pool.Get().(*node[T])
+	default:
+		return &node[T]{value: elem, next: next}
+	}
+	n.value = elem
+	n.next = next
+	return n
+}
+
+// freeNode releases a node that has been unlinked, returning it to the pool when one is configured
+// The node must not be reachable from the list or any of its iterators afterwards
+func (ll *LinkedList[T]) freeNode(n *node[T]) {
+	n.next = nil
+	if ll.pool != nil {
+		var zero T
+		n.value = zero
+		ll.pool.Put(n)
 	}
-	sb.WriteString("]")
-	return sb.String()
 }
 
 // findPreviousNode finds the node before the specified index position
@@ -320,9 +438,25 @@ func (ll *LinkedList[T]) findPreviousNode(index int) (*node[T], error) {
 			ErrIndexOutOfBounds, index, ll.size)
 	}
 
-	prev := ll.head
-	for i := 0; i < index-1; i++ {
-		prev = prev.next
+	return ll.nodeAt(index - 1), nil
+}
+
+// nodeAt returns the node at a valid index, continuing from the cursor when it
+// is not past the index, and moves the cursor there
+// Sequential positional access therefore costs O(1) amortized per call
+func (ll *LinkedList[T]) nodeAt(index int) *node[T] {
+	if index == ll.size-1 {
+		ll.cursor, ll.cursorIndex = ll.tail, index
+		return ll.tail
+	}
+
+	cur, i := ll.head, 0
+	if ll.cursor != nil && ll.cursorIndex <= index {
+		cur, i = ll.cursor, ll.cursorIndex
+	}
+	for ; i < index; i++ {
+		cur = cur.next
 	}
-	return prev, nil
+	ll.cursor, ll.cursorIndex = cur, index
+	return cur
 }
@@ -3,7 +3,10 @@ package list
 import (
 	"errors"
 	"fmt"
+	"math/rand"
 	"testing"
+
+	"github.com/profoundwu/containers/arena"
 )
 
 func TestNewLinkedList(t *testing.T) {
@@ -179,6 +182,12 @@ func TestLinkedListContainsIndexOf(t *testing.T) {
 	if ll.IndexOf(100) != -1 {
 		t.Fatalf("expected -1 for missing element")
 	}
+	if ll.LastIndexOf(6) != 3 {
+		t.Fatalf("LastIndexOf 6 expected 3 got %d", ll.LastIndexOf(6))
+	}
+	if ll.LastIndexOf(100) != -1 {
+		t.Fatalf("expected -1 for missing element")
+	}
 }
 
 func TestLinkedListClear(t *testing.T) {
@@ -232,3 +241,130 @@ func TestLinkedListString(t *testing.T) {
 		t.Fatalf("empty list string mismatch")
 	}
 }
+
+func TestLinkedListWithArena(t *testing.T) {
+	ll := NewLinkedListWithArena[int](4)
+	for i := 0; i < 10; i++ {
+		ll.AddLast(i)
+	}
+	ll.AddFirst(-1)
+	if err := ll.Add(5, 100); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ll.String() != "[-1 -> 0 -> 1 -> 2 -> 3 -> 100 -> 4 -> 5 -> 6 -> 7 -> 8 -> 9]" {
+		t.Fatalf("unexpected contents %s", ll)
+	}
+	if ll.arena.Len() != 12 {
+		t.Fatalf("expected 12 arena nodes got %d", ll.arena.Len())
+	}
+
+	ll.Clear()
+	if !ll.IsEmpty() || ll.head != nil || ll.tail != nil || ll.arena.Len() != 0 {
+		t.Fatalf("clear did not release the arena")
+	}
+	ll.AddLast(7)
+	if v, _ := ll.GetFirst(); v != 7 || ll.Size() != 1 {
+		t.Fatalf("list should be reusable after clear")
+	}
+}
+
+func TestLinkedListWithAllocator(t *testing.T) {
+	a := arena.New(4)
+	first := NewLinkedListWithAllocator[int](a)
+	second := NewLinkedListWithAllocator[int](a)
+	for i := 0; i < 5; i++ {
+		first.AddLast(i)
+		second.AddFirst(i)
+	}
+	if a.Len() != 10 {
+		t.Fatalf("expected 10 shared arena nodes got %d", a.Len())
+	}
+
+	// Clearing a list leaves the shared arena, and so the other list, alone
+	first.Clear()
+	if !first.IsEmpty() || a.Len() != 10 {
+		t.Fatalf("expected the arena to keep 10 nodes got %d", a.Len())
+	}
+	assertElements[int](t, second, []int{4, 3, 2, 1, 0})
+
+	second.Clear()
+	a.Reset()
+	first.AddLast(7)
+	assertElements[int](t, first, []int{7})
+	if a.Len() != 1 {
+		t.Fatalf("expected the arena to be reused got %d nodes", a.Len())
+	}
+}
+
+func TestLinkedListCursorSequentialAccess(t *testing.T) {
+	ll := NewLinkedList[int]()
+	for i := 0; i < 100; i++ {
+		if err := ll.Add(i, i); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	for i := 0; i < ll.Size(); i++ {
+		if err := ll.Set(i, i*2); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if v, _ := ll.Get(i); v != i*2 {
+			t.Fatalf("expected %d at %d got %d", i*2, i, v)
+		}
+		if ll.cursorIndex != i {
+			t.Fatalf("cursor should follow sequential access, at %d want %d", ll.cursorIndex, i)
+		}
+	}
+}
+
+func TestLinkedListCursorMatchesModel(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	ll := NewLinkedList[int]()
+	var model []int
+	for step := 0; step < 5000; step++ {
+		switch op := r.Intn(8); {
+		case op < 3:
+			idx := r.Intn(len(model) + 1)
+			if err := ll.Add(idx, step); err != nil {
+				t.Fatalf("step %d: unexpected error: %v", step, err)
+			}
+			model = append(model[:idx], append([]int{step}, model[idx:]...)...)
+		case op == 3:
+			ll.AddFirst(step)
+			model = append([]int{step}, model...)
+		case op == 4 && len(model) > 0:
+			idx := r.Intn(len(model))
+			got, _ := ll.Remove(idx)
+			if got != model[idx] {
+				t.Fatalf("step %d: remove at %d got %d want %d", step, idx, got, model[idx])
+			}
+			model = append(model[:idx], model[idx+1:]...)
+		case op == 5 && len(model) > 0:
+			idx := r.Intn(len(model))
+			ll.Set(idx, -step)
+			model[idx] = -step
+		case op == 6 && len(model) > 0:
+			v := model[r.Intn(len(model))]
+			ll.RemoveElement(v)
+			for i, m := range model {
+				if m == v {
+					model = append(model[:i], model[i+1:]...)
+					break
+				}
+			}
+		case len(model) > 0:
+			idx := r.Intn(len(model))
+			if got, _ := ll.Get(idx); got != model[idx] {
+				t.Fatalf("step %d: get at %d got %d want %d", step, idx, got, model[idx])
+			}
+		}
+		if ll.Size() != len(model) {
+			t.Fatalf("step %d: size mismatch got %d want %d", step, ll.Size(), len(model))
+		}
+	}
+	s := ll.ToSlice()
+	for i := range model {
+		if s[i] != model[i] {
+			t.Fatalf("final contents mismatch at %d", i)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+package list
+
+import "slices"
+
+// List is the positional list interface implemented by ArrayList and LinkedList
+type List[T any] interface {
+	Size() int
+	IsEmpty() bool
+	AddLast(elem T)
+	Add(index int, elem T) error
+	Get(index int) (T, error)
+	GetFirst() (T, error)
+	GetLast() (T, error)
+	Set(index int, elem T) error
+	Remove(index int) (T, error)
+	RemoveFirst() (T, error)
+	RemoveLast() (T, error)
+	RemoveElement(elem T) bool
+	Contains(elem T) bool
+	IndexOf(elem T) int
+	Clear()
+	ToSlice() []T
+	AppendTo(dst []T) []T
+	Reverse()
+	Join(sep string) string
+	String() string
+}
+
+var (
+	_ List[int] = (*ArrayList[int])(nil)
+	_ List[int] = (*LinkedList[int])(nil)
+)
+
+// CopyInto replaces the contents of dst with the elements of src
+// Known implementation pairs use a bulk path: one grow and copy into an ArrayList,
+// or one freshly built node chain spliced into a LinkedList
+func CopyInto[T any](dst, src List[T]) {
+	if dst == src {
+		return
+	}
+
+	switch d := dst.(type) {
+	case *ArrayList[T]:
+		d.Clear()
+		d.ensureCapacity(src.Size())
+		d.size = len(src.AppendTo(d.elements[:0]))
+	case *LinkedList[T]:
+		d.Clear()
+		switch s := src.(type) {
+		case *ArrayList[T]:
+			d.spliceSlice(s.elements[:s.size])
+		case *LinkedList[T]:
+			d.spliceNodes(s.head, s.size)
+		default:
+			d.spliceSlice(src.ToSlice())
+		}
+	default:
+		dst.Clear()
+		for _, v := range src.ToSlice() {
+			dst.AddLast(v)
+		}
+	}
+}
+
+// ToLinkedList returns a linked list holding a copy of the array list's elements
+func (al *ArrayList[T]) ToLinkedList() *LinkedList[T] {
+	ll := &LinkedList[T]{equality: al.equality}
+	ll.spliceSlice(al.elements[:al.size])
+	return ll
+}
+
+// ToArrayList returns an array list holding a copy of the linked list's elements
+func (ll *LinkedList[T]) ToArrayList() *ArrayList[T] {
+	al := newArrayList(ll.size, ll.equality)
+	CopyInto[T](al, ll)
+	return al
+}
+
+// Equals checks if other holds the same elements in the same order
+// Comparing against another ArrayList or a LinkedList does not allocate
+func (al *ArrayList[T]) Equals(other List[T]) bool {
+	if other == nil || al.size != other.Size() {
+		return false
+	}
+
+	switch o := other.(type) {
+	case *ArrayList[T]:
+		return slices.EqualFunc(al.elements[:al.size], o.elements[:o.size], al.equality.eq)
+	case *LinkedList[T]:
+		i := 0
+		for cur := o.head; cur != nil; cur = cur.next {
+			if !al.equality.eq(al.elements[i], cur.value) {
+				return false
+			}
+			i++
+		}
+		return true
+	default:
+		return slices.EqualFunc(al.elements[:al.size], other.ToSlice(), al.equality.eq)
+	}
+}
+
+// Equals checks if other holds the same elements in the same order
+// Comparing against another LinkedList or an ArrayList does not allocate
+func (ll *LinkedList[T]) Equals(other List[T]) bool {
+	if other == nil || ll.size != other.Size() {
+		return false
+	}
+
+	switch o := other.(type) {
+	case *ArrayList[T]:
+		return o.Equals(ll)
+	case *LinkedList[T]:
+		for a, b := ll.head, o.head; a != nil; a, b = a.next, b.next {
+			if !ll.equality.eq(a.value, b.value) {
+				return false
+			}
+		}
+		return true
+	default:
+		i, elements := 0, other.ToSlice()
+		for cur := ll.head; cur != nil; cur = cur.next {
+			if !ll.equality.eq(cur.value, elements[i]) {
+				return false
+			}
+			i++
+		}
+		return true
+	}
+}
+
+// Clone returns an independent copy of the array list with the same capacity and format
+func (al *ArrayList[T]) Clone() *ArrayList[T] {
+	clone := newArrayList(al.Capacity(), al.equality)
+	clone.format, clone.layout, clone.growth = al.format, al.layout, al.growth
+	clone.size = copy(clone.elements, al.elements[:al.size])
+	return clone
+}
+
+// Clone returns an independent copy of the linked list with the same format
+// The copy allocates its nodes individually even if this list uses an arena or allocator
+func (ll *LinkedList[T]) Clone() *LinkedList[T] {
+	clone := &LinkedList[T]{format: ll.format, layout: ll.layout, equality: ll.equality}
+	for cur := ll.head; cur != nil; cur = cur.next {
+		clone.AddLast(cur.value)
+	}
+	return clone
+}
+
+// spliceSlice builds a node chain for slice and links it after the tail in one step
+func (ll *LinkedList[T]) spliceSlice(slice []T) {
+	if len(slice) == 0 {
+		return
+	}
+
+	first, last := ll.buildChain(slice)
+	ll.linkChain(first, last, len(slice))
+}
+
+// spliceNodes builds a node chain copying the values of the n nodes starting at src and links it
+// after the tail in one step
+func (ll *LinkedList[T]) spliceNodes(src *node[T], n int) {
+	if n == 0 {
+		return
+	}
+
+	first := ll.newNode(src.value, nil)
+	last := first
+	for cur, i := src.next, 1; i < n; cur, i = cur.next, i+1 {
+		last.next = ll.newNode(cur.value, nil)
+		last = last.next
+	}
+	ll.linkChain(first, last, n)
+}
+
+// linkChain links the chain from first to last holding n nodes after the tail
+func (ll *LinkedList[T]) linkChain(first, last *node[T], n int) {
+	if ll.tail == nil {
+		ll.head = first
+	} else {
+		ll.tail.next = first
+	}
+	ll.tail = last
+	ll.size += n
+	ll.modCount++
+}
+
+// buildChain links fresh nodes for a non-empty slice and returns the first and last of them
+func (ll *LinkedList[T]) buildChain(slice []T) (*node[T], *node[T]) {
+	first := ll.newNode(slice[0], nil)
+	last := first
+	for _, v := range slice[1:] {
+		n := ll.newNode(v, nil)
+		last.next = n
+		last = n
+	}
+	return first, last
+}
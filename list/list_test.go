@@ -0,0 +1,126 @@
+package list
+
+import (
+	"fmt"
+	"testing"
+)
+
+func assertElements[T comparable](t *testing.T, l List[T], expected []T) {
+	t.Helper()
+	s := l.ToSlice()
+	if len(s) != len(expected) || l.Size() != len(expected) {
+		t.Fatalf("length mismatch got %v (size %d) want %v", s, l.Size(), expected)
+	}
+	for i, v := range expected {
+		if s[i] != v {
+			t.Fatalf("mismatch at %d got %v want %v", i, s, expected)
+		}
+	}
+}
+
+func TestCopyIntoAllPairs(t *testing.T) {
+	data := []int{1, 2, 3, 4, 5}
+	sources := map[string]func() List[int]{
+		"array":  func() List[int] { return NewArrayListFromSlice(data) },
+		"linked": func() List[int] { return NewLinkedListFromSlice(data) },
+	}
+	destinations := map[string]func() List[int]{
+		"array":  func() List[int] { return NewArrayListFromSlice([]int{9, 9}) },
+		"linked": func() List[int] { return NewLinkedListFromSlice([]int{9, 9}) },
+	}
+	for sn, src := range sources {
+		for dn, dst := range destinations {
+			d := dst()
+			CopyInto(d, src())
+			assertElements(t, d, data)
+			d.AddLast(6)
+			if last, _ := d.GetLast(); last != 6 {
+				t.Fatalf("%s->%s: list not usable after copy", sn, dn)
+			}
+		}
+	}
+}
+
+func TestCopyIntoSelfAndEmpty(t *testing.T) {
+	al := NewArrayListFromSlice([]int{1, 2})
+	CopyInto[int](al, al)
+	assertElements[int](t, al, []int{1, 2})
+
+	ll := NewLinkedListFromSlice([]int{1, 2})
+	CopyInto[int](ll, NewArrayList[int]())
+	if !ll.IsEmpty() || ll.head != nil || ll.tail != nil {
+		t.Fatalf("copying an empty list should leave dst empty")
+	}
+}
+
+func TestCopyIntoLinkedSplicesOnce(t *testing.T) {
+	src := NewLinkedListFromSlice([]int{1, 2, 3, 4})
+	dst := NewLinkedListFromSlice([]int{9})
+	before := dst.modCount
+	CopyInto[int](dst, src)
+	assertElements[int](t, dst, []int{1, 2, 3, 4})
+	// Clear and the splice are the only modifications, however many elements are copied
+	if dst.modCount-before != 2 {
+		t.Fatalf("expected one splice after clearing got %d modifications", dst.modCount-before)
+	}
+	if dst.tail.value != 4 || dst.tail.next != nil || dst.head == src.head {
+		t.Fatalf("expected a fresh chain ending at the tail")
+	}
+}
+
+func TestListConverters(t *testing.T) {
+	al := NewArrayListFromSlice([]string{"a", "b", "c"})
+	ll := al.ToLinkedList()
+	assertElements[string](t, ll, []string{"a", "b", "c"})
+	if last, _ := ll.GetLast(); last != "c" {
+		t.Fatalf("tail not linked after conversion")
+	}
+
+	back := ll.ToArrayList()
+	assertElements[string](t, back, []string{"a", "b", "c"})
+	back.Set(0, "z")
+	if v, _ := al.Get(0); v != "a" {
+		t.Fatalf("converted list should not share storage")
+	}
+}
+
+func TestListEquals(t *testing.T) {
+	al := NewArrayListFromSlice([]int{1, 2, 3})
+	ll := NewLinkedListFromSlice([]int{1, 2, 3})
+	sub, _ := NewArrayListFromSlice([]int{0, 1, 2, 3}).SubList(1, 4)
+
+	for _, other := range []List[int]{al, ll, sub, NewArrayListFromSlice([]int{1, 2, 3})} {
+		if !al.Equals(other) || !ll.Equals(other) {
+			t.Fatalf("expected lists to equal %v", other)
+		}
+	}
+	for _, other := range []List[int]{NewArrayListFromSlice([]int{1, 2}), NewLinkedListFromSlice([]int{1, 2, 4}), nil} {
+		if al.Equals(other) || ll.Equals(other) {
+			t.Fatalf("expected lists not to equal %v", other)
+		}
+	}
+}
+
+func TestListClone(t *testing.T) {
+	al := NewArrayListWithCapacity[int](8)
+	al.AddSlice([]int{1, 2, 3})
+	al.SetFormatFunc(func(v int) string { return fmt.Sprintf("<%d>", v) })
+	alClone := al.Clone()
+	if !alClone.Equals(al) || alClone.Capacity() != 8 || alClone.String() != al.String() {
+		t.Fatalf("expected identical clone got %v", alClone)
+	}
+	alClone.Set(0, 9)
+	if v, _ := al.Get(0); v != 1 {
+		t.Fatalf("clone should not share storage")
+	}
+
+	ll := NewLinkedListFromSlice([]int{1, 2, 3})
+	llClone := ll.Clone()
+	if !llClone.Equals(ll) {
+		t.Fatalf("expected identical clone got %v", llClone)
+	}
+	llClone.AddLast(4)
+	llClone.Set(0, 9)
+	assertElements[int](t, ll, []int{1, 2, 3})
+	assertElements[int](t, llClone, []int{9, 2, 3, 4})
+}
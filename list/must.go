@@ -0,0 +1,187 @@
+package list
+
+import "github.com/profoundwu/containers/internal/utils"
+
+// The Must methods mirror the error-returning accessors for code that has already checked
+// bounds, such as tests; they panic with the accessor's error, wrapped with the method name,
+// so a recovered value still matches ErrIndexOutOfBounds or ErrEmptyList with errors.Is
+
+// MustGet returns the element at the specified index position, panicking if it is out of bounds
+func (al *ArrayList[T]) MustGet(index int) T {
+	v, err := al.Get(index)
+	return utils.Must("MustGet", v, err)
+}
+
+// MustGetFirst returns the first element of the array list, panicking if it is empty
+func (al *ArrayList[T]) MustGetFirst() T {
+	v, err := al.GetFirst()
+	return utils.Must("MustGetFirst", v, err)
+}
+
+// MustGetLast returns the last element of the array list, panicking if it is empty
+func (al *ArrayList[T]) MustGetLast() T {
+	v, err := al.GetLast()
+	return utils.Must("MustGetLast", v, err)
+}
+
+// MustRemove deletes and returns the element at the specified index position, panicking if it is out of bounds
+func (al *ArrayList[T]) MustRemove(index int) T {
+	v, err := al.Remove(index)
+	return utils.Must("MustRemove", v, err)
+}
+
+// MustRemoveFirst deletes and returns the first element of the array list, panicking if it is empty
+func (al *ArrayList[T]) MustRemoveFirst() T {
+	v, err := al.RemoveFirst()
+	return utils.Must("MustRemoveFirst", v, err)
+}
+
+// MustRemoveLast deletes and returns the last element of the array list, panicking if it is empty
+func (al *ArrayList[T]) MustRemoveLast() T {
+	v, err := al.RemoveLast()
+	return utils.Must("MustRemoveLast", v, err)
+}
+
+// MustGet returns the element at the specified index position, panicking if it is out of bounds
+func (ll *LinkedList[T]) MustGet(index int) T {
+	v, err := ll.Get(index)
+	return utils.Must("MustGet", v, err)
+}
+
+// MustGetFirst returns the first element of the linked list, panicking if it is empty
+func (ll *LinkedList[T]) MustGetFirst() T {
+	v, err := ll.GetFirst()
+	return utils.Must("MustGetFirst", v, err)
+}
+
+// MustGetLast returns the last element of the linked list, panicking if it is empty
+func (ll *LinkedList[T]) MustGetLast() T {
+	v, err := ll.GetLast()
+	return utils.Must("MustGetLast", v, err)
+}
+
+// MustRemove deletes and returns the element at the specified index position, panicking if it is out of bounds
+func (ll *LinkedList[T]) MustRemove(index int) T {
+	v, err := ll.Remove(index)
+	return utils.Must("MustRemove", v, err)
+}
+
+// MustRemoveFirst deletes and returns the first element of the linked list, panicking if it is empty
+func (ll *LinkedList[T]) MustRemoveFirst() T {
+	v, err := ll.RemoveFirst()
+	return utils.Must("MustRemoveFirst", v, err)
+}
+
+// MustRemoveLast deletes and returns the last element of the linked list, panicking if it is empty
+func (ll *LinkedList[T]) MustRemoveLast() T {
+	v, err := ll.RemoveLast()
+	return utils.Must("MustRemoveLast", v, err)
+}
+
+// MustGet returns the element at the specified index position, panicking if it is out of bounds
+func (ul *UnrolledLinkedList[T]) MustGet(index int) T {
+	v, err := ul.Get(index)
+	return utils.Must("MustGet", v, err)
+}
+
+// MustGetFirst returns the first element of the unrolled linked list, panicking if it is empty
+func (ul *UnrolledLinkedList[T]) MustGetFirst() T {
+	v, err := ul.GetFirst()
+	return utils.Must("MustGetFirst", v, err)
+}
+
+// MustGetLast returns the last element of the unrolled linked list, panicking if it is empty
+func (ul *UnrolledLinkedList[T]) MustGetLast() T {
+	v, err := ul.GetLast()
+	return utils.Must("MustGetLast", v, err)
+}
+
+// MustRemove deletes and returns the element at the specified index position, panicking if it is out of bounds
+func (ul *UnrolledLinkedList[T]) MustRemove(index int) T {
+	v, err := ul.Remove(index)
+	return utils.Must("MustRemove", v, err)
+}
+
+// MustRemoveFirst deletes and returns the first element of the unrolled linked list, panicking if it is empty
+func (ul *UnrolledLinkedList[T]) MustRemoveFirst() T {
+	v, err := ul.RemoveFirst()
+	return utils.Must("MustRemoveFirst", v, err)
+}
+
+// MustRemoveLast deletes and returns the last element of the unrolled linked list, panicking if it is empty
+func (ul *UnrolledLinkedList[T]) MustRemoveLast() T {
+	v, err := ul.RemoveLast()
+	return utils.Must("MustRemoveLast", v, err)
+}
+
+// MustGet returns the element at the specified index position, panicking if it is out of bounds
+func (l *CopyOnWriteArrayList[T]) MustGet(index int) T {
+	v, err := l.Get(index)
+	return utils.Must("MustGet", v, err)
+}
+
+// MustGetFirst returns the first element of the copy-on-write list, panicking if it is empty
+func (l *CopyOnWriteArrayList[T]) MustGetFirst() T {
+	v, err := l.GetFirst()
+	return utils.Must("MustGetFirst", v, err)
+}
+
+// MustGetLast returns the last element of the copy-on-write list, panicking if it is empty
+func (l *CopyOnWriteArrayList[T]) MustGetLast() T {
+	v, err := l.GetLast()
+	return utils.Must("MustGetLast", v, err)
+}
+
+// MustRemove deletes and returns the element at the specified index position, panicking if it is out of bounds
+func (l *CopyOnWriteArrayList[T]) MustRemove(index int) T {
+	v, err := l.Remove(index)
+	return utils.Must("MustRemove", v, err)
+}
+
+// MustRemoveFirst deletes and returns the first element of the copy-on-write list, panicking if it is empty
+func (l *CopyOnWriteArrayList[T]) MustRemoveFirst() T {
+	v, err := l.RemoveFirst()
+	return utils.Must("MustRemoveFirst", v, err)
+}
+
+// MustRemoveLast deletes and returns the last element of the copy-on-write list, panicking if it is empty
+func (l *CopyOnWriteArrayList[T]) MustRemoveLast() T {
+	v, err := l.RemoveLast()
+	return utils.Must("MustRemoveLast", v, err)
+}
+
+// MustGet returns the element at the specified index position, panicking if it is out of bounds
+func (sl *SubList[T]) MustGet(index int) T {
+	v, err := sl.Get(index)
+	return utils.Must("MustGet", v, err)
+}
+
+// MustGetFirst returns the first element of the sub list, panicking if it is empty
+func (sl *SubList[T]) MustGetFirst() T {
+	v, err := sl.GetFirst()
+	return utils.Must("MustGetFirst", v, err)
+}
+
+// MustGetLast returns the last element of the sub list, panicking if it is empty
+func (sl *SubList[T]) MustGetLast() T {
+	v, err := sl.GetLast()
+	return utils.Must("MustGetLast", v, err)
+}
+
+// MustRemove deletes and returns the element at the specified index position, panicking if it is out of bounds
+func (sl *SubList[T]) MustRemove(index int) T {
+	v, err := sl.Remove(index)
+	return utils.Must("MustRemove", v, err)
+}
+
+// MustRemoveFirst deletes and returns the first element of the sub list, panicking if it is empty
+func (sl *SubList[T]) MustRemoveFirst() T {
+	v, err := sl.RemoveFirst()
+	return utils.Must("MustRemoveFirst", v, err)
+}
+
+// MustRemoveLast deletes and returns the last element of the sub list, panicking if it is empty
+func (sl *SubList[T]) MustRemoveLast() T {
+	v, err := sl.RemoveLast()
+	return utils.Must("MustRemoveLast", v, err)
+}
@@ -0,0 +1,42 @@
+package list
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMustAccessors(t *testing.T) {
+	lists := map[string]interface {
+		List[int]
+		MustGet(index int) int
+		MustGetFirst() int
+		MustGetLast() int
+		MustRemove(index int) int
+		MustRemoveFirst() int
+		MustRemoveLast() int
+	}{
+		"ArrayList":            NewArrayListFromSlice([]int{1, 2, 3, 4}),
+		"LinkedList":           NewLinkedListFromSlice([]int{1, 2, 3, 4}),
+		"UnrolledLinkedList":   NewUnrolledLinkedListFromSlice([]int{1, 2, 3, 4}),
+		"CopyOnWriteArrayList": NewCopyOnWriteArrayListFromSlice([]int{1, 2, 3, 4}),
+	}
+	for name, l := range lists {
+		if l.MustGet(1) != 2 || l.MustGetFirst() != 1 || l.MustGetLast() != 4 {
+			t.Fatalf("%s: unexpected accessor results", name)
+		}
+		if l.MustRemove(1) != 2 || l.MustRemoveFirst() != 1 || l.MustRemoveLast() != 4 {
+			t.Fatalf("%s: unexpected removal results", name)
+		}
+		assertPanicsWith(t, ErrIndexOutOfBounds, func() { l.MustGet(5) })
+		l.MustRemoveFirst()
+		assertPanicsWith(t, ErrEmptyList, func() { l.MustGetLast() })
+		assertPanicsWith(t, ErrEmptyList, func() { l.MustRemoveFirst() })
+	}
+
+	defer func() {
+		if err, _ := recover().(error); err == nil || !strings.HasPrefix(err.Error(), "MustRemove: ") {
+			t.Fatalf("expected the panic to name the method got %v", err)
+		}
+	}()
+	NewArrayList[int]().MustRemove(0)
+}
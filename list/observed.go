@@ -0,0 +1,189 @@
+package list
+
+// Hooks are callbacks an ObservedList runs after each change to its elements
+// Nil callbacks are skipped; the callbacks must not change the list they observe
+type Hooks[T any] struct {
+	// OnAdd runs for every element that joins the list
+	OnAdd func(elem T)
+	// OnRemove runs for every element that leaves the list, except through Clear
+	OnRemove func(elem T)
+	// OnClear runs once after Clear instead of OnRemove for each element
+	OnClear func()
+}
+
+func (h Hooks[T]) added(elem T) {
+	if h.OnAdd != nil {
+		h.OnAdd(elem)
+	}
+}
+
+func (h Hooks[T]) removed(elem T) {
+	if h.OnRemove != nil {
+		h.OnRemove(elem)
+	}
+}
+
+// ObservedList wraps a list and reports every change to the hooks it was created with,
+// so derived indexes, caches and metrics stay up to date without touching each call site
+// Set counts as removing the old element and adding the new one, and Reverse is not reported
+// The backing list must only be changed through the wrapper
+type ObservedList[T any] struct {
+	inner List[T]
+	hooks Hooks[T]
+}
+
+var _ List[int] = (*ObservedList[int])(nil)
+
+// NewObservedList creates an observed list over inner
+// The elements already in inner are not reported
+func NewObservedList[T any](inner List[T], hooks Hooks[T]) *ObservedList[T] {
+	return &ObservedList[T]{inner: inner, hooks: hooks}
+}
+
+// Size returns the number of elements in the list
+func (ol *ObservedList[T]) Size() int {
+	return ol.inner.Size()
+}
+
+// IsEmpty checks if the list is empty
+func (ol *ObservedList[T]) IsEmpty() bool {
+	return ol.inner.IsEmpty()
+}
+
+// AddLast adds an element to the end of the list
+func (ol *ObservedList[T]) AddLast(elem T) {
+	ol.inner.AddLast(elem)
+	ol.hooks.added(elem)
+}
+
+// Add inserts an element at the specified index position
+// Returns error if index is out of bounds
+func (ol *ObservedList[T]) Add(index int, elem T) error {
+	if err := ol.inner.Add(index, elem); err != nil {
+		return err
+	}
+	ol.hooks.added(elem)
+	return nil
+}
+
+// Get returns the element at the specified index position
+// Returns error if index is out of bounds
+func (ol *ObservedList[T]) Get(index int) (T, error) {
+	return ol.inner.Get(index)
+}
+
+// GetFirst returns the first element of the list
+// Returns error if list is empty
+func (ol *ObservedList[T]) GetFirst() (T, error) {
+	return ol.inner.GetFirst()
+}
+
+// GetLast returns the last element of the list
+// Returns error if list is empty
+func (ol *ObservedList[T]) GetLast() (T, error) {
+	return ol.inner.GetLast()
+}
+
+// Set replaces the element at the specified index position
+// Returns error if index is out of bounds
+func (ol *ObservedList[T]) Set(index int, elem T) error {
+	old, err := ol.inner.Get(index)
+	if err != nil {
+		return err
+	}
+	if err := ol.inner.Set(index, elem); err != nil {
+		return err
+	}
+	ol.hooks.removed(old)
+	ol.hooks.added(elem)
+	return nil
+}
+
+// Remove deletes the element at the specified index position and returns its value
+// Returns error if index is out of bounds
+func (ol *ObservedList[T]) Remove(index int) (T, error) {
+	removed, err := ol.inner.Remove(index)
+	if err != nil {
+		return removed, err
+	}
+	ol.hooks.removed(removed)
+	return removed, nil
+}
+
+// RemoveFirst deletes and returns the first element of the list
+// Returns error if list is empty
+func (ol *ObservedList[T]) RemoveFirst() (T, error) {
+	removed, err := ol.inner.RemoveFirst()
+	if err != nil {
+		return removed, err
+	}
+	ol.hooks.removed(removed)
+	return removed, nil
+}
+
+// RemoveLast deletes and returns the last element of the list
+// Returns error if list is empty
+func (ol *ObservedList[T]) RemoveLast() (T, error) {
+	removed, err := ol.inner.RemoveLast()
+	if err != nil {
+		return removed, err
+	}
+	ol.hooks.removed(removed)
+	return removed, nil
+}
+
+// RemoveElement deletes the first occurrence of the specified element from the list
+// The hook receives the stored element, which may differ from elem under a custom equality
+// Returns true if element was found and removed, false otherwise
+func (ol *ObservedList[T]) RemoveElement(elem T) bool {
+	index := ol.inner.IndexOf(elem)
+	if index < 0 {
+		return false
+	}
+	_, err := ol.Remove(index)
+	return err == nil
+}
+
+// Contains checks if the list contains the specified element
+func (ol *ObservedList[T]) Contains(elem T) bool {
+	return ol.inner.Contains(elem)
+}
+
+// IndexOf returns the first index of the specified element in the list
+// Returns -1 if element is not found
+func (ol *ObservedList[T]) IndexOf(elem T) int {
+	return ol.inner.IndexOf(elem)
+}
+
+// Clear removes all elements from the list and runs OnClear
+func (ol *ObservedList[T]) Clear() {
+	ol.inner.Clear()
+	if ol.hooks.OnClear != nil {
+		ol.hooks.OnClear()
+	}
+}
+
+// ToSlice converts the list to a slice
+func (ol *ObservedList[T]) ToSlice() []T {
+	return ol.inner.ToSlice()
+}
+
+// AppendTo appends the elements of the list to dst and returns the extended slice
+func (ol *ObservedList[T]) AppendTo(dst []T) []T {
+	return ol.inner.AppendTo(dst)
+}
+
+// Reverse reverses the list without running any hook, since no element joins or leaves
+func (ol *ObservedList[T]) Reverse() {
+	ol.inner.Reverse()
+}
+
+// Join renders the elements of the list separated by sep
+func (ol *ObservedList[T]) Join(sep string) string {
+	return ol.inner.Join(sep)
+}
+
+// String returns a string representation of the list
+func (ol *ObservedList[T]) String() string {
+	return ol.inner.String()
+}
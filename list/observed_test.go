@@ -0,0 +1,49 @@
+package list
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestObservedList(t *testing.T) {
+	counts := map[string]int{}
+	clears := 0
+	ol := NewObservedList[string](NewArrayListFromSlice([]string{"seed"}), Hooks[string]{
+		OnAdd:    func(s string) { counts[s]++ },
+		OnRemove: func(s string) { counts[s]-- },
+		OnClear:  func() { clears++ },
+	})
+
+	ol.AddLast("a")
+	ol.Add(0, "b")
+	ol.AddLast("a")
+	if err := ol.Add(9, "x"); err == nil || counts["x"] != 0 {
+		t.Fatalf("expected a failed add to run no hook got %v %d", err, counts["x"])
+	}
+	ol.Set(1, "c")
+	ol.RemoveElement("a")
+	ol.RemoveLast()
+	ol.Reverse()
+	assertElements[string](t, ol, []string{"c", "b"})
+	want := map[string]int{"a": 0, "b": 1, "c": 1, "seed": -1}
+	for k, v := range want {
+		if counts[k] != v {
+			t.Fatalf("expected count %d for %s got %d", v, k, counts[k])
+		}
+	}
+
+	ol.Clear()
+	if clears != 1 || !ol.IsEmpty() {
+		t.Fatalf("expected one clear got %d", clears)
+	}
+
+	// The hook sees the stored element even when a custom equality matched it
+	var removed string
+	fold := NewObservedList[string](NewLinkedListWithEquals(strings.EqualFold), Hooks[string]{
+		OnRemove: func(s string) { removed = s },
+	})
+	fold.AddLast("Go")
+	if !fold.RemoveElement("GO") || removed != "Go" {
+		t.Fatalf("expected Go removed got %q", removed)
+	}
+}
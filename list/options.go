@@ -0,0 +1,144 @@
+package list
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/profoundwu/containers/internal/utils"
+	"github.com/profoundwu/containers/metrics"
+)
+
+var (
+	ErrOptionType = errors.New("option does not match the list element type")
+)
+
+// Option configures a list created by New or NewLinked
+// Options that only concern array storage, such as WithCapacity, are ignored by linked lists
+type Option func(o *options)
+
+type options struct {
+	capacity int
+	growth   growthPolicy
+	metrics  *metrics.Counters
+	// elements and equal hold a []T and a func(a, b T) bool, checked against T when the list is built
+	elements any
+	equal    any
+}
+
+// WithCapacity sets the initial capacity
+// Values below 1 fall back to the default capacity
+func WithCapacity(capacity int) Option {
+	return func(o *options) {
+		o.capacity = capacity
+	}
+}
+
+// WithGrowthFactor sets how much the backing array grows when it is full, such as 1.5 to trade
+// more frequent copying for less unused capacity
+// Values of factor of 1 or below, or that are not finite, fall back to the default growth factor
+func WithGrowthFactor(factor float64) Option {
+	return func(o *options) {
+		if factor > 1 && !math.IsInf(factor, 0) {
+			o.growth.factor = factor
+		} else {
+			o.growth.factor = 0
+		}
+	}
+}
+
+// WithMaxCapacity bounds the backing array to n elements
+// Adding beyond the bound fails with ErrCapacityExceeded: methods with an error result return it and
+// the others, such as AddLast and AddSlice, panic with it
+// Values of n below 1 remove the bound
+func WithMaxCapacity(n int) Option {
+	return func(o *options) {
+		o.growth.maxCapacity = max(n, 0)
+	}
+}
+
+// WithMetrics makes an array list count its adds, removals, lookups and reallocations into c
+// Bulk operations such as AddSlice only count the reallocations they cause
+// The counters may be shared by several lists; clones of the list are not counted
+func WithMetrics(c *metrics.Counters) Option {
+	return func(o *options) {
+		o.metrics = c
+	}
+}
+
+// WithElements fills the list with a copy of elems
+// The initial capacity grows to fit them
+func WithElements[T any](elems ...T) Option {
+	return func(o *options) {
+		o.elements = elems
+	}
+}
+
+// WithEquality makes the list compare elements with eq instead of ==
+// Set operations such as RemoveAll and Distinct then take quadratic time
+// A nil eq keeps ==
+func WithEquality[T any](eq func(a, b T) bool) Option {
+	return func(o *options) {
+		if eq == nil {
+			o.equal = nil
+		} else {
+			o.equal = eq
+		}
+	}
+}
+
+// New creates a new array list configured by opts
+// An initial capacity above the maximum capacity is reduced to it
+// Panics with ErrOptionType if WithElements or WithEquality was given a different element type,
+// and with ErrCapacityExceeded if the elements do not fit the maximum capacity
+func New[T comparable](opts ...Option) *ArrayList[T] {
+	o := applyOptions(opts)
+	elems, e := resolveOptions[T](o)
+	capacity := max(o.capacity, len(elems))
+	if capacity < 1 {
+		capacity = utils.DefaultCapacity
+	}
+	if o.growth.maxCapacity > 0 {
+		capacity = min(capacity, o.growth.maxCapacity)
+	}
+	al := newArrayList(capacity, e)
+	al.growth, al.metrics = o.growth, o.metrics
+	al.AddSlice(elems)
+	return al
+}
+
+// NewLinked creates a new linked list configured by opts
+// Panics with ErrOptionType if WithElements or WithEquality was given a different element type
+func NewLinked[T comparable](opts ...Option) *LinkedList[T] {
+	elems, e := resolveOptions[T](applyOptions(opts))
+	ll := &LinkedList[T]{equality: e}
+	ll.spliceSlice(elems)
+	return ll
+}
+
+func applyOptions(opts []Option) options {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// resolveOptions checks the element typed options against T
+func resolveOptions[T comparable](o options) ([]T, equality[T]) {
+	var elems []T
+	if o.elements != nil {
+		var ok bool
+		if elems, ok = o.elements.([]T); !ok {
+			panic(fmt.Errorf("%w: WithElements got %T, list holds %T", ErrOptionType, o.elements, elems))
+		}
+	}
+	if o.equal == nil {
+		return elems, comparableEquality[T]()
+	}
+	eq, ok := o.equal.(func(a, b T) bool)
+	if !ok {
+		panic(fmt.Errorf("%w: WithEquality got %T, list holds %T", ErrOptionType, o.equal, *new(T)))
+	}
+	return elems, funcEquality(eq)
+}
@@ -0,0 +1,39 @@
+package list
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewWithOptions(t *testing.T) {
+	al := New[int](WithCapacity(10), WithElements(1, 2, 3))
+	assertElements[int](t, al, []int{1, 2, 3})
+	if al.Capacity() != 10 {
+		t.Fatalf("expected capacity 10 got %d", al.Capacity())
+	}
+
+	// The elements decide the capacity when they need more room
+	al = New[int](WithCapacity(2), WithElements(1, 2, 3, 4, 5, 6))
+	if al.Size() != 6 || al.Capacity() != 6 {
+		t.Fatalf("expected size and capacity 6 got %d %d", al.Size(), al.Capacity())
+	}
+
+	fold := WithEquality(strings.EqualFold)
+	ll := NewLinked[string](WithElements("Go", "Rust"), fold, WithCapacity(100))
+	assertElements[string](t, ll, []string{"Go", "Rust"})
+	if ll.IndexOf("rust") != 1 {
+		t.Fatalf("expected case-insensitive lookup got %d", ll.IndexOf("rust"))
+	}
+	if l := New[string](fold); !l.IsEmpty() {
+		t.Fatalf("expected an empty list")
+	}
+	if New[string](WithEquality[string](nil), WithElements("a")).Contains("A") {
+		t.Fatalf("expected a nil equality to keep ==")
+	}
+}
+
+func TestNewOptionTypeMismatch(t *testing.T) {
+	assertPanicsWith(t, ErrOptionType, func() { New[int](WithElements("a")) })
+	assertPanicsWith(t, ErrOptionType, func() { NewLinked[int](WithEquality(strings.EqualFold)) })
+	assertPanicsWith(t, ErrCapacityExceeded, func() { New[int](WithMaxCapacity(2), WithElements(1, 2, 3)) })
+}
@@ -0,0 +1,95 @@
+package list
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrNegativeSize = errors.New("negative list size")
+)
+
+// Fill overwrites every element of the array list with value, keeping its size
+func (al *ArrayList[T]) Fill(value T) {
+	for i := range al.elements[:al.size] {
+		al.elements[i] = value
+	}
+}
+
+// Resize truncates or extends the array list to exactly n elements, appending copies of fill when growing
+// The capacity is kept when shrinking, so a list can be resized every cycle without reallocating
+// Returns error if n is negative or exceeds the maximum capacity
+func (al *ArrayList[T]) Resize(n int, fill T) error {
+	if n < 0 {
+		return fmt.Errorf("%w: %d", ErrNegativeSize, n)
+	}
+	if n == al.size {
+		return nil
+	}
+
+	if n < al.size {
+		// Clear references to help garbage collection
+		clear(al.elements[n:al.size])
+	} else {
+		if err := al.reserve(n); err != nil {
+			return err
+		}
+		for i := al.size; i < n; i++ {
+			al.elements[i] = fill
+		}
+	}
+	al.size = n
+	al.modCount++
+	return nil
+}
+
+// Fill overwrites every element of the linked list with value, keeping its size
+func (ll *LinkedList[T]) Fill(value T) {
+	for cur := ll.head; cur != nil; cur = cur.next {
+		cur.value = value
+	}
+}
+
+// Resize truncates or extends the linked list to exactly n elements, appending copies of fill when growing
+// Truncated nodes from an arena are not reclaimed until Clear, nor those from a shared allocator until it is reset
+// Returns error if n is negative
+func (ll *LinkedList[T]) Resize(n int, fill T) error {
+	if n < 0 {
+		return fmt.Errorf("%w: %d", ErrNegativeSize, n)
+	}
+	switch {
+	case n == ll.size:
+		return nil
+	case n == 0:
+		ll.Clear()
+		return nil
+	case n < ll.size:
+		// nodeAt leaves the cursor on the new tail, which stays valid
+		last := ll.nodeAt(n - 1)
+		for cur := last.next; cur != nil; {
+			next := cur.next
+			ll.freeNode(cur)
+			cur = next
+		}
+		last.next = nil
+		ll.tail = last
+		ll.size = n
+		ll.modCount++
+	default:
+		first := ll.newNode(fill, nil)
+		last := first
+		for i := ll.size + 1; i < n; i++ {
+			last.next = ll.newNode(fill, nil)
+			last = last.next
+		}
+		if ll.tail == nil {
+			ll.head = first
+		} else {
+			ll.tail.next = first
+		}
+		ll.tail = last
+		ll.size = n
+		ll.modCount++
+	}
+	return nil
+}
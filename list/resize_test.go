@@ -0,0 +1,57 @@
+package list
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestListFillAndResize(t *testing.T) {
+	for name, newList := range map[string]func([]int) List[int]{
+		"array":  func(s []int) List[int] { return NewArrayListFromSlice(s) },
+		"linked": func(s []int) List[int] { return NewLinkedListFromSlice(s) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			l := newList([]int{1, 2, 3, 4, 5}).(interface {
+				List[int]
+				Fill(value int)
+				Resize(n int, fill int) error
+			})
+
+			l.Fill(7)
+			assertElements(t, l, []int{7, 7, 7, 7, 7})
+
+			if err := l.Resize(2, 0); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertElements(t, l, []int{7, 7})
+			l.AddLast(8)
+			assertElements(t, l, []int{7, 7, 8})
+
+			if err := l.Resize(6, 1); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertElements(t, l, []int{7, 7, 8, 1, 1, 1})
+			if last, _ := l.GetLast(); last != 1 {
+				t.Fatalf("expected tail 1 got %d", last)
+			}
+
+			if err := l.Resize(-1, 0); !errors.Is(err, ErrNegativeSize) {
+				t.Fatalf("expected ErrNegativeSize got %v", err)
+			}
+			if err := l.Resize(0, 0); err != nil || !l.IsEmpty() {
+				t.Fatalf("expected an empty list got %v", l.ToSlice())
+			}
+			l.Resize(2, 3)
+			assertElements(t, l, []int{3, 3})
+		})
+	}
+}
+
+func TestArrayListResizeKeepsCapacity(t *testing.T) {
+	al := NewArrayListWithCapacity[int](100)
+	al.Resize(100, 1)
+	al.Resize(10, 0)
+	if al.Capacity() != 100 {
+		t.Fatalf("expected capacity 100 got %d", al.Capacity())
+	}
+}
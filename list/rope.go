@@ -0,0 +1,255 @@
+package list
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+)
+
+type ropeNode[T any] struct {
+	value    T
+	priority uint32
+	size     int
+	left     *ropeNode[T]
+	right    *ropeNode[T]
+}
+
+// Rope is a sequence stored as an implicit treap, a tree ordered by position and balanced by
+// random priorities, so editing the middle of a large sequence costs expected O(log n)
+// instead of the O(n) shifting of an ArrayList
+type Rope[T any] struct {
+	root   *ropeNode[T]
+	format FormatFunc[T]
+}
+
+// NewRope creates a new empty rope
+func NewRope[T any]() *Rope[T] {
+	return &Rope[T]{}
+}
+
+// NewRopeFromSlice creates a rope holding a copy of slice
+func NewRopeFromSlice[T any](slice []T) *Rope[T] {
+	return &Rope[T]{root: buildRope(slice)}
+}
+
+// Size returns the number of elements in the rope
+func (r *Rope[T]) Size() int {
+	return ropeSize(r.root)
+}
+
+// IsEmpty checks if the rope is empty
+func (r *Rope[T]) IsEmpty() bool {
+	return r.root == nil
+}
+
+// Get returns the element at the specified index position
+// Returns error if index is out of bounds
+func (r *Rope[T]) Get(index int) (T, error) {
+	if index < 0 || index >= r.Size() {
+		var zero T
+		return zero, fmt.Errorf("%w: %d, rope size: %d", ErrIndexOutOfBounds, index, r.Size())
+	}
+	n := r.root
+	for {
+		leftSize := ropeSize(n.left)
+		switch {
+		case index < leftSize:
+			n = n.left
+		case index > leftSize:
+			index -= leftSize + 1
+			n = n.right
+		default:
+			return n.value, nil
+		}
+	}
+}
+
+// Set replaces the element at the specified index position
+// Returns error if index is out of bounds
+func (r *Rope[T]) Set(index int, elem T) error {
+	if index < 0 || index >= r.Size() {
+		return fmt.Errorf("%w: %d, rope size: %d", ErrIndexOutOfBounds, index, r.Size())
+	}
+	n := r.root
+	for {
+		leftSize := ropeSize(n.left)
+		switch {
+		case index < leftSize:
+			n = n.left
+		case index > leftSize:
+			index -= leftSize + 1
+			n = n.right
+		default:
+			n.value = elem
+			return nil
+		}
+	}
+}
+
+// Insert inserts elems before the specified index position, index Size appends them
+// Returns error if index is out of bounds
+func (r *Rope[T]) Insert(index int, elems ...T) error {
+	if index < 0 || index > r.Size() {
+		return fmt.Errorf("%w: %d, rope size: %d", ErrIndexOutOfBounds, index, r.Size())
+	}
+	if len(elems) == 0 {
+		return nil
+	}
+	left, right := splitRope(r.root, index)
+	r.root = mergeRope(mergeRope(left, buildRope(elems)), right)
+	return nil
+}
+
+// Append adds elems to the end of the rope
+func (r *Rope[T]) Append(elems ...T) {
+	r.root = mergeRope(r.root, buildRope(elems))
+}
+
+// Delete removes count elements starting at index
+// Returns error if the range [index, index+count) is not within the rope
+func (r *Rope[T]) Delete(index, count int) error {
+	if index < 0 || count < 0 || index+count > r.Size() {
+		return fmt.Errorf("%w: [%d, %d), rope size: %d", ErrIndexOutOfBounds, index, index+count, r.Size())
+	}
+	left, rest := splitRope(r.root, index)
+	_, right := splitRope(rest, count)
+	r.root = mergeRope(left, right)
+	return nil
+}
+
+// Concat moves every element of other to the end of the rope in O(log n), leaving other empty
+func (r *Rope[T]) Concat(other *Rope[T]) {
+	if r == other {
+		return
+	}
+	r.root = mergeRope(r.root, other.root)
+	other.root = nil
+}
+
+// Split keeps the elements before index in the rope and returns the rest as a new rope
+// Returns error if index is out of bounds
+func (r *Rope[T]) Split(index int) (*Rope[T], error) {
+	if index < 0 || index > r.Size() {
+		return nil, fmt.Errorf("%w: %d, rope size: %d", ErrIndexOutOfBounds, index, r.Size())
+	}
+	left, right := splitRope(r.root, index)
+	r.root = left
+	return &Rope[T]{root: right, format: r.format}, nil
+}
+
+// Clear removes all elements from the rope
+func (r *Rope[T]) Clear() {
+	r.root = nil
+}
+
+// ToSlice converts the rope to a slice
+func (r *Rope[T]) ToSlice() []T {
+	return r.AppendTo(make([]T, 0, r.Size()))
+}
+
+// AppendTo appends the elements of the rope to dst and returns the extended slice
+func (r *Rope[T]) AppendTo(dst []T) []T {
+	var walk func(n *ropeNode[T])
+	walk = func(n *ropeNode[T]) {
+		if n == nil {
+			return
+		}
+		walk(n.left)
+		dst = append(dst, n.value)
+		walk(n.right)
+	}
+	walk(r.root)
+	return dst
+}
+
+// SetFormatFunc sets how elements render in String and Join
+// A nil format restores the default %v rendering
+func (r *Rope[T]) SetFormatFunc(format FormatFunc[T]) {
+	r.format = format
+}
+
+// Join renders the elements of the rope separated by sep
+func (r *Rope[T]) Join(sep string) string {
+	var sb strings.Builder
+	joinElements(&sb, r.format, r.ToSlice(), sep)
+	return sb.String()
+}
+
+// String returns a string representation of the rope
+func (r *Rope[T]) String() string {
+	return "[" + r.Join(", ") + "]"
+}
+
+// buildRope builds a treap over slice in O(n) by keeping the right spine on a stack
+func buildRope[T any](slice []T) *ropeNode[T] {
+	var spine []*ropeNode[T]
+	for _, v := range slice {
+		n := &ropeNode[T]{value: v, priority: rand.Uint32(), size: 1}
+		var last *ropeNode[T]
+		for len(spine) > 0 && spine[len(spine)-1].priority < n.priority {
+			last = spine[len(spine)-1]
+			spine = spine[:len(spine)-1]
+			last.update()
+		}
+		n.left = last
+		if len(spine) > 0 {
+			spine[len(spine)-1].right = n
+		}
+		spine = append(spine, n)
+	}
+	if len(spine) == 0 {
+		return nil
+	}
+	for i := len(spine) - 1; i >= 0; i-- {
+		spine[i].update()
+	}
+	return spine[0]
+}
+
+// splitRope divides the subtree at n into its first index elements and the rest
+func splitRope[T any](n *ropeNode[T], index int) (*ropeNode[T], *ropeNode[T]) {
+	if n == nil {
+		return nil, nil
+	}
+	leftSize := ropeSize(n.left)
+	if index <= leftSize {
+		left, rest := splitRope(n.left, index)
+		n.left = rest
+		n.update()
+		return left, n
+	}
+	rest, right := splitRope(n.right, index-leftSize-1)
+	n.right = rest
+	n.update()
+	return n, right
+}
+
+// mergeRope joins two subtrees with every element of left placed before every element of right
+func mergeRope[T any](left, right *ropeNode[T]) *ropeNode[T] {
+	if left == nil {
+		return right
+	}
+	if right == nil {
+		return left
+	}
+	if left.priority > right.priority {
+		left.right = mergeRope(left.right, right)
+		left.update()
+		return left
+	}
+	right.left = mergeRope(left, right.left)
+	right.update()
+	return right
+}
+
+func ropeSize[T any](n *ropeNode[T]) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// update recomputes the subtree size of n from its children
+func (n *ropeNode[T]) update() {
+	n.size = 1 + ropeSize(n.left) + ropeSize(n.right)
+}
@@ -0,0 +1,107 @@
+package list
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestRopeInsertDelete(t *testing.T) {
+	r := NewRopeFromSlice([]rune("hello world"))
+	if r.Size() != 11 {
+		t.Fatalf("expected size 11 got %d", r.Size())
+	}
+	if err := r.Insert(5, []rune(",")...); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := r.Delete(7, 5); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	r.Append([]rune("gophers")...)
+	if got := string(r.ToSlice()); got != "hello, gophers" {
+		t.Fatalf("unexpected content %q", got)
+	}
+	if v, _ := r.Get(7); v != 'g' {
+		t.Fatalf("expected g got %c", v)
+	}
+	r.Set(0, 'H')
+	if v, _ := r.Get(0); v != 'H' {
+		t.Fatalf("expected H got %c", v)
+	}
+
+	if err := r.Insert(20, 'x'); !errors.Is(err, ErrIndexOutOfBounds) {
+		t.Fatalf("expected ErrIndexOutOfBounds got %v", err)
+	}
+	if err := r.Delete(10, 5); !errors.Is(err, ErrIndexOutOfBounds) {
+		t.Fatalf("expected ErrIndexOutOfBounds got %v", err)
+	}
+	if _, err := r.Get(-1); !errors.Is(err, ErrIndexOutOfBounds) {
+		t.Fatalf("expected ErrIndexOutOfBounds got %v", err)
+	}
+}
+
+func TestRopeSplitConcat(t *testing.T) {
+	r := NewRopeFromSlice([]int{1, 2, 3, 4, 5})
+	tail, err := r.Split(2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if r.String() != "[1, 2]" || tail.String() != "[3, 4, 5]" {
+		t.Fatalf("unexpected split %s %s", r, tail)
+	}
+	tail.Concat(r)
+	if tail.String() != "[3, 4, 5, 1, 2]" || !r.IsEmpty() {
+		t.Fatalf("unexpected concat %s, source left with %s", tail, r)
+	}
+	if _, err := tail.Split(6); !errors.Is(err, ErrIndexOutOfBounds) {
+		t.Fatalf("expected ErrIndexOutOfBounds got %v", err)
+	}
+	tail.Clear()
+	if tail.Size() != 0 || tail.String() != "[]" {
+		t.Fatalf("expected empty rope after clear")
+	}
+}
+
+func TestRopeMatchesSlice(t *testing.T) {
+	rnd := rand.New(rand.NewSource(13))
+	r := NewRope[int]()
+	var model []int
+	for i := 0; i < 2000; i++ {
+		switch rnd.Intn(4) {
+		case 0, 1:
+			at := rnd.Intn(len(model) + 1)
+			elems := []int{i, i + 1, i + 2}[:rnd.Intn(3)+1]
+			r.Insert(at, elems...)
+			model = slices.Insert(model, at, elems...)
+		case 2:
+			if len(model) == 0 {
+				continue
+			}
+			at := rnd.Intn(len(model))
+			n := rnd.Intn(min(4, len(model)-at) + 1)
+			r.Delete(at, n)
+			model = slices.Delete(model, at, at+n)
+		default:
+			at := rnd.Intn(len(model) + 1)
+			tail, _ := r.Split(at)
+			r.Concat(tail)
+		}
+	}
+	if !slices.Equal(r.ToSlice(), model) {
+		t.Fatalf("rope diverged from model")
+	}
+	for i := range model {
+		if v, _ := r.Get(i); v != model[i] {
+			t.Fatalf("element %d got %d want %d", i, v, model[i])
+		}
+	}
+}
+
+func BenchmarkRopeInsertMiddle(b *testing.B) {
+	r := NewRopeFromSlice(make([]int, 1<<16))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		r.Insert(r.Size()/2, i)
+	}
+}
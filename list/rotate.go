@@ -0,0 +1,87 @@
+package list
+
+import "fmt"
+
+// Swap exchanges the elements at positions i and j
+// Returns error if either index is out of bounds
+func (al *ArrayList[T]) Swap(i, j int) error {
+	if i < 0 || i >= al.size || j < 0 || j >= al.size {
+		return fmt.Errorf("%w: %d and %d, list size: %d", ErrIndexOutOfBounds, i, j, al.size)
+	}
+	al.elements[i], al.elements[j] = al.elements[j], al.elements[i]
+	return nil
+}
+
+// RotateLeft moves every element n positions towards the front, wrapping the first n to the back
+// A negative n rotates right; the rotation is done in place by reversing three ranges
+func (al *ArrayList[T]) RotateLeft(n int) {
+	k := rotation(n, al.size)
+	if k == 0 {
+		return
+	}
+	reverseRange(al.elements[:k])
+	reverseRange(al.elements[k:al.size])
+	reverseRange(al.elements[:al.size])
+	al.modCount++
+}
+
+// RotateRight moves every element n positions towards the back, wrapping the last n to the front
+// A negative n rotates left
+func (al *ArrayList[T]) RotateRight(n int) {
+	al.RotateLeft(-rotation(n, al.size))
+}
+
+// Swap exchanges the elements at positions i and j
+// Returns error if either index is out of bounds
+func (ll *LinkedList[T]) Swap(i, j int) error {
+	if i < 0 || i >= ll.size || j < 0 || j >= ll.size {
+		return fmt.Errorf("%w: %d and %d, list size: %d", ErrIndexOutOfBounds, i, j, ll.size)
+	}
+	if i > j {
+		i, j = j, i
+	}
+	a := ll.nodeAt(i)
+	b := ll.nodeAt(j)
+	a.value, b.value = b.value, a.value
+	return nil
+}
+
+// RotateLeft moves every element n positions towards the front, wrapping the first n to the back
+// A negative n rotates right; the nodes are relinked without copying values
+func (ll *LinkedList[T]) RotateLeft(n int) {
+	k := rotation(n, ll.size)
+	if k == 0 {
+		return
+	}
+	newTail := ll.nodeAt(k - 1)
+	ll.tail.next = ll.head
+	ll.head = newTail.next
+	newTail.next = nil
+	ll.tail = newTail
+	ll.modCount++
+	ll.cursor = nil
+}
+
+// RotateRight moves every element n positions towards the back, wrapping the last n to the front
+// A negative n rotates left
+func (ll *LinkedList[T]) RotateRight(n int) {
+	ll.RotateLeft(-rotation(n, ll.size))
+}
+
+// rotation normalizes a left rotation by n to the range [0, size)
+func rotation(n, size int) int {
+	if size == 0 {
+		return 0
+	}
+	k := n % size
+	if k < 0 {
+		k += size
+	}
+	return k
+}
+
+func reverseRange[T any](s []T) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
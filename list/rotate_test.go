@@ -0,0 +1,58 @@
+package list
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestListSwapAndRotate(t *testing.T) {
+	for name, newList := range map[string]func([]int) List[int]{
+		"array":  func(s []int) List[int] { return NewArrayListFromSlice(s) },
+		"linked": func(s []int) List[int] { return NewLinkedListFromSlice(s) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			type rotator interface {
+				List[int]
+				Swap(i, j int) error
+				RotateLeft(n int)
+				RotateRight(n int)
+			}
+			l := newList([]int{1, 2, 3, 4, 5}).(rotator)
+
+			if err := l.Swap(0, 4); err != nil {
+				t.Fatalf("expected no error got %v", err)
+			}
+			assertElements(t, l, []int{5, 2, 3, 4, 1})
+			if err := l.Swap(3, 1); err != nil {
+				t.Fatalf("expected no error got %v", err)
+			}
+			assertElements(t, l, []int{5, 4, 3, 2, 1})
+			if err := l.Swap(0, 5); !errors.Is(err, ErrIndexOutOfBounds) {
+				t.Fatalf("expected ErrIndexOutOfBounds got %v", err)
+			}
+
+			l.RotateLeft(2)
+			assertElements(t, l, []int{3, 2, 1, 5, 4})
+			l.RotateRight(7)
+			assertElements(t, l, []int{5, 4, 3, 2, 1})
+			l.RotateLeft(-1)
+			assertElements(t, l, []int{1, 5, 4, 3, 2})
+			l.RotateRight(5)
+			assertElements(t, l, []int{1, 5, 4, 3, 2})
+
+			// The tail must follow the rotation
+			l.AddLast(9)
+			if last, _ := l.GetLast(); last != 9 {
+				t.Fatalf("expected tail 9 got %d", last)
+			}
+			assertElements(t, l, []int{1, 5, 4, 3, 2, 9})
+		})
+	}
+
+	empty := NewLinkedList[int]()
+	empty.RotateLeft(3)
+	NewArrayList[int]().RotateRight(-2)
+	if !empty.IsEmpty() {
+		t.Fatalf("rotating an empty list should be a no-op")
+	}
+}
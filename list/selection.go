@@ -0,0 +1,123 @@
+package list
+
+import (
+	"errors"
+	"slices"
+)
+
+var (
+	ErrStaleSelection = errors.New("selection does not belong to the list in its current state")
+)
+
+// Selection is a set of positions picked out of one list by Select
+// It stays valid until the list is structurally changed, so the positions can be acted on after
+// the search without the shifting indexes that make removal inside a loop error-prone
+type Selection struct {
+	owner    any
+	modCount int
+	indices  []int
+}
+
+// Size returns the number of selected elements
+func (s Selection) Size() int {
+	return len(s.indices)
+}
+
+// IsEmpty checks if no element was selected
+func (s Selection) IsEmpty() bool {
+	return len(s.indices) == 0
+}
+
+// Indices returns the selected positions in ascending order
+func (s Selection) Indices() []int {
+	return slices.Clone(s.indices)
+}
+
+// Select returns the positions of every element matching pred
+func (al *ArrayList[T]) Select(pred func(elem T) bool) Selection {
+	sel := Selection{owner: al, modCount: al.modCount}
+	for i, v := range al.elements[:al.size] {
+		if pred(v) {
+			sel.indices = append(sel.indices, i)
+		}
+	}
+	return sel
+}
+
+// ApplySelected replaces every selected element with op applied to it
+// Returns error if sel was taken from another list or before a structural change
+func (al *ArrayList[T]) ApplySelected(sel Selection, op func(elem T) T) error {
+	if sel.owner != al || sel.modCount != al.modCount {
+		return ErrStaleSelection
+	}
+	for _, i := range sel.indices {
+		al.elements[i] = op(al.elements[i])
+	}
+	return nil
+}
+
+// RemoveSelected removes every selected element, moving the survivors down in a single pass
+// The selection is stale afterwards
+// Returns the number of elements removed, or error if sel was taken from another list or before a structural change
+func (al *ArrayList[T]) RemoveSelected(sel Selection) (int, error) {
+	if sel.owner != al || sel.modCount != al.modCount {
+		return 0, ErrStaleSelection
+	}
+	i, next := 0, 0
+	return al.RemoveIf(func(T) bool {
+		selected := next < len(sel.indices) && sel.indices[next] == i
+		if selected {
+			next++
+		}
+		i++
+		return selected
+	}), nil
+}
+
+// Select returns the positions of every element matching pred
+func (ll *LinkedList[T]) Select(pred func(elem T) bool) Selection {
+	sel := Selection{owner: ll, modCount: ll.modCount}
+	i := 0
+	for cur := ll.head; cur != nil; cur = cur.next {
+		if pred(cur.value) {
+			sel.indices = append(sel.indices, i)
+		}
+		i++
+	}
+	return sel
+}
+
+// ApplySelected replaces every selected element with op applied to it in a single traversal
+// Returns error if sel was taken from another list or before a structural change
+func (ll *LinkedList[T]) ApplySelected(sel Selection, op func(elem T) T) error {
+	if sel.owner != ll || sel.modCount != ll.modCount {
+		return ErrStaleSelection
+	}
+	i, next := 0, 0
+	for cur := ll.head; cur != nil && next < len(sel.indices); cur = cur.next {
+		if sel.indices[next] == i {
+			cur.value = op(cur.value)
+			next++
+		}
+		i++
+	}
+	return nil
+}
+
+// RemoveSelected unlinks every selected element in a single traversal
+// The selection is stale afterwards
+// Returns the number of elements removed, or error if sel was taken from another list or before a structural change
+func (ll *LinkedList[T]) RemoveSelected(sel Selection) (int, error) {
+	if sel.owner != ll || sel.modCount != ll.modCount {
+		return 0, ErrStaleSelection
+	}
+	i, next := 0, 0
+	return ll.RemoveIf(func(T) bool {
+		selected := next < len(sel.indices) && sel.indices[next] == i
+		if selected {
+			next++
+		}
+		i++
+		return selected
+	}), nil
+}
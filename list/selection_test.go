@@ -0,0 +1,57 @@
+package list
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestListSelection(t *testing.T) {
+	for name, newList := range map[string]func([]int) List[int]{
+		"array":  func(s []int) List[int] { return NewArrayListFromSlice(s) },
+		"linked": func(s []int) List[int] { return NewLinkedListFromSlice(s) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			type selector interface {
+				List[int]
+				Select(pred func(int) bool) Selection
+				ApplySelected(sel Selection, op func(int) int) error
+				RemoveSelected(sel Selection) (int, error)
+			}
+			l := newList([]int{5, 12, 7, 20, 3, 15}).(selector)
+			big := l.Select(func(v int) bool { return v >= 10 })
+			if !slices.Equal(big.Indices(), []int{1, 3, 5}) || big.Size() != 3 {
+				t.Fatalf("expected [1 3 5] got %v", big.Indices())
+			}
+
+			if err := l.ApplySelected(big, func(v int) int { return v * 10 }); err != nil {
+				t.Fatalf("expected no error got %v", err)
+			}
+			assertElements(t, l, []int{5, 120, 7, 200, 3, 150})
+
+			// Applying keeps the selection valid, removing uses it up
+			n, err := l.RemoveSelected(big)
+			if err != nil || n != 3 {
+				t.Fatalf("expected 3 removed got %d %v", n, err)
+			}
+			assertElements(t, l, []int{5, 7, 3})
+			if _, err := l.RemoveSelected(big); !errors.Is(err, ErrStaleSelection) {
+				t.Fatalf("expected ErrStaleSelection got %v", err)
+			}
+
+			none := l.Select(func(v int) bool { return v > 100 })
+			if !none.IsEmpty() {
+				t.Fatalf("expected empty selection")
+			}
+			l.AddLast(1)
+			if err := l.ApplySelected(none, func(v int) int { return v }); !errors.Is(err, ErrStaleSelection) {
+				t.Fatalf("expected ErrStaleSelection after AddLast got %v", err)
+			}
+		})
+	}
+
+	a, b := NewArrayListFromSlice([]int{1}), NewArrayListFromSlice([]int{1})
+	if _, err := b.RemoveSelected(a.Select(func(int) bool { return true })); !errors.Is(err, ErrStaleSelection) {
+		t.Fatalf("expected ErrStaleSelection for a foreign selection got %v", err)
+	}
+}
@@ -0,0 +1,56 @@
+package list
+
+import "math/rand"
+
+// Shuffle randomly permutes the elements using the global random source
+func (al *ArrayList[T]) Shuffle() {
+	al.shuffle(rand.Intn)
+}
+
+// ShuffleWithRand randomly permutes the elements drawing from r, so a seeded source gives a repeatable order
+func (al *ArrayList[T]) ShuffleWithRand(r *rand.Rand) {
+	al.shuffle(r.Intn)
+}
+
+// shuffle runs Fisher–Yates with intn picking a uniform index in [0, n)
+func (al *ArrayList[T]) shuffle(intn func(n int) int) {
+	for i := al.size - 1; i > 0; i-- {
+		j := intn(i + 1)
+		al.elements[i], al.elements[j] = al.elements[j], al.elements[i]
+	}
+	al.modCount++
+}
+
+// Shuffle randomly permutes the elements using the global random source
+func (ll *LinkedList[T]) Shuffle() {
+	ll.shuffle(rand.Intn)
+}
+
+// ShuffleWithRand randomly permutes the elements drawing from r, so a seeded source gives a repeatable order
+func (ll *LinkedList[T]) ShuffleWithRand(r *rand.Rand) {
+	ll.shuffle(r.Intn)
+}
+
+// shuffle permutes the nodes with Fisher–Yates and relinks them without copying values
+func (ll *LinkedList[T]) shuffle(intn func(n int) int) {
+	if ll.size < 2 {
+		return
+	}
+	nodes := make([]*node[T], 0, ll.size)
+	for cur := ll.head; cur != nil; cur = cur.next {
+		nodes = append(nodes, cur)
+	}
+	for i := len(nodes) - 1; i > 0; i-- {
+		j := intn(i + 1)
+		nodes[i], nodes[j] = nodes[j], nodes[i]
+	}
+
+	for i := 0; i < len(nodes)-1; i++ {
+		nodes[i].next = nodes[i+1]
+	}
+	ll.head = nodes[0]
+	ll.tail = nodes[len(nodes)-1]
+	ll.tail.next = nil
+	ll.modCount++
+	ll.cursor = nil
+}
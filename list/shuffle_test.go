@@ -0,0 +1,63 @@
+package list
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestListShuffle(t *testing.T) {
+	for name, newList := range map[string]func([]int) List[int]{
+		"array":  func(s []int) List[int] { return NewArrayListFromSlice(s) },
+		"linked": func(s []int) List[int] { return NewLinkedListFromSlice(s) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			type shuffler interface {
+				List[int]
+				Shuffle()
+				ShuffleWithRand(r *rand.Rand)
+			}
+			original := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+			a := newList(original).(shuffler)
+			b := newList(original).(shuffler)
+			a.ShuffleWithRand(rand.New(rand.NewSource(42)))
+			b.ShuffleWithRand(rand.New(rand.NewSource(42)))
+
+			if !slices.Equal(a.ToSlice(), b.ToSlice()) {
+				t.Fatalf("expected equal seeds to give equal orders got %v and %v", a, b)
+			}
+			if slices.Equal(a.ToSlice(), original) {
+				t.Fatalf("expected a different order got %v", a)
+			}
+			sorted := a.ToSlice()
+			slices.Sort(sorted)
+			if !slices.Equal(sorted, original) {
+				t.Fatalf("expected a permutation got %v", a)
+			}
+
+			a.Shuffle()
+			a.AddLast(11)
+			if last, _ := a.GetLast(); last != 11 || a.Size() != 11 {
+				t.Fatalf("expected tail 11 after shuffle got %d", last)
+			}
+		})
+	}
+}
+
+func TestShuffleUniform(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	counts := make(map[[3]int]int)
+	for i := 0; i < 6000; i++ {
+		ll := NewLinkedListFromSlice([]int{1, 2, 3})
+		ll.ShuffleWithRand(r)
+		counts[[3]int(ll.ToSlice())]++
+	}
+	if len(counts) != 6 {
+		t.Fatalf("expected all 6 permutations got %d", len(counts))
+	}
+	for perm, n := range counts {
+		if n < 850 || n > 1150 {
+			t.Fatalf("permutation %v drawn %d times out of 6000", perm, n)
+		}
+	}
+}
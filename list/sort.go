@@ -0,0 +1,61 @@
+package list
+
+import (
+	"slices"
+	"sort"
+)
+
+// sortAdapter exposes an array list to the sort package without copying its elements
+type sortAdapter[T any] struct {
+	al   *ArrayList[T]
+	less func(a, b T) bool
+}
+
+// AsSortInterface returns a sort.Interface that orders the array list in place by less,
+// for code written against the sort package
+func AsSortInterface[T any](al *ArrayList[T], less func(a, b T) bool) sort.Interface {
+	return sortAdapter[T]{al: al, less: less}
+}
+
+func (s sortAdapter[T]) Len() int {
+	return s.al.size
+}
+
+func (s sortAdapter[T]) Less(i, j int) bool {
+	return s.less(s.al.elements[i], s.al.elements[j])
+}
+
+func (s sortAdapter[T]) Swap(i, j int) {
+	s.al.elements[i], s.al.elements[j] = s.al.elements[j], s.al.elements[i]
+}
+
+// SortFunc sorts the array list in place by cmp
+func (al *ArrayList[T]) SortFunc(cmp func(a, b T) int) {
+	slices.SortFunc(al.elements[:al.size], cmp)
+	al.modCount++
+}
+
+// SortStableFunc sorts the array list in place by cmp, keeping equal elements in their original order
+func (al *ArrayList[T]) SortStableFunc(cmp func(a, b T) int) {
+	slices.SortStableFunc(al.elements[:al.size], cmp)
+	al.modCount++
+}
+
+// IsSortedFunc checks if the array list is sorted by cmp
+func (al *ArrayList[T]) IsSortedFunc(cmp func(a, b T) int) bool {
+	return slices.IsSortedFunc(al.elements[:al.size], cmp)
+}
+
+// BinarySearchFunc searches an array list sorted by cmp for target
+// Returns the position where target is or would be inserted, and whether it was found
+func (al *ArrayList[T]) BinarySearchFunc(target T, cmp func(elem, target T) int) (int, bool) {
+	return slices.BinarySearchFunc(al.elements[:al.size], target, cmp)
+}
+
+// WithSlice calls fn with the elements of the array list as a slice backed by the list itself,
+// so functions from the slices package can work on it without copying
+// fn may modify and reorder the elements but must not retain the slice or append to it
+func (al *ArrayList[T]) WithSlice(fn func(elements []T)) {
+	fn(al.elements[:al.size:al.size])
+	al.modCount++
+}
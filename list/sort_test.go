@@ -0,0 +1,39 @@
+package list
+
+import (
+	"cmp"
+	"slices"
+	"sort"
+	"testing"
+)
+
+func TestArrayListSortAdapters(t *testing.T) {
+	al := NewArrayListFromSlice([]int{5, 2, 8, 1})
+	sort.Sort(AsSortInterface(al, func(a, b int) bool { return a < b }))
+	assertElements[int](t, al, []int{1, 2, 5, 8})
+	if !sort.IsSorted(AsSortInterface(al, func(a, b int) bool { return a < b })) {
+		t.Fatalf("expected sort.IsSorted to agree")
+	}
+
+	al.SortFunc(func(a, b int) int { return cmp.Compare(b, a) })
+	assertElements[int](t, al, []int{8, 5, 2, 1})
+	if al.IsSortedFunc(cmp.Compare[int]) {
+		t.Fatalf("expected a descending list not to be sorted ascending")
+	}
+
+	type item struct {
+		key int
+		tag string
+	}
+	items := NewArrayListFromSlice([]item{{2, "a"}, {1, "b"}, {2, "c"}, {1, "d"}})
+	items.SortStableFunc(func(x, y item) int { return cmp.Compare(x.key, y.key) })
+	assertElements[item](t, items, []item{{1, "b"}, {1, "d"}, {2, "a"}, {2, "c"}})
+
+	al.WithSlice(slices.Sort[[]int])
+	if i, found := al.BinarySearchFunc(5, cmp.Compare[int]); !found || i != 2 {
+		t.Fatalf("expected 5 at 2 got %d %v", i, found)
+	}
+	if i, found := al.BinarySearchFunc(3, cmp.Compare[int]); found || i != 2 {
+		t.Fatalf("expected insertion point 2 got %d %v", i, found)
+	}
+}
@@ -0,0 +1,232 @@
+package list
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/profoundwu/containers/compare"
+)
+
+// SortedList keeps its elements ordered by a comparator at all times
+// Lookups and rank queries are O(log n) binary searches, insertion and removal shift elements like an ArrayList
+// Elements that compare equal keep their insertion order
+type SortedList[T any] struct {
+	elements []T
+	cmp      compare.Comparator[T]
+	format   FormatFunc[T]
+}
+
+// NewSortedList creates a new empty sorted list ordered by the natural order of T
+func NewSortedList[T cmp.Ordered]() *SortedList[T] {
+	return NewSortedListWithComparator(compare.Natural[T]())
+}
+
+// NewSortedListWithComparator creates a new empty sorted list ordered by comparator
+func NewSortedListWithComparator[T any](comparator compare.Comparator[T]) *SortedList[T] {
+	return &SortedList[T]{cmp: comparator}
+}
+
+// NewSortedListFromSlice creates a sorted list holding a sorted copy of slice
+func NewSortedListFromSlice[T any](slice []T, comparator compare.Comparator[T]) *SortedList[T] {
+	sl := &SortedList[T]{elements: slices.Clone(slice), cmp: comparator}
+	slices.SortStableFunc(sl.elements, comparator)
+	return sl
+}
+
+// Size returns the number of elements in the sorted list
+func (sl *SortedList[T]) Size() int {
+	return len(sl.elements)
+}
+
+// IsEmpty checks if the sorted list is empty
+func (sl *SortedList[T]) IsEmpty() bool {
+	return len(sl.elements) == 0
+}
+
+// Add inserts elem after any elements that compare equal to it
+// Returns the index elem was inserted at
+func (sl *SortedList[T]) Add(elem T) int {
+	index := sl.upperBound(elem)
+	sl.elements = slices.Insert(sl.elements, index, elem)
+	return index
+}
+
+// Get returns the element of rank index, the index-th smallest element
+// Returns error if index is out of bounds
+func (sl *SortedList[T]) Get(index int) (T, error) {
+	if index < 0 || index >= len(sl.elements) {
+		var zero T
+		return zero, fmt.Errorf("%w: %d, list size: %d", ErrIndexOutOfBounds, index, len(sl.elements))
+	}
+	return sl.elements[index], nil
+}
+
+// GetFirst returns the smallest element
+// Returns error if the list is empty
+func (sl *SortedList[T]) GetFirst() (T, error) {
+	if len(sl.elements) == 0 {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	return sl.elements[0], nil
+}
+
+// GetLast returns the largest element
+// Returns error if the list is empty
+func (sl *SortedList[T]) GetLast() (T, error) {
+	if len(sl.elements) == 0 {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	return sl.elements[len(sl.elements)-1], nil
+}
+
+// First returns the smallest element
+// Returns false if the list is empty
+func (sl *SortedList[T]) First() (T, bool) {
+	elem, err := sl.GetFirst()
+	return elem, err == nil
+}
+
+// Last returns the largest element
+// Returns false if the list is empty
+func (sl *SortedList[T]) Last() (T, bool) {
+	elem, err := sl.GetLast()
+	return elem, err == nil
+}
+
+// PopFirst removes and returns the smallest element in O(1), so the list can serve as a priority queue
+// The space it held is reclaimed the next time the list grows
+// Returns false if the list is empty
+func (sl *SortedList[T]) PopFirst() (T, bool) {
+	var zero T
+	if len(sl.elements) == 0 {
+		return zero, false
+	}
+	elem := sl.elements[0]
+	// Clear the reference to help garbage collection
+	sl.elements[0] = zero
+	sl.elements = sl.elements[1:]
+	return elem, true
+}
+
+// PopLast removes and returns the largest element in O(1)
+// Returns false if the list is empty
+func (sl *SortedList[T]) PopLast() (T, bool) {
+	var zero T
+	if len(sl.elements) == 0 {
+		return zero, false
+	}
+	elem := sl.elements[len(sl.elements)-1]
+	sl.removeAt(len(sl.elements) - 1)
+	return elem, true
+}
+
+// Remove removes and returns the element at the specified index position
+// Returns error if index is out of bounds
+func (sl *SortedList[T]) Remove(index int) (T, error) {
+	elem, err := sl.Get(index)
+	if err != nil {
+		return elem, err
+	}
+	sl.removeAt(index)
+	return elem, nil
+}
+
+// RemoveElement removes the first element comparing equal to elem
+// Returns true if an element was removed, false otherwise
+func (sl *SortedList[T]) RemoveElement(elem T) bool {
+	index := sl.IndexOf(elem)
+	if index < 0 {
+		return false
+	}
+	sl.removeAt(index)
+	return true
+}
+
+// Contains checks if the sorted list holds an element comparing equal to elem
+func (sl *SortedList[T]) Contains(elem T) bool {
+	return sl.IndexOf(elem) >= 0
+}
+
+// IndexOf returns the index of the first element comparing equal to elem
+// Returns -1 if no such element exists
+func (sl *SortedList[T]) IndexOf(elem T) int {
+	index := sl.lowerBound(elem)
+	if index < len(sl.elements) && sl.cmp(sl.elements[index], elem) == 0 {
+		return index
+	}
+	return -1
+}
+
+// Range returns the elements in the inclusive range [from, to] in sorted order
+// The returned slice is a copy and may be modified freely
+func (sl *SortedList[T]) Range(from, to T) []T {
+	if sl.cmp(from, to) > 0 {
+		return []T{}
+	}
+	return slices.Clone(sl.elements[sl.lowerBound(from):sl.upperBound(to)])
+}
+
+// Clear removes all elements from the sorted list
+func (sl *SortedList[T]) Clear() {
+	clear(sl.elements)
+	sl.elements = sl.elements[:0]
+}
+
+// ToSlice converts the sorted list to a slice
+func (sl *SortedList[T]) ToSlice() []T {
+	return sl.AppendTo(make([]T, 0, len(sl.elements)))
+}
+
+// AppendTo appends the elements of the sorted list to dst and returns the extended slice
+func (sl *SortedList[T]) AppendTo(dst []T) []T {
+	return append(dst, sl.elements...)
+}
+
+// SetFormatFunc sets how elements render in String and Join
+// A nil format restores the default %v rendering
+func (sl *SortedList[T]) SetFormatFunc(format FormatFunc[T]) {
+	sl.format = format
+}
+
+// Join renders the elements of the sorted list separated by sep
+func (sl *SortedList[T]) Join(sep string) string {
+	var sb strings.Builder
+	joinElements(&sb, sl.format, sl.elements, sep)
+	return sb.String()
+}
+
+// String returns a string representation of the sorted list
+func (sl *SortedList[T]) String() string {
+	var sb strings.Builder
+	sb.WriteString("[")
+	joinElements(&sb, sl.format, sl.elements, ", ")
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// lowerBound returns the index of the first element not less than elem
+func (sl *SortedList[T]) lowerBound(elem T) int {
+	return sort.Search(len(sl.elements), func(i int) bool {
+		return sl.cmp(sl.elements[i], elem) >= 0
+	})
+}
+
+// upperBound returns the index of the first element greater than elem
+func (sl *SortedList[T]) upperBound(elem T) int {
+	return sort.Search(len(sl.elements), func(i int) bool {
+		return sl.cmp(sl.elements[i], elem) > 0
+	})
+}
+
+func (sl *SortedList[T]) removeAt(index int) {
+	var zero T
+	copy(sl.elements[index:], sl.elements[index+1:])
+	// Clear the reference to help garbage collection
+	sl.elements[len(sl.elements)-1] = zero
+	sl.elements = sl.elements[:len(sl.elements)-1]
+}
@@ -0,0 +1,160 @@
+package list
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/profoundwu/containers/compare"
+)
+
+func TestSortedListAdd(t *testing.T) {
+	sl := NewSortedList[int]()
+	for _, v := range []int{5, 1, 4, 1, 3} {
+		sl.Add(v)
+	}
+	if sl.String() != "[1, 1, 3, 4, 5]" {
+		t.Fatalf("unexpected order %s", sl)
+	}
+	if i := sl.Add(2); i != 2 {
+		t.Fatalf("expected insertion index 2 got %d", i)
+	}
+	if v, _ := sl.Get(3); v != 3 {
+		t.Fatalf("expected third smallest 3 got %d", v)
+	}
+	if first, _ := sl.GetFirst(); first != 1 {
+		t.Fatalf("expected first 1 got %d", first)
+	}
+	if last, _ := sl.GetLast(); last != 5 {
+		t.Fatalf("expected last 5 got %d", last)
+	}
+	if _, err := sl.Get(6); !errors.Is(err, ErrIndexOutOfBounds) {
+		t.Fatalf("expected ErrIndexOutOfBounds got %v", err)
+	}
+}
+
+func TestSortedListLookupAndRemove(t *testing.T) {
+	sl := NewSortedListFromSlice([]int{9, 2, 7, 2, 4}, func(a, b int) int { return a - b })
+	if sl.IndexOf(2) != 0 || sl.IndexOf(7) != 3 || sl.IndexOf(8) != -1 {
+		t.Fatalf("unexpected IndexOf results for %s", sl)
+	}
+	if !sl.Contains(9) || sl.Contains(10) {
+		t.Fatalf("unexpected Contains results")
+	}
+	if !sl.RemoveElement(2) || sl.IndexOf(2) != 0 || sl.Size() != 4 {
+		t.Fatalf("expected one of the duplicates removed got %s", sl)
+	}
+	if v, err := sl.Remove(0); err != nil || v != 2 {
+		t.Fatalf("expected 2 got %d err=%v", v, err)
+	}
+	if sl.RemoveElement(2) {
+		t.Fatalf("expected no more 2s")
+	}
+	sl.Clear()
+	if !sl.IsEmpty() {
+		t.Fatalf("expected empty list after clear")
+	}
+	if _, err := sl.GetFirst(); !errors.Is(err, ErrEmptyList) {
+		t.Fatalf("expected ErrEmptyList got %v", err)
+	}
+}
+
+func TestSortedListRange(t *testing.T) {
+	sl := NewSortedListFromSlice([]int{1, 3, 5, 7, 9}, func(a, b int) int { return a - b })
+	if got := sl.Range(3, 7); !slices.Equal(got, []int{3, 5, 7}) {
+		t.Fatalf("expected [3 5 7] got %v", got)
+	}
+	if got := sl.Range(4, 4); len(got) != 0 {
+		t.Fatalf("expected empty range got %v", got)
+	}
+	if got := sl.Range(8, 2); len(got) != 0 {
+		t.Fatalf("expected empty range for reversed bounds got %v", got)
+	}
+}
+
+func TestSortedListComparatorStability(t *testing.T) {
+	sl := NewSortedListWithComparator(func(a, b string) int { return len(a) - len(b) })
+	for _, w := range []string{"ccc", "a", "bb", "dd", "e"} {
+		sl.Add(w)
+	}
+	if sl.Join(" ") != "a e bb dd ccc" {
+		t.Fatalf("equal elements should keep insertion order got %s", sl.Join(" "))
+	}
+	sl.SetFormatFunc(strings.ToUpper)
+	if sl.String() != "[A, E, BB, DD, CCC]" {
+		t.Fatalf("unexpected string %s", sl)
+	}
+}
+
+func TestSortedListSharedComparator(t *testing.T) {
+	byLen := compare.ByKey(func(s string) int { return len(s) }, compare.Natural[int]())
+	sl := NewSortedListFromSlice([]string{"bb", "a", "ccc", "b"}, compare.Reverse(byLen.Then(strings.Compare)))
+	if sl.Join(" ") != "ccc bb b a" {
+		t.Fatalf("expected ccc bb b a got %s", sl.Join(" "))
+	}
+}
+
+func TestSortedListMatchesSortedSlice(t *testing.T) {
+	r := rand.New(rand.NewSource(11))
+	sl := NewSortedList[int]()
+	var model []int
+	for i := 0; i < 1000; i++ {
+		v := r.Intn(100)
+		if r.Intn(3) == 0 {
+			idx := slices.Index(model, v)
+			if sl.RemoveElement(v) != (idx >= 0) {
+				t.Fatalf("remove mismatch for %d", v)
+			}
+			if idx >= 0 {
+				model = slices.Delete(model, idx, idx+1)
+			}
+		} else {
+			sl.Add(v)
+			model = append(model, v)
+			slices.Sort(model)
+		}
+	}
+	if !slices.Equal(sl.ToSlice(), model) {
+		t.Fatalf("sorted list diverged from model")
+	}
+}
+
+func TestSortedListExtremes(t *testing.T) {
+	sl := NewSortedList[int]()
+	if _, ok := sl.First(); ok {
+		t.Fatalf("expected no first element in an empty list")
+	}
+	if _, ok := sl.PopLast(); ok {
+		t.Fatalf("expected nothing to pop from an empty list")
+	}
+	for _, v := range []int{5, 1, 4, 2, 3} {
+		sl.Add(v)
+	}
+	if first, _ := sl.First(); first != 1 {
+		t.Fatalf("expected first 1 got %d", first)
+	}
+	if last, _ := sl.Last(); last != 5 {
+		t.Fatalf("expected last 5 got %d", last)
+	}
+
+	// Used as a priority queue, popped elements come out in order and additions still sort
+	var popped []int
+	for i := 0; i < 2; i++ {
+		v, _ := sl.PopFirst()
+		popped = append(popped, v)
+	}
+	sl.Add(0)
+	v, _ := sl.PopFirst()
+	popped = append(popped, v)
+	if last, ok := sl.PopLast(); !ok || last != 5 {
+		t.Fatalf("expected to pop 5 got %d", last)
+	}
+	if !slices.Equal(popped, []int{1, 2, 0}) || sl.String() != "[3, 4]" {
+		t.Fatalf("unexpected pops %v leaving %s", popped, sl)
+	}
+	if err := sl.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,82 @@
+package list
+
+import (
+	"unsafe"
+
+	"github.com/profoundwu/containers/metrics"
+)
+
+// Stats describes how much memory a list holds on to
+type Stats struct {
+	// Size is the number of elements
+	Size int
+	// Capacity is the number of element slots allocated, used or not
+	Capacity int
+	// Nodes is the number of nodes for linked lists, and 0 for array backed ones
+	Nodes int
+	// Bytes approximates the memory retained by the list itself; memory that elements
+	// point to is not counted
+	Bytes int
+}
+
+// Stats reports the size, capacity and approximate footprint of the array list
+func (al *ArrayList[T]) Stats() Stats {
+	var zero T
+	bytes := int(unsafe.Sizeof(*al))
+	if !al.isInline() {
+		bytes += al.Capacity() * int(unsafe.Sizeof(zero))
+	}
+	return Stats{Size: al.size, Capacity: al.Capacity(), Bytes: bytes}
+}
+
+// Metrics returns the operation counts of an array list created with WithMetrics
+// Lists created without it report zero counts
+func (al *ArrayList[T]) Metrics() metrics.Metrics {
+	return al.metrics.Snapshot()
+}
+
+// isInline checks whether the elements live in the storage inside the list
+func (al *ArrayList[T]) isInline() bool {
+	base := al.elements
+	if al.backing != nil {
+		base = al.backing
+	}
+	return cap(base) > 0 && unsafe.SliceData(base) == &al.small[0]
+}
+
+// Stats reports the size and approximate footprint of the linked list
+// Nodes of an arena are counted as they are used, not by the chunks the arena has reserved
+func (ll *LinkedList[T]) Stats() Stats {
+	var n node[T]
+	return Stats{
+		Size:     ll.size,
+		Capacity: ll.size,
+		Nodes:    ll.size,
+		Bytes:    int(unsafe.Sizeof(*ll)) + ll.size*int(unsafe.Sizeof(n)),
+	}
+}
+
+// Stats reports the size, slot capacity, node count and approximate footprint of the unrolled linked list
+func (ul *UnrolledLinkedList[T]) Stats() Stats {
+	var zero T
+	var n unrolledNode[T]
+	stats := Stats{Size: ul.size, Bytes: int(unsafe.Sizeof(*ul))}
+	for cur := ul.head; cur != nil; cur = cur.next {
+		stats.Nodes++
+		stats.Capacity += cap(cur.elements)
+	}
+	stats.Bytes += stats.Nodes*int(unsafe.Sizeof(n)) + stats.Capacity*int(unsafe.Sizeof(zero))
+	return stats
+}
+
+// Stats reports the size and approximate footprint of the current snapshot
+// Snapshots still held by running iterations are not counted
+func (l *CopyOnWriteArrayList[T]) Stats() Stats {
+	var zero T
+	elements := l.snapshot()
+	return Stats{
+		Size:     len(elements),
+		Capacity: cap(elements),
+		Bytes:    int(unsafe.Sizeof(*l)) + cap(elements)*int(unsafe.Sizeof(zero)),
+	}
+}
@@ -0,0 +1,64 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/profoundwu/containers/metrics"
+)
+
+func TestListStats(t *testing.T) {
+	al := NewArrayListFromSlice([]int64{1, 2})
+	small := al.Stats()
+	if small.Size != 2 || small.Capacity != smallCapacity || small.Nodes != 0 {
+		t.Fatalf("unexpected stats %+v", small)
+	}
+	for i := 0; i < 10; i++ {
+		al.AddLast(int64(i))
+	}
+	grown := al.Stats()
+	if grown.Bytes != small.Bytes+grown.Capacity*8 {
+		t.Fatalf("expected the backing array to add %d bytes got %+v", grown.Capacity*8, grown)
+	}
+
+	ll := NewLinkedListFromSlice([]int{1, 2, 3})
+	if stats := ll.Stats(); stats.Size != 3 || stats.Nodes != 3 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+
+	ul := NewUnrolledLinkedListWithNodeCapacity[int](4)
+	for i := 0; i < 9; i++ {
+		ul.AddLast(i)
+	}
+	if stats := ul.Stats(); stats.Nodes != 3 || stats.Capacity != 12 {
+		t.Fatalf("expected 3 nodes with 12 slots got %+v", stats)
+	}
+
+	cow := NewCopyOnWriteArrayListFromSlice([]int{1, 2, 3})
+	if stats := cow.Stats(); stats.Size != 3 || stats.Capacity < 3 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+}
+
+func TestArrayListMetrics(t *testing.T) {
+	m := metrics.New()
+	al := New[int](WithMetrics(m), WithCapacity(2))
+	for i := 0; i < 5; i++ {
+		al.AddLast(i)
+	}
+	al.AddFirst(-1)
+	al.Add(2, 9)
+	al.Get(0)
+	al.Contains(3)
+	al.RemoveFirst()
+	al.RemoveLast()
+	al.RemoveElement(9)
+	al.AddSlice([]int{7, 8})
+
+	got := al.Metrics()
+	if got.Adds != 7 || got.Removes != 3 || got.Lookups != 2 || got.Growths == 0 {
+		t.Fatalf("unexpected metrics %+v", got)
+	}
+	if NewArrayList[int]().Metrics() != (metrics.Metrics{}) {
+		t.Fatalf("expected an uninstrumented list to report zero counts")
+	}
+}
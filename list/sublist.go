@@ -0,0 +1,268 @@
+package list
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SubList is a view of the range [from, to) of a backing list
+// Reads and writes pass through to the backing list, and adding or removing through the view
+// grows or shrinks both. Structural changes made to the backing list directly invalidate the view
+type SubList[T any] struct {
+	parent   List[T]
+	offset   int
+	size     int
+	format   FormatFunc[T]
+	equality equality[T]
+}
+
+var _ List[int] = (*SubList[int])(nil)
+
+func newSubList[T any](parent List[T], from, to int) (*SubList[T], error) {
+	if from < 0 || to > parent.Size() || from > to {
+		return nil, fmt.Errorf("%w: [%d, %d), list size: %d", ErrIndexOutOfBounds, from, to, parent.Size())
+	}
+	return &SubList[T]{parent: parent, offset: from, size: to - from, equality: equalityOf(parent)}, nil
+}
+
+// SubList returns a view of the elements in [from, to)
+// Returns error if the range is not within the list
+func (al *ArrayList[T]) SubList(from, to int) (*SubList[T], error) {
+	return newSubList[T](al, from, to)
+}
+
+// Slice returns a new array list holding a copy of the elements in [from, to)
+// Returns error if the range is not within the list
+func (al *ArrayList[T]) Slice(from, to int) (*ArrayList[T], error) {
+	if from < 0 || to > al.size || from > to {
+		return nil, fmt.Errorf("%w: [%d, %d), list size: %d", ErrIndexOutOfBounds, from, to, al.size)
+	}
+	result := newArrayList(to-from, al.equality)
+	result.size = copy(result.elements, al.elements[from:to])
+	return result, nil
+}
+
+// SubList returns a view of the elements in [from, to)
+// Returns error if the range is not within the list
+func (ll *LinkedList[T]) SubList(from, to int) (*SubList[T], error) {
+	return newSubList[T](ll, from, to)
+}
+
+// Slice returns a new linked list holding a copy of the elements in [from, to)
+// Returns error if the range is not within the list
+func (ll *LinkedList[T]) Slice(from, to int) (*LinkedList[T], error) {
+	if from < 0 || to > ll.size || from > to {
+		return nil, fmt.Errorf("%w: [%d, %d), list size: %d", ErrIndexOutOfBounds, from, to, ll.size)
+	}
+	result := &LinkedList[T]{equality: ll.equality}
+	if from == to {
+		return result, nil
+	}
+	cur := ll.nodeAt(from)
+	for i := from; i < to; i++ {
+		result.AddLast(cur.value)
+		cur = cur.next
+	}
+	return result, nil
+}
+
+// SubList returns a view of the elements in [from, to) of this view
+// Returns error if the range is not within the view
+func (sl *SubList[T]) SubList(from, to int) (*SubList[T], error) {
+	return newSubList[T](sl, from, to)
+}
+
+// Size returns the number of elements in the view
+func (sl *SubList[T]) Size() int {
+	return sl.size
+}
+
+// IsEmpty checks if the view is empty
+func (sl *SubList[T]) IsEmpty() bool {
+	return sl.size == 0
+}
+
+// AddLast adds an element at the end of the view, inserting it into the backing list
+func (sl *SubList[T]) AddLast(elem T) {
+	// The index is always valid, so the error can be ignored
+	_ = sl.Add(sl.size, elem)
+}
+
+// Add inserts an element at the specified index position of the view
+// Returns error if index is out of bounds
+func (sl *SubList[T]) Add(index int, elem T) error {
+	if index < 0 || index > sl.size {
+		return fmt.Errorf("%w: %d, list size: %d", ErrIndexOutOfBounds, index, sl.size)
+	}
+	if err := sl.parent.Add(sl.offset+index, elem); err != nil {
+		return err
+	}
+	sl.size++
+	return nil
+}
+
+// Get returns the element at the specified index position of the view
+// Returns error if index is out of bounds
+func (sl *SubList[T]) Get(index int) (T, error) {
+	if err := sl.checkIndex(index); err != nil {
+		var zero T
+		return zero, err
+	}
+	return sl.parent.Get(sl.offset + index)
+}
+
+// GetFirst returns the first element of the view
+// Returns error if the view is empty
+func (sl *SubList[T]) GetFirst() (T, error) {
+	if sl.size == 0 {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	return sl.parent.Get(sl.offset)
+}
+
+// GetLast returns the last element of the view
+// Returns error if the view is empty
+func (sl *SubList[T]) GetLast() (T, error) {
+	if sl.size == 0 {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	return sl.parent.Get(sl.offset + sl.size - 1)
+}
+
+// Set replaces the element at the specified index position of the view
+// Returns error if index is out of bounds
+func (sl *SubList[T]) Set(index int, elem T) error {
+	if err := sl.checkIndex(index); err != nil {
+		return err
+	}
+	return sl.parent.Set(sl.offset+index, elem)
+}
+
+// Remove removes and returns the element at the specified index position of the view
+// Returns error if index is out of bounds
+func (sl *SubList[T]) Remove(index int) (T, error) {
+	if err := sl.checkIndex(index); err != nil {
+		var zero T
+		return zero, err
+	}
+	elem, err := sl.parent.Remove(sl.offset + index)
+	if err == nil {
+		sl.size--
+	}
+	return elem, err
+}
+
+// RemoveFirst removes and returns the first element of the view
+// Returns error if the view is empty
+func (sl *SubList[T]) RemoveFirst() (T, error) {
+	if sl.size == 0 {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	return sl.Remove(0)
+}
+
+// RemoveLast removes and returns the last element of the view
+// Returns error if the view is empty
+func (sl *SubList[T]) RemoveLast() (T, error) {
+	if sl.size == 0 {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	return sl.Remove(sl.size - 1)
+}
+
+// RemoveElement removes the first occurrence of the specified element within the view
+// Returns true if the element was found and removed, false otherwise
+func (sl *SubList[T]) RemoveElement(elem T) bool {
+	index := sl.IndexOf(elem)
+	if index == -1 {
+		return false
+	}
+	_, err := sl.Remove(index)
+	return err == nil
+}
+
+// Contains checks if the view contains the specified element
+func (sl *SubList[T]) Contains(elem T) bool {
+	return sl.IndexOf(elem) != -1
+}
+
+// IndexOf returns the index within the view of the first occurrence of the specified element
+// Returns -1 if the element is not found
+func (sl *SubList[T]) IndexOf(elem T) int {
+	for i := 0; i < sl.size; i++ {
+		if v, _ := sl.parent.Get(sl.offset + i); sl.equality.eq(v, elem) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Clear removes the elements of the view from the backing list
+// Backing lists that support RemoveRange drop the whole range at once
+func (sl *SubList[T]) Clear() {
+	if r, ok := sl.parent.(interface {
+		RemoveRange(from, to int) (int, error)
+	}); ok {
+		if _, err := r.RemoveRange(sl.offset, sl.offset+sl.size); err == nil {
+			sl.size = 0
+		}
+		return
+	}
+	for sl.size > 0 {
+		sl.parent.Remove(sl.offset + sl.size - 1)
+		sl.size--
+	}
+}
+
+// ToSlice converts the view to a slice
+func (sl *SubList[T]) ToSlice() []T {
+	return sl.AppendTo(make([]T, 0, sl.size))
+}
+
+// AppendTo appends the elements of the view to dst and returns the extended slice
+func (sl *SubList[T]) AppendTo(dst []T) []T {
+	for i := 0; i < sl.size; i++ {
+		v, _ := sl.parent.Get(sl.offset + i)
+		dst = append(dst, v)
+	}
+	return dst
+}
+
+// Reverse reverses the elements of the view in place within the backing list
+func (sl *SubList[T]) Reverse() {
+	for i, j := 0, sl.size-1; i < j; i, j = i+1, j-1 {
+		a, _ := sl.Get(i)
+		b, _ := sl.Get(j)
+		sl.Set(i, b)
+		sl.Set(j, a)
+	}
+}
+
+// SetFormatFunc sets how elements render in String and Join
+// A nil format restores the default %v rendering
+func (sl *SubList[T]) SetFormatFunc(format FormatFunc[T]) {
+	sl.format = format
+}
+
+// Join renders the elements of the view separated by sep
+func (sl *SubList[T]) Join(sep string) string {
+	var sb strings.Builder
+	joinElements(&sb, sl.format, sl.ToSlice(), sep)
+	return sb.String()
+}
+
+// String returns a string representation of the view
+func (sl *SubList[T]) String() string {
+	return "[" + sl.Join(", ") + "]"
+}
+
+func (sl *SubList[T]) checkIndex(index int) error {
+	if index < 0 || index >= sl.size {
+		return fmt.Errorf("%w: %d, list size: %d", ErrIndexOutOfBounds, index, sl.size)
+	}
+	return nil
+}
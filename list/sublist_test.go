@@ -0,0 +1,85 @@
+package list
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSubListPassesThrough(t *testing.T) {
+	for name, backing := range map[string]List[int]{
+		"array":  NewArrayListFromSlice([]int{0, 1, 2, 3, 4, 5}),
+		"linked": NewLinkedListFromSlice([]int{0, 1, 2, 3, 4, 5}),
+	} {
+		t.Run(name, func(t *testing.T) {
+			var view *SubList[int]
+			switch l := backing.(type) {
+			case *ArrayList[int]:
+				view, _ = l.SubList(1, 4)
+			case *LinkedList[int]:
+				view, _ = l.SubList(1, 4)
+			}
+			assertElements(t, view, []int{1, 2, 3})
+
+			view.Set(0, 10)
+			assertElements(t, backing, []int{0, 10, 2, 3, 4, 5})
+
+			view.AddLast(99)
+			view.Remove(1)
+			assertElements(t, view, []int{10, 3, 99})
+			assertElements(t, backing, []int{0, 10, 3, 99, 4, 5})
+
+			view.Reverse()
+			assertElements(t, backing, []int{0, 99, 3, 10, 4, 5})
+			if view.IndexOf(10) != 2 || view.Contains(4) {
+				t.Fatalf("lookups should stay within the view")
+			}
+			if _, err := view.Get(3); !errors.Is(err, ErrIndexOutOfBounds) {
+				t.Fatalf("expected ErrIndexOutOfBounds got %v", err)
+			}
+
+			inner, _ := view.SubList(1, 3)
+			inner.Clear()
+			assertElements(t, view, []int{99})
+			assertElements(t, backing, []int{0, 99, 4, 5})
+		})
+	}
+}
+
+func TestSubListBounds(t *testing.T) {
+	al := NewArrayListFromSlice([]int{1, 2, 3})
+	if _, err := al.SubList(2, 1); !errors.Is(err, ErrIndexOutOfBounds) {
+		t.Fatalf("expected ErrIndexOutOfBounds got %v", err)
+	}
+	if _, err := al.SubList(0, 4); !errors.Is(err, ErrIndexOutOfBounds) {
+		t.Fatalf("expected ErrIndexOutOfBounds got %v", err)
+	}
+	empty, err := al.SubList(3, 3)
+	if err != nil || !empty.IsEmpty() {
+		t.Fatalf("expected an empty view at the end got err=%v", err)
+	}
+	if _, err := empty.GetFirst(); !errors.Is(err, ErrEmptyList) {
+		t.Fatalf("expected ErrEmptyList got %v", err)
+	}
+	empty.AddLast(4)
+	assertElements(t, al, []int{1, 2, 3, 4})
+}
+
+func TestSliceCopies(t *testing.T) {
+	al := NewArrayListFromSlice([]int{1, 2, 3, 4})
+	copied, err := al.Slice(1, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	copied.Set(0, 20)
+	assertElements(t, copied, []int{20, 3})
+	assertElements(t, al, []int{1, 2, 3, 4})
+
+	ll := NewLinkedListFromSlice([]int{1, 2, 3, 4})
+	llCopy, _ := ll.Slice(2, 4)
+	llCopy.AddLast(5)
+	assertElements(t, llCopy, []int{3, 4, 5})
+	assertElements(t, ll, []int{1, 2, 3, 4})
+	if _, err := ll.Slice(3, 5); !errors.Is(err, ErrIndexOutOfBounds) {
+		t.Fatalf("expected ErrIndexOutOfBounds got %v", err)
+	}
+}
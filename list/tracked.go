@@ -0,0 +1,242 @@
+package list
+
+import (
+	"slices"
+)
+
+// Changes describes how a TrackedList differs from its state at the last ResetTracking
+// Added and Modified hold positions in the current list, Removed holds positions as they were at the reset
+// All three are sorted ascending
+type Changes struct {
+	Added    []int
+	Modified []int
+	Removed  []int
+}
+
+// IsEmpty checks if there are no changes
+func (c Changes) IsEmpty() bool {
+	return len(c.Added) == 0 && len(c.Modified) == 0 && len(c.Removed) == 0
+}
+
+type trackState struct {
+	// origin is the element's position at the last reset, or -1 if it was added since
+	origin   int
+	modified bool
+}
+
+// TrackedList wraps a list and records which elements were added, modified or removed
+// since the last ResetTracking, so that only the changes need to be persisted
+// Elements keep their identity as they shift, and an element moved by Reverse counts as modified
+// The backing list must only be changed through the wrapper
+type TrackedList[T comparable] struct {
+	inner   List[T]
+	states  []trackState
+	removed []int
+}
+
+var _ List[int] = (*TrackedList[int])(nil)
+
+// NewTrackedList creates a tracked list over inner, treating its current elements as unchanged
+func NewTrackedList[T comparable](inner List[T]) *TrackedList[T] {
+	tl := &TrackedList[T]{inner: inner}
+	tl.ResetTracking()
+	return tl
+}
+
+// Changes returns the changes made since the last ResetTracking
+func (tl *TrackedList[T]) Changes() Changes {
+	var c Changes
+	for i, s := range tl.states {
+		switch {
+		case s.origin < 0:
+			c.Added = append(c.Added, i)
+		case s.modified:
+			c.Modified = append(c.Modified, i)
+		}
+	}
+	c.Removed = slices.Clone(tl.removed)
+	slices.Sort(c.Removed)
+	return c
+}
+
+// HasChanges checks if anything changed since the last ResetTracking
+func (tl *TrackedList[T]) HasChanges() bool {
+	if len(tl.removed) > 0 {
+		return true
+	}
+	for _, s := range tl.states {
+		if s.origin < 0 || s.modified {
+			return true
+		}
+	}
+	return false
+}
+
+// ResetTracking forgets all recorded changes, making the current contents the new baseline
+func (tl *TrackedList[T]) ResetTracking() {
+	tl.states = make([]trackState, tl.inner.Size())
+	for i := range tl.states {
+		tl.states[i].origin = i
+	}
+	tl.removed = nil
+}
+
+// Size returns the number of elements in the list
+func (tl *TrackedList[T]) Size() int {
+	return tl.inner.Size()
+}
+
+// IsEmpty checks if the list is empty
+func (tl *TrackedList[T]) IsEmpty() bool {
+	return tl.inner.IsEmpty()
+}
+
+// AddLast adds an element to the end of the list and records it as added
+func (tl *TrackedList[T]) AddLast(elem T) {
+	tl.inner.AddLast(elem)
+	tl.states = append(tl.states, trackState{origin: -1})
+}
+
+// Add inserts an element at the specified index position and records it as added
+// Returns error if index is out of bounds
+func (tl *TrackedList[T]) Add(index int, elem T) error {
+	if err := tl.inner.Add(index, elem); err != nil {
+		return err
+	}
+	tl.states = slices.Insert(tl.states, index, trackState{origin: -1})
+	return nil
+}
+
+// Get returns the element at the specified index position
+// Returns error if index is out of bounds
+func (tl *TrackedList[T]) Get(index int) (T, error) {
+	return tl.inner.Get(index)
+}
+
+// GetFirst returns the first element of the list
+// Returns error if list is empty
+func (tl *TrackedList[T]) GetFirst() (T, error) {
+	return tl.inner.GetFirst()
+}
+
+// GetLast returns the last element of the list
+// Returns error if list is empty
+func (tl *TrackedList[T]) GetLast() (T, error) {
+	return tl.inner.GetLast()
+}
+
+// Set updates the element value at the specified index position and records it as modified
+// Setting an element to its current value is not a change
+// Returns error if index is out of bounds
+func (tl *TrackedList[T]) Set(index int, elem T) error {
+	old, err := tl.inner.Get(index)
+	if err != nil {
+		return err
+	}
+	if old == elem {
+		return nil
+	}
+	if err := tl.inner.Set(index, elem); err != nil {
+		return err
+	}
+	tl.states[index].modified = true
+	return nil
+}
+
+// Remove deletes the element at the specified index position and returns its value
+// Returns error if index is out of bounds
+func (tl *TrackedList[T]) Remove(index int) (T, error) {
+	removed, err := tl.inner.Remove(index)
+	if err != nil {
+		return removed, err
+	}
+	if origin := tl.states[index].origin; origin >= 0 {
+		tl.removed = append(tl.removed, origin)
+	}
+	tl.states = slices.Delete(tl.states, index, index+1)
+	return removed, nil
+}
+
+// RemoveFirst deletes and returns the first element of the list
+// Returns error if list is empty
+func (tl *TrackedList[T]) RemoveFirst() (T, error) {
+	if tl.IsEmpty() {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	return tl.Remove(0)
+}
+
+// RemoveLast deletes and returns the last element of the list
+// Returns error if list is empty
+func (tl *TrackedList[T]) RemoveLast() (T, error) {
+	if tl.IsEmpty() {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	return tl.Remove(tl.Size() - 1)
+}
+
+// RemoveElement deletes the first occurrence of the specified element from the list
+// Returns true if element was found and removed, false otherwise
+func (tl *TrackedList[T]) RemoveElement(elem T) bool {
+	index := tl.inner.IndexOf(elem)
+	if index < 0 {
+		return false
+	}
+	_, err := tl.Remove(index)
+	return err == nil
+}
+
+// Contains checks if the list contains the specified element
+func (tl *TrackedList[T]) Contains(elem T) bool {
+	return tl.inner.Contains(elem)
+}
+
+// IndexOf returns the first index of the specified element in the list
+// Returns -1 if element is not found
+func (tl *TrackedList[T]) IndexOf(elem T) int {
+	return tl.inner.IndexOf(elem)
+}
+
+// Clear removes all elements from the list, recording every baseline element as removed
+func (tl *TrackedList[T]) Clear() {
+	tl.inner.Clear()
+	for _, s := range tl.states {
+		if s.origin >= 0 {
+			tl.removed = append(tl.removed, s.origin)
+		}
+	}
+	tl.states = tl.states[:0]
+}
+
+// ToSlice converts the list to a slice
+func (tl *TrackedList[T]) ToSlice() []T {
+	return tl.inner.ToSlice()
+}
+
+// AppendTo appends the elements of the list to dst and returns the extended slice
+func (tl *TrackedList[T]) AppendTo(dst []T) []T {
+	return tl.inner.AppendTo(dst)
+}
+
+// Reverse reverses the list, recording every element that changed position as modified
+func (tl *TrackedList[T]) Reverse() {
+	tl.inner.Reverse()
+	slices.Reverse(tl.states)
+	for i := range tl.states {
+		if tl.states[i].origin >= 0 && tl.states[i].origin != i {
+			tl.states[i].modified = true
+		}
+	}
+}
+
+// Join renders the elements of the list separated by sep
+func (tl *TrackedList[T]) Join(sep string) string {
+	return tl.inner.Join(sep)
+}
+
+// String returns a string representation of the list
+func (tl *TrackedList[T]) String() string {
+	return tl.inner.String()
+}
@@ -0,0 +1,50 @@
+package list
+
+import (
+	"slices"
+	"testing"
+)
+
+func assertChanges(t *testing.T, got Changes, added, modified, removed []int) {
+	t.Helper()
+	if !slices.Equal(got.Added, added) || !slices.Equal(got.Modified, modified) || !slices.Equal(got.Removed, removed) {
+		t.Fatalf("expected added %v modified %v removed %v got %+v", added, modified, removed, got)
+	}
+}
+
+func TestTrackedListChanges(t *testing.T) {
+	tl := NewTrackedList[string](NewArrayListFromSlice([]string{"a", "b", "c", "d"}))
+	if tl.HasChanges() || !tl.Changes().IsEmpty() {
+		t.Fatalf("expected no changes on a fresh tracked list")
+	}
+
+	tl.Set(1, "B")
+	tl.Set(2, "c")
+	tl.Remove(0)
+	tl.Add(0, "x")
+	tl.AddLast("e")
+	tl.RemoveElement("d")
+	assertElements[string](t, tl, []string{"x", "B", "c", "e"})
+	assertChanges(t, tl.Changes(), []int{0, 3}, []int{1}, []int{0, 3})
+
+	// Removing an added element leaves no trace
+	tl.RemoveLast()
+	assertChanges(t, tl.Changes(), []int{0}, []int{1}, []int{0, 3})
+
+	tl.ResetTracking()
+	if tl.HasChanges() {
+		t.Fatalf("expected no changes after ResetTracking")
+	}
+	tl.Clear()
+	assertChanges(t, tl.Changes(), nil, nil, []int{0, 1, 2})
+}
+
+func TestTrackedListReverse(t *testing.T) {
+	tl := NewTrackedList[int](NewLinkedListFromSlice([]int{1, 2, 3}))
+	tl.Reverse()
+	assertElements[int](t, tl, []int{3, 2, 1})
+	assertChanges(t, tl.Changes(), nil, []int{0, 2}, nil)
+	if s := tl.String(); s != "[3 -> 2 -> 1]" {
+		t.Fatalf("expected [3 -> 2 -> 1] got %s", s)
+	}
+}
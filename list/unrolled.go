@@ -0,0 +1,375 @@
+package list
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// DefaultUnrolledNodeCapacity is the number of elements each node of an unrolled linked list holds
+// unless another capacity is requested
+const DefaultUnrolledNodeCapacity = 64
+
+type unrolledNode[T any] struct {
+	// elements holds between one and the list's node capacity elements
+	elements []T
+	prev     *unrolledNode[T]
+	next     *unrolledNode[T]
+}
+
+// UnrolledLinkedList is a doubly linked list of small arrays of elements
+// Walking it touches one pointer per node instead of one per element, and each node is one
+// allocation, while inserting in the middle only shifts the elements of a single node
+// Positional access costs O(n/c) for a node capacity of c
+type UnrolledLinkedList[T any] struct {
+	head         *unrolledNode[T]
+	tail         *unrolledNode[T]
+	size         int
+	nodeCapacity int
+	format       FormatFunc[T]
+	equality     equality[T]
+	// modCount counts structural changes so sequences can detect modification behind their back
+	modCount int
+}
+
+var _ List[int] = (*UnrolledLinkedList[int])(nil)
+
+// NewUnrolledLinkedList creates a new empty unrolled linked list with the default node capacity
+func NewUnrolledLinkedList[T comparable]() *UnrolledLinkedList[T] {
+	return newUnrolledLinkedList(DefaultUnrolledNodeCapacity, comparableEquality[T]())
+}
+
+// NewUnrolledLinkedListWithNodeCapacity creates a new empty unrolled linked list whose nodes hold up to
+// capacity elements
+// Values of capacity below 2 fall back to the default node capacity
+func NewUnrolledLinkedListWithNodeCapacity[T comparable](capacity int) *UnrolledLinkedList[T] {
+	return newUnrolledLinkedList(capacity, comparableEquality[T]())
+}
+
+// NewUnrolledLinkedListWithEquals creates a new empty unrolled linked list that compares elements with eq
+func NewUnrolledLinkedListWithEquals[T any](eq func(a, b T) bool) *UnrolledLinkedList[T] {
+	return newUnrolledLinkedList(DefaultUnrolledNodeCapacity, funcEquality(eq))
+}
+
+// NewUnrolledLinkedListFromSlice creates an unrolled linked list from a slice, filling every node
+func NewUnrolledLinkedListFromSlice[T comparable](slice []T) *UnrolledLinkedList[T] {
+	ul := NewUnrolledLinkedList[T]()
+	for _, v := range slice {
+		ul.AddLast(v)
+	}
+	return ul
+}
+
+func newUnrolledLinkedList[T any](capacity int, e equality[T]) *UnrolledLinkedList[T] {
+	if capacity < 2 {
+		capacity = DefaultUnrolledNodeCapacity
+	}
+	return &UnrolledLinkedList[T]{nodeCapacity: capacity, equality: e}
+}
+
+// Size returns the number of elements in the list
+func (ul *UnrolledLinkedList[T]) Size() int {
+	return ul.size
+}
+
+// IsEmpty checks if the list is empty
+func (ul *UnrolledLinkedList[T]) IsEmpty() bool {
+	return ul.size == 0
+}
+
+// AddLast adds an element at the end of the list
+func (ul *UnrolledLinkedList[T]) AddLast(elem T) {
+	if ul.tail == nil || len(ul.tail.elements) == ul.nodeCapacity {
+		ul.insertNodeAfter(ul.tail, ul.newNode())
+	}
+	ul.tail.elements = append(ul.tail.elements, elem)
+	ul.size++
+	ul.modCount++
+}
+
+// Add inserts an element at the specified index position
+// A full node is split in half first, so later insertions nearby do not split again
+// Returns error if index is out of bounds
+func (ul *UnrolledLinkedList[T]) Add(index int, elem T) error {
+	if index < 0 || index > ul.size {
+		return fmt.Errorf("%w: %d, list size: %d", ErrIndexOutOfBounds, index, ul.size)
+	}
+	if index == ul.size {
+		ul.AddLast(elem)
+		return nil
+	}
+
+	n, offset := ul.locate(index)
+	if len(n.elements) == ul.nodeCapacity {
+		half := ul.nodeCapacity / 2
+		split := ul.newNode()
+		split.elements = append(split.elements, n.elements[half:]...)
+		clear(n.elements[half:])
+		n.elements = n.elements[:half]
+		ul.insertNodeAfter(n, split)
+		if offset >= half {
+			n, offset = split, offset-half
+		}
+	}
+	n.elements = slices.Insert(n.elements, offset, elem)
+	ul.size++
+	ul.modCount++
+	return nil
+}
+
+// Get returns the element at the specified index position
+// Returns error if index is out of bounds
+func (ul *UnrolledLinkedList[T]) Get(index int) (T, error) {
+	if index < 0 || index >= ul.size {
+		var zero T
+		return zero, fmt.Errorf("%w: %d, list size: %d", ErrIndexOutOfBounds, index, ul.size)
+	}
+	n, offset := ul.locate(index)
+	return n.elements[offset], nil
+}
+
+// GetFirst returns the first element of the list
+// Returns error if list is empty
+func (ul *UnrolledLinkedList[T]) GetFirst() (T, error) {
+	if ul.size == 0 {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	return ul.head.elements[0], nil
+}
+
+// GetLast returns the last element of the list
+// Returns error if list is empty
+func (ul *UnrolledLinkedList[T]) GetLast() (T, error) {
+	if ul.size == 0 {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	return ul.tail.elements[len(ul.tail.elements)-1], nil
+}
+
+// Set replaces the element at the specified index position
+// Returns error if index is out of bounds
+func (ul *UnrolledLinkedList[T]) Set(index int, elem T) error {
+	if index < 0 || index >= ul.size {
+		return fmt.Errorf("%w: %d, list size: %d", ErrIndexOutOfBounds, index, ul.size)
+	}
+	n, offset := ul.locate(index)
+	n.elements[offset] = elem
+	return nil
+}
+
+// Remove deletes and returns the element at the specified index position
+// A node left less than half full is merged with its successor when both fit in one node
+// Returns error if index is out of bounds
+func (ul *UnrolledLinkedList[T]) Remove(index int) (T, error) {
+	if index < 0 || index >= ul.size {
+		var zero T
+		return zero, fmt.Errorf("%w: %d, list size: %d", ErrIndexOutOfBounds, index, ul.size)
+	}
+	n, offset := ul.locate(index)
+	return ul.removeAt(n, offset), nil
+}
+
+// RemoveFirst deletes and returns the first element of the list
+// Returns error if list is empty
+func (ul *UnrolledLinkedList[T]) RemoveFirst() (T, error) {
+	if ul.size == 0 {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	return ul.removeAt(ul.head, 0), nil
+}
+
+// RemoveLast deletes and returns the last element of the list
+// Returns error if list is empty
+func (ul *UnrolledLinkedList[T]) RemoveLast() (T, error) {
+	if ul.size == 0 {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	return ul.removeAt(ul.tail, len(ul.tail.elements)-1), nil
+}
+
+// RemoveElement deletes the first occurrence of the specified element
+// Returns true if element was found and removed, false otherwise
+func (ul *UnrolledLinkedList[T]) RemoveElement(elem T) bool {
+	for n := ul.head; n != nil; n = n.next {
+		for i, v := range n.elements {
+			if ul.equality.eq(v, elem) {
+				ul.removeAt(n, i)
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// removeAt deletes the element at offset within n, unlinking n once it is empty
+func (ul *UnrolledLinkedList[T]) removeAt(n *unrolledNode[T], offset int) T {
+	removed := n.elements[offset]
+	n.elements = slices.Delete(n.elements, offset, offset+1)
+	ul.size--
+	ul.modCount++
+
+	switch {
+	case len(n.elements) == 0:
+		ul.unlinkNode(n)
+	case len(n.elements) < ul.nodeCapacity/2 && n.next != nil &&
+		len(n.elements)+len(n.next.elements) <= ul.nodeCapacity:
+		next := n.next
+		n.elements = append(n.elements, next.elements...)
+		ul.unlinkNode(next)
+	}
+	return removed
+}
+
+// Contains checks if the list contains the specified element
+func (ul *UnrolledLinkedList[T]) Contains(elem T) bool {
+	return ul.IndexOf(elem) != -1
+}
+
+// IndexOf returns the first index of the specified element
+// Returns -1 if element is not found
+func (ul *UnrolledLinkedList[T]) IndexOf(elem T) int {
+	base := 0
+	for n := ul.head; n != nil; n = n.next {
+		for i, v := range n.elements {
+			if ul.equality.eq(v, elem) {
+				return base + i
+			}
+		}
+		base += len(n.elements)
+	}
+	return -1
+}
+
+// Clear removes all elements from the list
+func (ul *UnrolledLinkedList[T]) Clear() {
+	ul.head, ul.tail, ul.size = nil, nil, 0
+	ul.modCount++
+}
+
+// ToSlice returns a copy of the elements
+func (ul *UnrolledLinkedList[T]) ToSlice() []T {
+	return ul.AppendTo(make([]T, 0, ul.size))
+}
+
+// AppendTo appends the elements to dst and returns the extended slice
+func (ul *UnrolledLinkedList[T]) AppendTo(dst []T) []T {
+	for n := ul.head; n != nil; n = n.next {
+		dst = append(dst, n.elements...)
+	}
+	return dst
+}
+
+// Reverse reverses the list in place by reversing the node order and each node's elements
+func (ul *UnrolledLinkedList[T]) Reverse() {
+	for n := ul.head; n != nil; n = n.prev {
+		slices.Reverse(n.elements)
+		n.prev, n.next = n.next, n.prev
+	}
+	ul.head, ul.tail = ul.tail, ul.head
+	ul.modCount++
+}
+
+// All returns a sequence of index-element pairs in order
+// The sequence panics with ErrConcurrentModification if the loop body adds or removes elements
+func (ul *UnrolledLinkedList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i, modCount := 0, ul.modCount
+		for n := ul.head; n != nil; n = n.next {
+			for _, v := range n.elements {
+				if !yield(i, v) {
+					return
+				}
+				checkModCount(modCount, ul.modCount)
+				i++
+			}
+		}
+	}
+}
+
+// Values returns a sequence of the elements in order
+// The sequence panics with ErrConcurrentModification if the loop body adds or removes elements
+func (ul *UnrolledLinkedList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range ul.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// SetFormatFunc sets how elements render in String and Join
+// A nil format restores the default %v rendering
+func (ul *UnrolledLinkedList[T]) SetFormatFunc(format FormatFunc[T]) {
+	ul.format = format
+}
+
+// Join renders the elements of the list separated by sep
+func (ul *UnrolledLinkedList[T]) Join(sep string) string {
+	var sb strings.Builder
+	joinElements(&sb, ul.format, ul.ToSlice(), sep)
+	return sb.String()
+}
+
+// String returns a string representation of the list
+func (ul *UnrolledLinkedList[T]) String() string {
+	return "[" + ul.Join(", ") + "]"
+}
+
+func (ul *UnrolledLinkedList[T]) newNode() *unrolledNode[T] {
+	return &unrolledNode[T]{elements: make([]T, 0, ul.nodeCapacity)}
+}
+
+// locate returns the node holding index and the offset within it, walking from the nearer end
+func (ul *UnrolledLinkedList[T]) locate(index int) (*unrolledNode[T], int) {
+	if index < ul.size/2 {
+		n := ul.head
+		for index >= len(n.elements) {
+			index -= len(n.elements)
+			n = n.next
+		}
+		return n, index
+	}
+	n, fromEnd := ul.tail, ul.size-1-index
+	for fromEnd >= len(n.elements) {
+		fromEnd -= len(n.elements)
+		n = n.prev
+	}
+	return n, len(n.elements) - 1 - fromEnd
+}
+
+// insertNodeAfter links n after prev, or at the head when prev is nil
+func (ul *UnrolledLinkedList[T]) insertNodeAfter(prev, n *unrolledNode[T]) {
+	n.prev = prev
+	if prev == nil {
+		n.next = ul.head
+		ul.head = n
+	} else {
+		n.next = prev.next
+		prev.next = n
+	}
+	if n.next == nil {
+		ul.tail = n
+	} else {
+		n.next.prev = n
+	}
+}
+
+func (ul *UnrolledLinkedList[T]) unlinkNode(n *unrolledNode[T]) {
+	if n.prev == nil {
+		ul.head = n.next
+	} else {
+		n.prev.next = n.next
+	}
+	if n.next == nil {
+		ul.tail = n.prev
+	} else {
+		n.next.prev = n.prev
+	}
+	n.prev, n.next = nil, nil
+}
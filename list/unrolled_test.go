@@ -0,0 +1,121 @@
+package list
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestUnrolledLinkedListMatchesSlice(t *testing.T) {
+	ul := NewUnrolledLinkedListWithNodeCapacity[int](4)
+	var expected []int
+	for i := 0; i < 40; i++ {
+		index := (i * 7) % (len(expected) + 1)
+		if err := ul.Add(index, i); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		expected = slices.Insert(expected, index, i)
+	}
+	assertElements[int](t, ul, expected)
+
+	for i := 0; i < 30; i++ {
+		index := (i * 5) % len(expected)
+		v, err := ul.Remove(index)
+		if err != nil || v != expected[index] {
+			t.Fatalf("expected %d got %d %v", expected[index], v, err)
+		}
+		expected = slices.Delete(expected, index, index+1)
+		assertElements[int](t, ul, expected)
+	}
+	for i, v := range ul.All() {
+		if got, _ := ul.Get(i); got != v || v != expected[i] {
+			t.Fatalf("expected %d at %d got %d", expected[i], i, got)
+		}
+	}
+	if ul.IndexOf(expected[3]) != 3 || ul.Contains(-1) {
+		t.Fatalf("unexpected search results in %s", ul)
+	}
+}
+
+func TestUnrolledLinkedList(t *testing.T) {
+	ul := NewUnrolledLinkedListFromSlice([]string{"a", "b", "c"})
+	if ul.String() != "[a, b, c]" {
+		t.Fatalf("expected [a, b, c] got %s", ul)
+	}
+	ul.Reverse()
+	assertElements[string](t, ul, []string{"c", "b", "a"})
+	if first, _ := ul.GetFirst(); first != "c" {
+		t.Fatalf("expected c got %s", first)
+	}
+	if last, _ := ul.RemoveLast(); last != "a" {
+		t.Fatalf("expected a got %s", last)
+	}
+	if !ul.RemoveElement("c") || ul.RemoveElement("z") {
+		t.Fatalf("unexpected RemoveElement results")
+	}
+	ul.Set(0, "x")
+	if got := slices.Collect(ul.Values()); !slices.Equal(got, []string{"x"}) {
+		t.Fatalf("expected [x] got %v", got)
+	}
+	ul.Clear()
+	if _, err := ul.RemoveFirst(); !errors.Is(err, ErrEmptyList) {
+		t.Fatalf("expected ErrEmptyList got %v", err)
+	}
+	if err := ul.Add(1, "y"); !errors.Is(err, ErrIndexOutOfBounds) {
+		t.Fatalf("expected ErrIndexOutOfBounds got %v", err)
+	}
+
+	eq := NewUnrolledLinkedListWithEquals(func(a, b []int) bool { return slices.Equal(a, b) })
+	eq.AddLast([]int{1, 2})
+	if !eq.Contains([]int{1, 2}) {
+		t.Fatalf("expected the custom equality to match")
+	}
+}
+
+func benchmarkMiddleInsert(b *testing.B, l List[int]) {
+	for n := 0; n < b.N; n++ {
+		l.Clear()
+		for i := 0; i < 2000; i++ {
+			l.Add(l.Size()/2, i)
+		}
+	}
+}
+
+func BenchmarkMiddleInsertArrayList(b *testing.B) {
+	benchmarkMiddleInsert(b, NewArrayList[int]())
+}
+
+func BenchmarkMiddleInsertLinkedList(b *testing.B) {
+	benchmarkMiddleInsert(b, NewLinkedList[int]())
+}
+
+func BenchmarkMiddleInsertUnrolledLinkedList(b *testing.B) {
+	benchmarkMiddleInsert(b, NewUnrolledLinkedList[int]())
+}
+
+func BenchmarkIterateArrayList(b *testing.B) {
+	l := NewArrayListFromSlice(make([]int, 100000))
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		for range l.Values() {
+		}
+	}
+}
+
+func BenchmarkIterateLinkedList(b *testing.B) {
+	l := NewLinkedListFromSlice(make([]int, 100000))
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		for range l.Values() {
+		}
+	}
+}
+
+func BenchmarkIterateUnrolledLinkedList(b *testing.B) {
+	l := NewUnrolledLinkedListFromSlice(make([]int, 100000))
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		for range l.Values() {
+		}
+	}
+}
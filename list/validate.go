@@ -0,0 +1,109 @@
+package list
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrInvariantViolated = errors.New("list invariant violated")
+)
+
+// Validate checks the internal invariants of the array list: the size fits the capacity and
+// the elements are the tail of the backing array they were sliced from
+// It is meant for tests and debugging; a list only changed through its methods always passes
+// Returns error describing the first violated invariant
+func (al *ArrayList[T]) Validate() error {
+	if al.size < 0 || al.size > len(al.elements) {
+		return fmt.Errorf("%w: size %d, capacity %d", ErrInvariantViolated, al.size, len(al.elements))
+	}
+	if al.backing == nil {
+		if al.head != 0 {
+			return fmt.Errorf("%w: head %d without a backing array", ErrInvariantViolated, al.head)
+		}
+		return nil
+	}
+	if al.head < 0 || al.head+len(al.elements) != len(al.backing) {
+		return fmt.Errorf("%w: head %d and %d elements do not span a backing array of %d",
+			ErrInvariantViolated, al.head, len(al.elements), len(al.backing))
+	}
+	if len(al.elements) > 0 && &al.elements[0] != &al.backing[al.head] {
+		return fmt.Errorf("%w: elements are not sliced from the backing array", ErrInvariantViolated)
+	}
+	return nil
+}
+
+// Validate checks the internal invariants of the linked list: the chain from the head is
+// acyclic, ends at the tail and holds size nodes, and the cursor points at its recorded index
+// It is meant for tests and debugging; a list only changed through its methods always passes
+// Returns error describing the first violated invariant
+func (ll *LinkedList[T]) Validate() error {
+	if (ll.head == nil) != (ll.size == 0) || (ll.tail == nil) != (ll.size == 0) {
+		return fmt.Errorf("%w: head and tail do not match size %d", ErrInvariantViolated, ll.size)
+	}
+	count, last := 0, (*node[T])(nil)
+	cursorFound := ll.cursor == nil
+	for cur := ll.head; cur != nil; cur = cur.next {
+		if count == ll.size {
+			return fmt.Errorf("%w: more than %d nodes reachable from head", ErrInvariantViolated, ll.size)
+		}
+		if cur == ll.cursor {
+			if count != ll.cursorIndex {
+				return fmt.Errorf("%w: cursor at index %d recorded as %d", ErrInvariantViolated, count, ll.cursorIndex)
+			}
+			cursorFound = true
+		}
+		count, last = count+1, cur
+	}
+	if count != ll.size {
+		return fmt.Errorf("%w: %d nodes reachable from head, size %d", ErrInvariantViolated, count, ll.size)
+	}
+	if last != ll.tail {
+		return fmt.Errorf("%w: tail is not the last node reachable from head", ErrInvariantViolated)
+	}
+	if !cursorFound {
+		return fmt.Errorf("%w: cursor is not in the list", ErrInvariantViolated)
+	}
+	return nil
+}
+
+// Validate checks the internal invariants of the unrolled linked list: the nodes are linked
+// consistently in both directions, none is empty or over capacity, and they hold size elements
+// It is meant for tests and debugging; a list only changed through its methods always passes
+// Returns error describing the first violated invariant
+func (ul *UnrolledLinkedList[T]) Validate() error {
+	count, prev := 0, (*unrolledNode[T])(nil)
+	for n := ul.head; n != nil; n = n.next {
+		if n.prev != prev {
+			return fmt.Errorf("%w: node after %d elements has a wrong prev link", ErrInvariantViolated, count)
+		}
+		if len(n.elements) == 0 || len(n.elements) > ul.nodeCapacity {
+			return fmt.Errorf("%w: node holds %d elements, node capacity %d",
+				ErrInvariantViolated, len(n.elements), ul.nodeCapacity)
+		}
+		count += len(n.elements)
+		if count > ul.size {
+			return fmt.Errorf("%w: more than %d elements reachable from head", ErrInvariantViolated, ul.size)
+		}
+		prev = n
+	}
+	if count != ul.size {
+		return fmt.Errorf("%w: %d elements reachable from head, size %d", ErrInvariantViolated, count, ul.size)
+	}
+	if prev != ul.tail {
+		return fmt.Errorf("%w: tail is not the last node reachable from head", ErrInvariantViolated)
+	}
+	return nil
+}
+
+// Validate checks that the elements of the sorted list are in comparator order
+// It is meant for tests and debugging, such as catching a comparator that is not a total order
+// Returns error describing the first violated invariant
+func (sl *SortedList[T]) Validate() error {
+	for i := 1; i < len(sl.elements); i++ {
+		if sl.cmp(sl.elements[i-1], sl.elements[i]) > 0 {
+			return fmt.Errorf("%w: elements %d and %d are out of order", ErrInvariantViolated, i-1, i)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package list
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+type validator interface {
+	List[int]
+	Validate() error
+}
+
+func TestListValidate(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	for _, l := range []validator{NewArrayList[int](), NewLinkedList[int](), NewUnrolledLinkedListWithNodeCapacity[int](4)} {
+		for step := 0; step < 1000; step++ {
+			switch op := r.Intn(8); {
+			case op == 0 && l.Size() > 0:
+				l.Remove(r.Intn(l.Size()))
+			case op == 1:
+				l.RemoveFirst()
+			case op == 2:
+				l.Get(r.Intn(l.Size() + 1))
+			case op == 3 && r.Intn(20) == 0:
+				l.Clear()
+			case op == 4:
+				l.Reverse()
+			default:
+				l.Add(r.Intn(l.Size()+1), step)
+			}
+			if err := l.Validate(); err != nil {
+				t.Fatalf("%T step %d: %v", l, step, err)
+			}
+		}
+	}
+
+	sl := NewSortedListFromSlice([]int{5, 1, 3}, func(a, b int) int { return a - b })
+	if err := sl.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sl.elements[0] = 9
+	if err := sl.Validate(); !errors.Is(err, ErrInvariantViolated) {
+		t.Fatalf("expected ErrInvariantViolated got %v", err)
+	}
+}
+
+func TestListValidateDetectsCorruption(t *testing.T) {
+	al := NewArrayListFromSlice([]int{1, 2, 3})
+	al.size = 10
+	if err := al.Validate(); !errors.Is(err, ErrInvariantViolated) {
+		t.Fatalf("expected ErrInvariantViolated got %v", err)
+	}
+
+	ll := NewLinkedListFromSlice([]int{1, 2, 3})
+	ll.tail.next = ll.head
+	if err := ll.Validate(); !errors.Is(err, ErrInvariantViolated) {
+		t.Fatalf("expected a cycle to be detected got %v", err)
+	}
+	ll = NewLinkedListFromSlice([]int{1, 2, 3})
+	ll.tail = ll.head
+	if err := ll.Validate(); !errors.Is(err, ErrInvariantViolated) {
+		t.Fatalf("expected a wrong tail to be detected got %v", err)
+	}
+
+	ul := NewUnrolledLinkedListWithNodeCapacity[int](2)
+	for i := 0; i < 6; i++ {
+		ul.AddLast(i)
+	}
+	ul.tail.prev = nil
+	if err := ul.Validate(); !errors.Is(err, ErrInvariantViolated) {
+		t.Fatalf("expected a broken prev link to be detected got %v", err)
+	}
+}
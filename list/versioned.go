@@ -0,0 +1,307 @@
+package list
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrUnknownVersion = errors.New("version is not in the list history")
+)
+
+type editKind int
+
+const (
+	editInsert editKind = iota
+	editRemove
+	editSet
+	editClear
+	editReverse
+)
+
+// edit is one journaled change together with what is needed to revert it
+type edit[T any] struct {
+	kind    editKind
+	version int
+	index   int
+	elem    T
+	old     T
+	// cleared holds the elements removed by a Clear
+	cleared []T
+}
+
+// VersionedList wraps a list and journals every change so it can be undone, redone,
+// or rolled to any earlier version
+// Making a change after an undo discards the undone changes and the versions they created
+// The journal keeps every change since creation, including the elements removed by Clear
+// The backing list must only be changed through the wrapper
+type VersionedList[T any] struct {
+	inner List[T]
+	edits []edit[T]
+	// applied is the number of edits currently reflected in inner, the rest can be redone
+	applied int
+	// latest is the highest version handed out, so versions are never reused
+	latest int
+}
+
+var _ List[int] = (*VersionedList[int])(nil)
+
+// NewVersionedList creates a versioned list over inner, whose current elements are version 0
+func NewVersionedList[T any](inner List[T]) *VersionedList[T] {
+	return &VersionedList[T]{inner: inner}
+}
+
+// Snapshot returns the current version, which RestoreTo can return to later
+func (vl *VersionedList[T]) Snapshot() int {
+	if vl.applied == 0 {
+		return 0
+	}
+	return vl.edits[vl.applied-1].version
+}
+
+// CanUndo checks if there is a change to undo
+func (vl *VersionedList[T]) CanUndo() bool {
+	return vl.applied > 0
+}
+
+// CanRedo checks if there is an undone change to redo
+func (vl *VersionedList[T]) CanRedo() bool {
+	return vl.applied < len(vl.edits)
+}
+
+// Undo reverts the most recent change
+// Returns false if there is nothing to undo
+func (vl *VersionedList[T]) Undo() bool {
+	if !vl.CanUndo() {
+		return false
+	}
+	vl.applied--
+	vl.revert(vl.edits[vl.applied])
+	return true
+}
+
+// Redo applies the most recently undone change again
+// Returns false if there is nothing to redo
+func (vl *VersionedList[T]) Redo() bool {
+	if !vl.CanRedo() {
+		return false
+	}
+	vl.apply(vl.edits[vl.applied])
+	vl.applied++
+	return true
+}
+
+// RestoreTo undoes or redoes changes until the list is at version
+// Returns error if version was discarded by a change after an undo or never existed
+func (vl *VersionedList[T]) RestoreTo(version int) error {
+	target := -1
+	if version == 0 {
+		target = 0
+	}
+	for i, e := range vl.edits {
+		if e.version == version {
+			target = i + 1
+			break
+		}
+	}
+	if target < 0 {
+		return fmt.Errorf("%w: %d", ErrUnknownVersion, version)
+	}
+	for vl.applied > target {
+		vl.Undo()
+	}
+	for vl.applied < target {
+		vl.Redo()
+	}
+	return nil
+}
+
+// record journals a change that was just made, discarding any undone changes
+func (vl *VersionedList[T]) record(e edit[T]) {
+	clear(vl.edits[vl.applied:])
+	vl.latest++
+	e.version = vl.latest
+	vl.edits = append(vl.edits[:vl.applied], e)
+	vl.applied++
+}
+
+func (vl *VersionedList[T]) apply(e edit[T]) {
+	switch e.kind {
+	case editInsert:
+		vl.inner.Add(e.index, e.elem)
+	case editRemove:
+		vl.inner.Remove(e.index)
+	case editSet:
+		vl.inner.Set(e.index, e.elem)
+	case editClear:
+		vl.inner.Clear()
+	case editReverse:
+		vl.inner.Reverse()
+	}
+}
+
+func (vl *VersionedList[T]) revert(e edit[T]) {
+	switch e.kind {
+	case editInsert:
+		vl.inner.Remove(e.index)
+	case editRemove:
+		vl.inner.Add(e.index, e.elem)
+	case editSet:
+		vl.inner.Set(e.index, e.old)
+	case editClear:
+		for _, v := range e.cleared {
+			vl.inner.AddLast(v)
+		}
+	case editReverse:
+		vl.inner.Reverse()
+	}
+}
+
+// Size returns the number of elements in the list
+func (vl *VersionedList[T]) Size() int {
+	return vl.inner.Size()
+}
+
+// IsEmpty checks if the list is empty
+func (vl *VersionedList[T]) IsEmpty() bool {
+	return vl.inner.IsEmpty()
+}
+
+// AddLast adds an element to the end of the list
+func (vl *VersionedList[T]) AddLast(elem T) {
+	index := vl.inner.Size()
+	vl.inner.AddLast(elem)
+	vl.record(edit[T]{kind: editInsert, index: index, elem: elem})
+}
+
+// Add inserts an element at the specified index position
+// Returns error if index is out of bounds
+func (vl *VersionedList[T]) Add(index int, elem T) error {
+	if err := vl.inner.Add(index, elem); err != nil {
+		return err
+	}
+	vl.record(edit[T]{kind: editInsert, index: index, elem: elem})
+	return nil
+}
+
+// Get returns the element at the specified index position
+// Returns error if index is out of bounds
+func (vl *VersionedList[T]) Get(index int) (T, error) {
+	return vl.inner.Get(index)
+}
+
+// GetFirst returns the first element of the list
+// Returns error if list is empty
+func (vl *VersionedList[T]) GetFirst() (T, error) {
+	return vl.inner.GetFirst()
+}
+
+// GetLast returns the last element of the list
+// Returns error if list is empty
+func (vl *VersionedList[T]) GetLast() (T, error) {
+	return vl.inner.GetLast()
+}
+
+// Set replaces the element at the specified index position
+// Returns error if index is out of bounds
+func (vl *VersionedList[T]) Set(index int, elem T) error {
+	old, err := vl.inner.Get(index)
+	if err != nil {
+		return err
+	}
+	if err := vl.inner.Set(index, elem); err != nil {
+		return err
+	}
+	vl.record(edit[T]{kind: editSet, index: index, elem: elem, old: old})
+	return nil
+}
+
+// Remove deletes the element at the specified index position and returns its value
+// Returns error if index is out of bounds
+func (vl *VersionedList[T]) Remove(index int) (T, error) {
+	removed, err := vl.inner.Remove(index)
+	if err != nil {
+		return removed, err
+	}
+	vl.record(edit[T]{kind: editRemove, index: index, elem: removed})
+	return removed, nil
+}
+
+// RemoveFirst deletes and returns the first element of the list
+// Returns error if list is empty
+func (vl *VersionedList[T]) RemoveFirst() (T, error) {
+	if vl.inner.IsEmpty() {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	return vl.Remove(0)
+}
+
+// RemoveLast deletes and returns the last element of the list
+// Returns error if list is empty
+func (vl *VersionedList[T]) RemoveLast() (T, error) {
+	if vl.inner.IsEmpty() {
+		var zero T
+		return zero, ErrEmptyList
+	}
+	return vl.Remove(vl.inner.Size() - 1)
+}
+
+// RemoveElement deletes the first occurrence of the specified element from the list
+// Returns true if element was found and removed, false otherwise
+func (vl *VersionedList[T]) RemoveElement(elem T) bool {
+	index := vl.inner.IndexOf(elem)
+	if index < 0 {
+		return false
+	}
+	_, err := vl.Remove(index)
+	return err == nil
+}
+
+// Contains checks if the list contains the specified element
+func (vl *VersionedList[T]) Contains(elem T) bool {
+	return vl.inner.Contains(elem)
+}
+
+// IndexOf returns the first index of the specified element in the list
+// Returns -1 if element is not found
+func (vl *VersionedList[T]) IndexOf(elem T) int {
+	return vl.inner.IndexOf(elem)
+}
+
+// Clear removes all elements from the list, keeping them in the journal so Undo can restore them
+// Clearing an empty list is not a change
+func (vl *VersionedList[T]) Clear() {
+	if vl.inner.IsEmpty() {
+		return
+	}
+	cleared := vl.inner.ToSlice()
+	vl.inner.Clear()
+	vl.record(edit[T]{kind: editClear, cleared: cleared})
+}
+
+// ToSlice converts the list to a slice
+func (vl *VersionedList[T]) ToSlice() []T {
+	return vl.inner.ToSlice()
+}
+
+// AppendTo appends the elements of the list to dst and returns the extended slice
+func (vl *VersionedList[T]) AppendTo(dst []T) []T {
+	return vl.inner.AppendTo(dst)
+}
+
+// Reverse reverses the list
+func (vl *VersionedList[T]) Reverse() {
+	vl.inner.Reverse()
+	vl.record(edit[T]{kind: editReverse})
+}
+
+// Join renders the elements of the list separated by sep
+func (vl *VersionedList[T]) Join(sep string) string {
+	return vl.inner.Join(sep)
+}
+
+// String returns a string representation of the list
+func (vl *VersionedList[T]) String() string {
+	return vl.inner.String()
+}
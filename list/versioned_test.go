@@ -0,0 +1,87 @@
+package list
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestVersionedListUndoRedo(t *testing.T) {
+	vl := NewVersionedList[string](NewArrayListFromSlice([]string{"a"}))
+	if vl.Undo() || vl.Redo() {
+		t.Fatalf("expected nothing to undo or redo")
+	}
+
+	vl.AddLast("b")
+	start := vl.Snapshot()
+	vl.Set(0, "A")
+	vl.Add(1, "x")
+	vl.RemoveFirst()
+	vl.Reverse()
+	assertElements[string](t, vl, []string{"b", "x"})
+	end := vl.Snapshot()
+
+	vl.Clear()
+	vl.Clear()
+	if !vl.Undo() {
+		t.Fatalf("expected to undo the clear")
+	}
+	assertElements[string](t, vl, []string{"b", "x"})
+
+	if err := vl.RestoreTo(start); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertElements[string](t, vl, []string{"a", "b"})
+	if err := vl.RestoreTo(end); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertElements[string](t, vl, []string{"b", "x"})
+	vl.RestoreTo(0)
+	assertElements[string](t, vl, []string{"a"})
+
+	// A change after an undo discards the undone versions
+	vl.Redo()
+	vl.AddLast("c")
+	if vl.CanRedo() {
+		t.Fatalf("expected the redo history to be discarded")
+	}
+	if err := vl.RestoreTo(end); !errors.Is(err, ErrUnknownVersion) {
+		t.Fatalf("expected ErrUnknownVersion got %v", err)
+	}
+	if vl.Snapshot() <= end {
+		t.Fatalf("expected a fresh version got %d", vl.Snapshot())
+	}
+	assertElements[string](t, vl, []string{"a", "b", "c"})
+}
+
+func TestVersionedListMatchesHistory(t *testing.T) {
+	r := rand.New(rand.NewSource(5))
+	vl := NewVersionedList[int](NewLinkedList[int]())
+	var versions []int
+	var states [][]int
+	for step := 0; step < 500; step++ {
+		switch op := r.Intn(6); {
+		case op == 0 && vl.Size() > 0:
+			vl.Remove(r.Intn(vl.Size()))
+		case op == 1 && vl.Size() > 0:
+			vl.Set(r.Intn(vl.Size()), step)
+		case op == 2 && r.Intn(10) == 0:
+			vl.Clear()
+		case op == 3:
+			vl.Reverse()
+		default:
+			vl.Add(r.Intn(vl.Size()+1), step)
+		}
+		versions = append(versions, vl.Snapshot())
+		states = append(states, vl.ToSlice())
+	}
+	for _, i := range r.Perm(len(versions)) {
+		if err := vl.RestoreTo(versions[i]); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := vl.ToSlice(); !slices.Equal(got, states[i]) {
+			t.Fatalf("version %d: expected %v got %v", versions[i], states[i], got)
+		}
+	}
+}
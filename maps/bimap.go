@@ -0,0 +1,217 @@
+package maps
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/profoundwu/containers/pair"
+)
+
+var (
+	ErrDuplicateValue = errors.New("value already bound to another key")
+)
+
+// BiMap is a bijective map that keeps keys and values unique
+// and supports lookups in both directions
+type BiMap[K comparable, V comparable] struct {
+	forward  map[K]V
+	backward map[V]K
+	hooks    Hooks[K, V]
+}
+
+// NewBiMap creates a new empty bidirectional map
+func NewBiMap[K comparable, V comparable]() *BiMap[K, V] {
+	return &BiMap[K, V]{
+		forward:  make(map[K]V),
+		backward: make(map[V]K),
+	}
+}
+
+// NewBiMapWithHooks creates a new empty bidirectional map that reports every change to hooks
+// A pair dropped by ForcePut is reported through OnRemove before the new pair is added
+func NewBiMapWithHooks[K comparable, V comparable](hooks Hooks[K, V]) *BiMap[K, V] {
+	m := NewBiMap[K, V]()
+	m.hooks = hooks
+	return m
+}
+
+// Size returns the number of key-value pairs in the map
+func (m *BiMap[K, V]) Size() int {
+	return len(m.forward)
+}
+
+// IsEmpty checks if the map is empty
+func (m *BiMap[K, V]) IsEmpty() bool {
+	return len(m.forward) == 0
+}
+
+// Put binds key to value, replacing any value previously bound to key
+// Returns error if value is already bound to a different key
+func (m *BiMap[K, V]) Put(key K, value V) error {
+	if k, ok := m.backward[value]; ok && k != key {
+		return fmt.Errorf("%w: %v is bound to %v", ErrDuplicateValue, value, k)
+	}
+	m.ForcePut(key, value)
+	return nil
+}
+
+// ForcePut binds key to value, silently dropping any pair that used either of them
+func (m *BiMap[K, V]) ForcePut(key K, value V) {
+	if old, ok := m.forward[key]; ok {
+		if old == value {
+			return
+		}
+		delete(m.backward, old)
+		m.hooks.removed(key, old)
+	}
+	if k, ok := m.backward[value]; ok {
+		delete(m.forward, k)
+		m.hooks.removed(k, value)
+	}
+	m.forward[key] = value
+	m.backward[value] = key
+	m.hooks.added(key, value)
+}
+
+// GetByKey returns the value bound to key
+// Returns false if the key is not present
+func (m *BiMap[K, V]) GetByKey(key K) (V, bool) {
+	v, ok := m.forward[key]
+	return v, ok
+}
+
+// GetByValue returns the key bound to value
+// Returns false if the value is not present
+func (m *BiMap[K, V]) GetByValue(value V) (K, bool) {
+	k, ok := m.backward[value]
+	return k, ok
+}
+
+// ContainsKey checks if the map contains the specified key
+func (m *BiMap[K, V]) ContainsKey(key K) bool {
+	_, ok := m.forward[key]
+	return ok
+}
+
+// ContainsValue checks if the map contains the specified value
+func (m *BiMap[K, V]) ContainsValue(value V) bool {
+	_, ok := m.backward[value]
+	return ok
+}
+
+// RemoveByKey deletes the pair with the specified key and returns its value
+// Returns false if the key is not present
+func (m *BiMap[K, V]) RemoveByKey(key K) (V, bool) {
+	v, ok := m.forward[key]
+	if ok {
+		delete(m.forward, key)
+		delete(m.backward, v)
+		m.hooks.removed(key, v)
+	}
+	return v, ok
+}
+
+// RemoveByValue deletes the pair with the specified value and returns its key
+// Returns false if the value is not present
+func (m *BiMap[K, V]) RemoveByValue(value V) (K, bool) {
+	k, ok := m.backward[value]
+	if ok {
+		delete(m.backward, value)
+		delete(m.forward, k)
+		m.hooks.removed(k, value)
+	}
+	return k, ok
+}
+
+// Inverse returns a view of the map with keys and values swapped
+// The view shares storage and hooks with this map, so changes to either are visible in both
+func (m *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	return &BiMap[V, K]{
+		forward:  m.backward,
+		backward: m.forward,
+		hooks:    m.hooks.inverse(),
+	}
+}
+
+// Keys returns a sequence of the keys in unspecified order
+func (m *BiMap[K, V]) Keys() iter.Seq[K] {
+	return keys(m.forward)
+}
+
+// Values returns a sequence of the values in unspecified order
+func (m *BiMap[K, V]) Values() iter.Seq[V] {
+	return keys(m.backward)
+}
+
+// Entries returns all key-value pairs in unspecified order
+func (m *BiMap[K, V]) Entries() []pair.Pair[K, V] {
+	entries := make([]pair.Pair[K, V], 0, len(m.forward))
+	for k, v := range m.forward {
+		entries = append(entries, pair.New(k, v))
+	}
+	return entries
+}
+
+// Clear removes all pairs from the map
+// Inverse views observe the cleared state as well
+func (m *BiMap[K, V]) Clear() {
+	for k := range m.forward {
+		delete(m.forward, k)
+	}
+	for v := range m.backward {
+		delete(m.backward, v)
+	}
+	m.hooks.cleared()
+}
+
+// String returns a string representation of the map in unspecified order
+func (m *BiMap[K, V]) String() string {
+	var sb strings.Builder
+	sb.WriteString("{")
+
+	i := 0
+	for k, v := range m.forward {
+		sb.WriteString(fmt.Sprintf("%v: %v", k, v))
+		if i < len(m.forward)-1 {
+			sb.WriteString(", ")
+		}
+		i++
+	}
+
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// MarshalText implements encoding.TextMarshaler with the String rendering
+func (m *BiMap[K, V]) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// replace swaps in the pairs of forward after checking that its values are unique
+func (m *BiMap[K, V]) replace(forward map[K]V) error {
+	if forward == nil {
+		forward = make(map[K]V)
+	}
+	backward := make(map[V]K, len(forward))
+	for k, v := range forward {
+		if other, ok := backward[v]; ok {
+			return fmt.Errorf("%w: %v is bound to %v and %v", ErrDuplicateValue, v, other, k)
+		}
+		backward[v] = k
+	}
+
+	if m.forward == nil {
+		m.forward, m.backward = forward, backward
+		return nil
+	}
+	// Refill in place so inverse views keep observing this map
+	m.Clear()
+	for k, v := range forward {
+		m.forward[k] = v
+		m.backward[v] = k
+		m.hooks.added(k, v)
+	}
+	return nil
+}
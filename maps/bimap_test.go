@@ -0,0 +1,179 @@
+package maps
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestNewBiMap(t *testing.T) {
+	m := NewBiMap[string, int]()
+	if m.Size() != 0 || !m.IsEmpty() {
+		t.Fatalf("expected empty bimap")
+	}
+	if m.String() != "{}" {
+		t.Fatalf("unexpected string %s", m)
+	}
+}
+
+func TestBiMapPutAndLookup(t *testing.T) {
+	m := NewBiMap[string, int]()
+	if err := m.Put("one", 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := m.Put("two", 2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v, ok := m.GetByKey("one"); !ok || v != 1 {
+		t.Fatalf("expected 1 got %d ok=%v", v, ok)
+	}
+	if k, ok := m.GetByValue(2); !ok || k != "two" {
+		t.Fatalf("expected two got %s ok=%v", k, ok)
+	}
+	if err := m.Put("uno", 1); !errors.Is(err, ErrDuplicateValue) {
+		t.Fatalf("expected ErrDuplicateValue got %v", err)
+	}
+	if err := m.Put("one", 1); err != nil {
+		t.Fatalf("re-putting the same pair should succeed: %v", err)
+	}
+
+	// rebinding a key frees its old value
+	if err := m.Put("one", 11); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if m.ContainsValue(1) || !m.ContainsValue(11) {
+		t.Fatalf("old value should be released after rebinding")
+	}
+	if m.Size() != 2 {
+		t.Fatalf("expected size 2 got %d", m.Size())
+	}
+}
+
+func TestBiMapForcePut(t *testing.T) {
+	m := NewBiMap[string, int]()
+	m.ForcePut("a", 1)
+	m.ForcePut("b", 2)
+	m.ForcePut("c", 1) // steals value 1 from a
+	if m.ContainsKey("a") {
+		t.Fatalf("a should be dropped when its value is forced onto c")
+	}
+	m.ForcePut("b", 1) // steals value 1 from c and frees 2
+	if m.ContainsKey("c") || m.ContainsValue(2) {
+		t.Fatalf("unexpected contents %s", m)
+	}
+	if k, _ := m.GetByValue(1); k != "b" || m.Size() != 1 {
+		t.Fatalf("expected only b=1 got %s", m)
+	}
+}
+
+func TestBiMapRemove(t *testing.T) {
+	m := NewBiMap[string, int]()
+	m.ForcePut("a", 1)
+	m.ForcePut("b", 2)
+	if v, ok := m.RemoveByKey("a"); !ok || v != 1 {
+		t.Fatalf("expected removal of a=1 got %d ok=%v", v, ok)
+	}
+	if m.ContainsValue(1) {
+		t.Fatalf("value should be removed with its key")
+	}
+	if k, ok := m.RemoveByValue(2); !ok || k != "b" {
+		t.Fatalf("expected removal of b=2 got %s ok=%v", k, ok)
+	}
+	if _, ok := m.RemoveByKey("missing"); ok {
+		t.Fatalf("should not remove absent key")
+	}
+	if _, ok := m.RemoveByValue(42); ok {
+		t.Fatalf("should not remove absent value")
+	}
+	if !m.IsEmpty() {
+		t.Fatalf("expected empty bimap")
+	}
+}
+
+func TestBiMapInverse(t *testing.T) {
+	m := NewBiMap[string, int]()
+	m.ForcePut("a", 1)
+	inv := m.Inverse()
+	if k, ok := inv.GetByKey(1); !ok || k != "a" {
+		t.Fatalf("inverse lookup failed got %s ok=%v", k, ok)
+	}
+	if err := inv.Put(2, "b"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v, ok := m.GetByKey("b"); !ok || v != 2 {
+		t.Fatalf("write through inverse should be visible in original")
+	}
+	if err := inv.Put(3, "a"); !errors.Is(err, ErrDuplicateValue) {
+		t.Fatalf("inverse should enforce uniqueness got %v", err)
+	}
+	m.Clear()
+	if !inv.IsEmpty() {
+		t.Fatalf("clear should be visible through inverse")
+	}
+}
+
+func TestBiMapKeysValues(t *testing.T) {
+	m := NewBiMap[string, int]()
+	m.ForcePut("a", 1)
+	m.ForcePut("b", 2)
+	keys, values := slices.Sorted(m.Keys()), slices.Sorted(m.Values())
+	if !slices.Equal(keys, []string{"a", "b"}) || !slices.Equal(values, []int{1, 2}) {
+		t.Fatalf("expected [a b] / [1 2] got %v / %v", keys, values)
+	}
+	n := 0
+	for range m.Inverse().Keys() {
+		n++
+		break
+	}
+	if n != 1 {
+		t.Fatalf("expected the sequence to stop early got %d", n)
+	}
+	single := NewBiMap[string, int]()
+	single.ForcePut("x", 9)
+	if single.String() != "{x: 9}" {
+		t.Fatalf("unexpected string %s", single)
+	}
+}
+
+func TestBiMapEntries(t *testing.T) {
+	m := NewBiMap[string, int]()
+	m.Put("one", 1)
+	m.Put("two", 2)
+	entries := m.Entries()
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries got %d", len(entries))
+	}
+	for _, e := range entries {
+		if k, _ := m.GetByValue(e.Value()); k != e.Key() {
+			t.Fatalf("entry %v does not match the map", e)
+		}
+	}
+}
+
+func TestBiMapHooks(t *testing.T) {
+	var events []string
+	m := NewBiMapWithHooks(Hooks[string, int]{
+		OnAdd:    func(k string, v int) { events = append(events, fmt.Sprintf("+%s=%d", k, v)) },
+		OnRemove: func(k string, v int) { events = append(events, fmt.Sprintf("-%s=%d", k, v)) },
+		OnClear:  func() { events = append(events, "clear") },
+	})
+
+	m.Put("a", 1)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	if err := m.Put("c", 2); err == nil {
+		t.Fatalf("expected ErrDuplicateValue")
+	}
+	m.ForcePut("a", 2)
+	m.Inverse().RemoveByKey(2)
+	m.Put("d", 4)
+	m.Inverse().Put(5, "e")
+	m.RemoveByValue(9)
+	m.Clear()
+
+	want := []string{"+a=1", "+b=2", "-a=1", "-b=2", "+a=2", "-a=2", "+d=4", "+e=5", "clear"}
+	if !slices.Equal(events, want) {
+		t.Fatalf("expected %v got %v", want, events)
+	}
+}
@@ -0,0 +1,82 @@
+package maps
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"slices"
+)
+
+// MarshalBinary encodes the map with encoding/gob
+// Keys and values must be encodable by gob
+func (m *BiMap[K, V]) MarshalBinary() ([]byte, error) {
+	return encodeGob(m.forward)
+}
+
+// UnmarshalBinary replaces the map with one decoded from data produced by MarshalBinary
+// Returns error if two keys share a value, leaving the map unchanged
+func (m *BiMap[K, V]) UnmarshalBinary(data []byte) error {
+	var forward map[K]V
+	if err := decodeGob(data, &forward); err != nil {
+		return err
+	}
+	return m.replace(forward)
+}
+
+// binaryCounter is the gob payload behind Counter.MarshalBinary, in first-counted order
+type binaryCounter[T comparable] struct {
+	Elems  []T
+	Counts []int
+}
+
+// MarshalBinary encodes the counter with encoding/gob, keeping the order in which elements were
+// first counted so ties in MostCommon survive the round trip
+// Elements must be encodable by gob
+func (c *Counter[T]) MarshalBinary() ([]byte, error) {
+	elems := c.firstCounted()
+	payload := binaryCounter[T]{Elems: elems, Counts: make([]int, len(elems))}
+	for i, elem := range elems {
+		payload.Counts[i] = c.entries[elem].count
+	}
+	return encodeGob(payload)
+}
+
+// UnmarshalBinary replaces the counter with one decoded from data produced by MarshalBinary
+func (c *Counter[T]) UnmarshalBinary(data []byte) error {
+	var payload binaryCounter[T]
+	if err := decodeGob(data, &payload); err != nil {
+		return err
+	}
+	if len(payload.Elems) != len(payload.Counts) {
+		return fmt.Errorf("counter encoding has %d elements but %d counts", len(payload.Elems), len(payload.Counts))
+	}
+	c.Clear()
+	for i, elem := range payload.Elems {
+		c.Add(elem, payload.Counts[i])
+	}
+	return nil
+}
+
+// firstCounted returns the elements in the order they were first counted
+func (c *Counter[T]) firstCounted() []T {
+	elems := make([]T, 0, len(c.entries))
+	for elem := range c.entries {
+		elems = append(elems, elem)
+	}
+	slices.SortFunc(elems, func(a, b T) int {
+		return c.entries[a].seq - c.entries[b].seq
+	})
+	return elems
+}
+
+func encodeGob(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeGob(data []byte, v any) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
@@ -0,0 +1,36 @@
+package maps
+
+import "testing"
+
+func TestMapsBinary(t *testing.T) {
+	m := NewBiMap[string, int]()
+	m.Put("one", 1)
+	data, err := m.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var decoded BiMap[string, int]
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if k, ok := decoded.GetByValue(1); !ok || k != "one" {
+		t.Fatalf("unexpected decoded map %v", &decoded)
+	}
+
+	c := NewCounterFromSlice([]string{"b", "a", "c", "a"})
+	data, err = c.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var decodedCounter Counter[string]
+	if err := decodedCounter.UnmarshalBinary(data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Ties keep their first-counted order
+	if got := decodedCounter.String(); got != c.String() || got != "{a: 2, b: 1, c: 1}" {
+		t.Fatalf("expected {a: 2, b: 1, c: 1} got %s", got)
+	}
+	if err := decodedCounter.UnmarshalBinary([]byte("garbage")); err == nil {
+		t.Fatalf("expected an error for invalid data")
+	}
+}
@@ -0,0 +1,48 @@
+package maps
+
+import (
+	"slices"
+
+	"github.com/profoundwu/containers/internal/utils"
+)
+
+// DeepClone returns an independent copy of the map, where every value with a Clone method
+// returning its own type is replaced by its Clone
+// Other values are copied as they are, which is only independent for values without pointers
+func (m *HistoryMap[K, V]) DeepClone() *HistoryMap[K, V] {
+	return m.DeepCloneFunc(utils.CloneValue[V])
+}
+
+// DeepCloneFunc returns an independent copy of the map, with every retained value passed through clone
+func (m *HistoryMap[K, V]) DeepCloneFunc(clone func(V) V) *HistoryMap[K, V] {
+	c := &HistoryMap[K, V]{depth: m.depth, history: make(map[K]*historyRing[V], len(m.history)), now: m.now}
+	for k, r := range m.history {
+		versions := slices.Clone(r.versions)
+		for i := range r.count {
+			slot := (r.head + i) % len(versions)
+			versions[slot].Value = clone(versions[slot].Value)
+		}
+		c.history[k] = &historyRing[V]{versions: versions, head: r.head, count: r.count}
+	}
+	return c
+}
+
+// DeepClone returns an independent copy of the map, where every value with a Clone method
+// returning its own type is replaced by its Clone
+// Other values are copied as they are, which is only independent for values without pointers
+func (m *TemporalMap[K, V]) DeepClone() *TemporalMap[K, V] {
+	return m.DeepCloneFunc(utils.CloneValue[V])
+}
+
+// DeepCloneFunc returns an independent copy of the map, with every version's value passed through clone
+func (m *TemporalMap[K, V]) DeepCloneFunc(clone func(V) V) *TemporalMap[K, V] {
+	c := &TemporalMap[K, V]{history: make(map[K][]Version[V], len(m.history)), versions: m.versions}
+	for k, versions := range m.history {
+		copied := make([]Version[V], len(versions))
+		for i, v := range versions {
+			copied[i] = Version[V]{At: v.At, Value: clone(v.Value)}
+		}
+		c.history[k] = copied
+	}
+	return c
+}
@@ -0,0 +1,41 @@
+package maps
+
+import (
+	"testing"
+	"time"
+)
+
+type counter struct{ n int }
+
+func (c *counter) Clone() *counter {
+	return &counter{n: c.n}
+}
+
+func TestMapDeepClone(t *testing.T) {
+	hm := NewHistoryMap[string, *counter](2)
+	for i := 0; i < 3; i++ {
+		hm.Put("k", &counter{n: i})
+	}
+	hc := hm.DeepClone()
+	latest, _ := hm.Get("k")
+	latest.n = 100
+	hm.Put("k", &counter{n: 7})
+	history := hc.GetHistory("k")
+	if len(history) != 2 || history[0].Value.n != 1 || history[1].Value.n != 2 {
+		t.Fatalf("expected an independent history [1 2] got %+v %+v", history[0].Value, history[1].Value)
+	}
+
+	tm := NewTemporalMap[string, *counter]()
+	at := time.Unix(0, 0)
+	tm.Put("k", &counter{n: 1}, at)
+	tc := tm.DeepClone()
+	v, _ := tm.Get("k")
+	v.n = 5
+	if got, _ := tc.Get("k"); got.n != 1 || tc.Versions() != 1 {
+		t.Fatalf("expected an independent copy got %+v", got)
+	}
+	shared := tm.DeepCloneFunc(func(c *counter) *counter { return c })
+	if got, _ := shared.Get("k"); got != v {
+		t.Fatalf("expected the clone func to decide how values are copied")
+	}
+}
@@ -0,0 +1,69 @@
+package maps
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/profoundwu/containers/codec"
+	"github.com/profoundwu/containers/pair"
+)
+
+// WriteAll streams every pair to w, each key encoded with key followed by its value encoded with value,
+// in unspecified order
+// Returns error naming the position of the first pair that failed
+func (m *BiMap[K, V]) WriteAll(w io.Writer, key codec.Codec[K], value codec.Codec[V]) error {
+	c := codec.Pair(key, value)
+	i := 0
+	for k, v := range m.forward {
+		if err := c.Encode(w, pair.New(k, v)); err != nil {
+			return fmt.Errorf("element %d: %w", i, err)
+		}
+		i++
+	}
+	return nil
+}
+
+// ReadAll decodes pairs written by WriteAll from r until it ends and puts them into the map
+// Returns the number of pairs put, and error naming the position of the first pair that failed
+// to decode or whose value is already mapped to another key
+func (m *BiMap[K, V]) ReadAll(r io.Reader, key codec.Codec[K], value codec.Codec[V]) (int, error) {
+	c := codec.Pair(key, value)
+	for i := 0; ; i++ {
+		p, err := c.Decode(r)
+		if errors.Is(err, io.EOF) {
+			return i, nil
+		}
+		if err == nil {
+			err = m.Put(p.Unpack())
+		}
+		if err != nil {
+			return i, fmt.Errorf("element %d: %w", i, err)
+		}
+	}
+}
+
+// counts encodes the counts streamed by Counter.WriteAll
+var counts, _ = codec.Binary[int64]()
+
+// WriteAll streams every element encoded with c, each followed by its count, in the order the
+// elements were first counted
+// Returns error naming the position of the first element that failed
+func (c *Counter[T]) WriteAll(w io.Writer, elem codec.Codec[T]) error {
+	return codec.WriteSeq(w, func(yield func(pair.Pair[T, int64]) bool) {
+		for _, e := range c.firstCounted() {
+			if !yield(pair.New(e, int64(c.entries[e].count))) {
+				return
+			}
+		}
+	}, codec.Pair(elem, counts))
+}
+
+// ReadAll decodes elements and counts written by WriteAll from r until it ends, adding them to the counter
+// Returns the number of elements read, and error naming the position of the first element that failed
+func (c *Counter[T]) ReadAll(r io.Reader, elem codec.Codec[T]) (int, error) {
+	return codec.ReadSeq(r, codec.Pair(elem, counts), func(p pair.Pair[T, int64]) error {
+		c.Add(p.Key(), int(p.Value()))
+		return nil
+	})
+}
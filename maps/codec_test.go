@@ -0,0 +1,55 @@
+package maps
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/profoundwu/containers/codec"
+)
+
+func TestBiMapWriteAllReadAll(t *testing.T) {
+	ints, _ := codec.Binary[int64]()
+	src := NewBiMap[string, int64]()
+	src.Put("one", 1)
+	src.Put("two", 2)
+
+	var buf bytes.Buffer
+	if err := src.WriteAll(&buf, codec.String(), ints); err != nil {
+		t.Fatalf("expected no error got %v", err)
+	}
+	data := buf.Bytes()
+
+	dst := NewBiMap[string, int64]()
+	n, err := dst.ReadAll(bytes.NewReader(data), codec.String(), ints)
+	if err != nil || n != 2 {
+		t.Fatalf("expected 2 pairs got %d %v", n, err)
+	}
+	if k, _ := dst.GetByValue(2); k != "two" || dst.Size() != 2 {
+		t.Fatalf("unexpected map %s", dst)
+	}
+
+	clash := NewBiMap[string, int64]()
+	clash.Put("uno", 1)
+	clash.Put("dos", 2)
+	if _, err := clash.ReadAll(bytes.NewReader(data), codec.String(), ints); !errors.Is(err, ErrDuplicateValue) {
+		t.Fatalf("expected ErrDuplicateValue got %v", err)
+	}
+}
+
+func TestCounterWriteAllReadAll(t *testing.T) {
+	src := NewCounterFromSlice([]string{"b", "a", "b", "c", "a", "b"})
+	var buf bytes.Buffer
+	if err := src.WriteAll(&buf, codec.String()); err != nil {
+		t.Fatalf("expected no error got %v", err)
+	}
+
+	dst := NewCounterFromSlice([]string{"a"})
+	n, err := dst.ReadAll(&buf, codec.String())
+	if err != nil || n != 3 {
+		t.Fatalf("expected 3 elements got %d %v", n, err)
+	}
+	if dst.Count("a") != 3 || dst.Count("b") != 3 || dst.Count("c") != 1 || dst.Total() != 7 {
+		t.Fatalf("unexpected counts %s", dst)
+	}
+}
@@ -0,0 +1,216 @@
+package maps
+
+import (
+	"container/heap"
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/profoundwu/containers/pair"
+)
+
+type counterEntry struct {
+	count int
+	seq   int
+}
+
+// Counter is a multiset that tallies how many times each element was seen
+// Elements whose count drops to zero or below are removed
+type Counter[T comparable] struct {
+	entries map[T]*counterEntry
+	total   int
+	seq     int
+}
+
+// NewCounter creates a new empty counter
+func NewCounter[T comparable]() *Counter[T] {
+	return &Counter[T]{entries: make(map[T]*counterEntry)}
+}
+
+// NewCounterFromSlice creates a counter tallying every element of a slice
+func NewCounterFromSlice[T comparable](slice []T) *Counter[T] {
+	c := NewCounter[T]()
+	for _, v := range slice {
+		c.Increment(v)
+	}
+	return c
+}
+
+// NewCounterFromSeq creates a counter tallying every value of seq
+func NewCounterFromSeq[T comparable](seq iter.Seq[T]) *Counter[T] {
+	c := NewCounter[T]()
+	for v := range seq {
+		c.Increment(v)
+	}
+	return c
+}
+
+// Size returns the number of distinct elements in the counter
+func (c *Counter[T]) Size() int {
+	return len(c.entries)
+}
+
+// IsEmpty checks if the counter is empty
+func (c *Counter[T]) IsEmpty() bool {
+	return len(c.entries) == 0
+}
+
+// Total returns the sum of all counts
+func (c *Counter[T]) Total() int {
+	return c.total
+}
+
+// Increment adds one to the count of elem and returns the new count
+func (c *Counter[T]) Increment(elem T) int {
+	return c.Add(elem, 1)
+}
+
+// Add adds n to the count of elem and returns the new count
+// A count that ends up at zero or below removes the element
+func (c *Counter[T]) Add(elem T, n int) int {
+	e, ok := c.entries[elem]
+	if !ok {
+		if n <= 0 {
+			return 0
+		}
+		e = &counterEntry{seq: c.seq}
+		c.seq++
+		c.entries[elem] = e
+	}
+
+	if e.count+n <= 0 {
+		c.total -= e.count
+		delete(c.entries, elem)
+		return 0
+	}
+	e.count += n
+	c.total += n
+	return e.count
+}
+
+// Subtract removes n from the count of elem and returns the new count
+// A count that ends up at zero or below removes the element
+func (c *Counter[T]) Subtract(elem T, n int) int {
+	return c.Add(elem, -n)
+}
+
+// Count returns how many times elem was counted, or 0 if it is absent
+func (c *Counter[T]) Count(elem T) int {
+	if e, ok := c.entries[elem]; ok {
+		return e.count
+	}
+	return 0
+}
+
+// Contains checks if elem has a positive count
+func (c *Counter[T]) Contains(elem T) bool {
+	_, ok := c.entries[elem]
+	return ok
+}
+
+// Remove deletes elem regardless of its count
+// Returns true if the element was found and removed, false otherwise
+func (c *Counter[T]) Remove(elem T) bool {
+	e, ok := c.entries[elem]
+	if !ok {
+		return false
+	}
+	c.total -= e.count
+	delete(c.entries, elem)
+	return true
+}
+
+// Clear removes all elements from the counter
+func (c *Counter[T]) Clear() {
+	c.entries = make(map[T]*counterEntry)
+	c.total = 0
+	c.seq = 0
+}
+
+// MostCommon returns the n elements with the highest counts paired with their counts, most common first
+// Elements with equal counts are ordered by when they were first counted
+func (c *Counter[T]) MostCommon(n int) []pair.Pair[T, int] {
+	if n <= 0 {
+		return []pair.Pair[T, int]{}
+	}
+
+	h := &countHeap[T]{}
+	for elem, e := range c.entries {
+		item := countItem[T]{elem: elem, count: e.count, seq: e.seq}
+		if h.Len() < n {
+			heap.Push(h, item)
+		} else if h.less(h.items[0], item) {
+			h.items[0] = item
+			heap.Fix(h, 0)
+		}
+	}
+
+	result := make([]pair.Pair[T, int], h.Len())
+	for i := len(result) - 1; i >= 0; i-- {
+		item := heap.Pop(h).(countItem[T])
+		result[i] = pair.New(item.elem, item.count)
+	}
+	return result
+}
+
+// SortedPairs returns every element with its count, most common first
+func (c *Counter[T]) SortedPairs() []pair.Pair[T, int] {
+	return c.MostCommon(len(c.entries))
+}
+
+// String returns a string representation of the counter, most common first
+func (c *Counter[T]) String() string {
+	var sb strings.Builder
+	sb.WriteString("{")
+
+	pairs := c.SortedPairs()
+	for i, p := range pairs {
+		sb.WriteString(fmt.Sprintf("%v: %d", p.Key(), p.Value()))
+		if i < len(pairs)-1 {
+			sb.WriteString(", ")
+		}
+	}
+
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// MarshalText implements encoding.TextMarshaler with the String rendering
+func (c *Counter[T]) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+type countItem[T comparable] struct {
+	elem  T
+	count int
+	seq   int
+}
+
+// countHeap is a min-heap keeping the least common of the current top candidates at the root
+type countHeap[T comparable] struct {
+	items []countItem[T]
+}
+
+// less reports whether a ranks below b: a lower count, or a later first sighting on ties
+func (h *countHeap[T]) less(a, b countItem[T]) bool {
+	if a.count != b.count {
+		return a.count < b.count
+	}
+	return a.seq > b.seq
+}
+
+func (h *countHeap[T]) Len() int { return len(h.items) }
+
+func (h *countHeap[T]) Less(i, j int) bool { return h.less(h.items[i], h.items[j]) }
+
+func (h *countHeap[T]) Swap(i, j int) { h.items[i], h.items[j] = h.items[j], h.items[i] }
+
+func (h *countHeap[T]) Push(x any) { h.items = append(h.items, x.(countItem[T])) }
+
+func (h *countHeap[T]) Pop() any {
+	n := len(h.items)
+	item := h.items[n-1]
+	h.items[n-1] = countItem[T]{}
+	h.items = h.items[:n-1]
+	return item
+}
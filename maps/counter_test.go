@@ -0,0 +1,99 @@
+package maps
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNewCounterFromSlice(t *testing.T) {
+	c := NewCounterFromSlice([]string{"a", "b", "a", "c", "a", "b"})
+	if c.Size() != 3 || c.Total() != 6 {
+		t.Fatalf("expected 3 distinct and 6 total got %d/%d", c.Size(), c.Total())
+	}
+	if c.Count("a") != 3 || c.Count("b") != 2 || c.Count("z") != 0 {
+		t.Fatalf("unexpected counts %s", c)
+	}
+	if NewCounter[int]().String() != "{}" || !NewCounter[int]().IsEmpty() {
+		t.Fatalf("expected empty counter")
+	}
+}
+
+func TestNewCounterFromSeq(t *testing.T) {
+	c := NewCounterFromSeq(slices.Values([]int{4, 4, 5}))
+	if c.Count(4) != 2 || c.Count(5) != 1 || c.Total() != 3 {
+		t.Fatalf("unexpected counts %s", c)
+	}
+}
+
+func TestCounterMarshalText(t *testing.T) {
+	c := NewCounterFromSlice([]string{"x", "x"})
+	text, err := c.MarshalText()
+	if err != nil || string(text) != c.String() {
+		t.Fatalf("expected %s got %s, %v", c, text, err)
+	}
+}
+
+func TestCounterAddAndSubtract(t *testing.T) {
+	c := NewCounter[string]()
+	if c.Increment("x") != 1 || c.Add("x", 4) != 5 {
+		t.Fatalf("unexpected count after add got %d", c.Count("x"))
+	}
+	if c.Subtract("x", 2) != 3 || c.Total() != 3 {
+		t.Fatalf("unexpected count after subtract got %d total %d", c.Count("x"), c.Total())
+	}
+	if c.Subtract("x", 10) != 0 || c.Contains("x") {
+		t.Fatalf("element should be dropped when its count reaches zero")
+	}
+	if c.Total() != 0 {
+		t.Fatalf("expected total 0 got %d", c.Total())
+	}
+	if c.Add("y", -1) != 0 || c.Contains("y") {
+		t.Fatalf("negative add on absent element should be a no-op")
+	}
+}
+
+func TestCounterRemoveAndClear(t *testing.T) {
+	c := NewCounterFromSlice([]int{1, 1, 2})
+	if !c.Remove(1) || c.Remove(1) {
+		t.Fatalf("expected a single successful removal")
+	}
+	if c.Total() != 1 {
+		t.Fatalf("expected total 1 got %d", c.Total())
+	}
+	c.Clear()
+	if !c.IsEmpty() || c.Total() != 0 {
+		t.Fatalf("expected empty counter after clear")
+	}
+}
+
+func TestCounterMostCommon(t *testing.T) {
+	c := NewCounterFromSlice([]string{"a", "b", "c", "b", "c", "d", "c", "e", "e", "e"})
+	top := c.MostCommon(2)
+	if len(top) != 2 {
+		t.Fatalf("expected 2 results got %v", top)
+	}
+	// c and e tie at 3, c was seen first
+	if top[0].Key() != "c" || top[0].Value() != 3 || top[1].Key() != "e" {
+		t.Fatalf("unexpected most common %v", top)
+	}
+	if len(c.MostCommon(0)) != 0 {
+		t.Fatalf("expected no results for n=0")
+	}
+	if len(c.MostCommon(100)) != c.Size() {
+		t.Fatalf("expected all elements when n exceeds size")
+	}
+}
+
+func TestCounterSortedPairs(t *testing.T) {
+	c := NewCounterFromSlice([]string{"x", "y", "y", "z", "z", "z"})
+	pairs := c.SortedPairs()
+	expected := []string{"z", "y", "x"}
+	for i, e := range expected {
+		if pairs[i].Key() != e {
+			t.Fatalf("sorted pairs mismatch got %v", pairs)
+		}
+	}
+	if c.String() != "{z: 3, y: 2, x: 1}" {
+		t.Fatalf("unexpected string %s", c)
+	}
+}
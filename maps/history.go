@@ -0,0 +1,145 @@
+package maps
+
+import (
+	"iter"
+	"time"
+
+	"github.com/profoundwu/containers/internal/utils"
+)
+
+// historyRing holds the most recent versions of one key in a fixed size ring
+type historyRing[V any] struct {
+	versions []Version[V]
+	head     int // index of the oldest version
+	count    int
+}
+
+func (r *historyRing[V]) push(v Version[V]) {
+	if r.count < len(r.versions) {
+		r.versions[(r.head+r.count)%len(r.versions)] = v
+		r.count++
+		return
+	}
+	r.versions[r.head] = v
+	r.head = (r.head + 1) % len(r.versions)
+}
+
+func (r *historyRing[V]) at(i int) Version[V] {
+	return r.versions[(r.head+i)%len(r.versions)]
+}
+
+// HistoryMap keeps the last few values written to every key along with when they were written
+// Older values fall off a key's history once it holds depth entries, so memory per key is bounded
+type HistoryMap[K comparable, V any] struct {
+	depth   int
+	history map[K]*historyRing[V]
+
+	now func() time.Time
+}
+
+// NewHistoryMap creates a new empty history map keeping up to depth values per key
+// Values of depth below 1 fall back to the default capacity
+func NewHistoryMap[K comparable, V any](depth int) *HistoryMap[K, V] {
+	if depth < 1 {
+		depth = utils.DefaultCapacity
+	}
+	return &HistoryMap[K, V]{depth: depth, history: make(map[K]*historyRing[V]), now: time.Now}
+}
+
+// Size returns the number of keys in the map
+func (m *HistoryMap[K, V]) Size() int {
+	return len(m.history)
+}
+
+// IsEmpty checks if the map is empty
+func (m *HistoryMap[K, V]) IsEmpty() bool {
+	return len(m.history) == 0
+}
+
+// Depth returns the maximum number of values kept per key
+func (m *HistoryMap[K, V]) Depth() int {
+	return m.depth
+}
+
+// Put records value as the latest value of key, timestamped now
+func (m *HistoryMap[K, V]) Put(key K, value V) {
+	m.PutAt(key, value, m.now())
+}
+
+// PutAt records value as the latest value of key with the given timestamp
+// Values are kept in the order they were put, whatever their timestamps
+func (m *HistoryMap[K, V]) PutAt(key K, value V, at time.Time) {
+	r, ok := m.history[key]
+	if !ok {
+		r = &historyRing[V]{versions: make([]Version[V], m.depth)}
+		m.history[key] = r
+	}
+	r.push(Version[V]{At: at, Value: value})
+}
+
+// Get returns the latest value of key
+// Returns false if the key is not present
+func (m *HistoryMap[K, V]) Get(key K) (V, bool) {
+	r, ok := m.history[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return r.at(r.count - 1).Value, true
+}
+
+// GetHistory returns the retained values of key, oldest first
+// Returns nil if the key is not present
+func (m *HistoryMap[K, V]) GetHistory(key K) []Version[V] {
+	r, ok := m.history[key]
+	if !ok {
+		return nil
+	}
+	history := make([]Version[V], r.count)
+	for i := range history {
+		history[i] = r.at(i)
+	}
+	return history
+}
+
+// GetSince returns the retained values of key recorded at or after since, oldest first
+func (m *HistoryMap[K, V]) GetSince(key K, since time.Time) []Version[V] {
+	var result []Version[V]
+	for _, v := range m.GetHistory(key) {
+		if !v.At.Before(since) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// Remove deletes key and its whole history
+// Returns true if the key was found and removed, false otherwise
+func (m *HistoryMap[K, V]) Remove(key K) bool {
+	if _, ok := m.history[key]; !ok {
+		return false
+	}
+	delete(m.history, key)
+	return true
+}
+
+// Keys returns a sequence of the keys in unspecified order
+func (m *HistoryMap[K, V]) Keys() iter.Seq[K] {
+	return keys(m.history)
+}
+
+// Values returns a sequence of the latest value of every key in unspecified order
+func (m *HistoryMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, r := range m.history {
+			if !yield(r.at(r.count - 1).Value) {
+				return
+			}
+		}
+	}
+}
+
+// Clear removes all keys and their histories
+func (m *HistoryMap[K, V]) Clear() {
+	clear(m.history)
+}
@@ -0,0 +1,74 @@
+package maps
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestHistoryMapBounded(t *testing.T) {
+	clock := time.Unix(100, 0)
+	m := NewHistoryMap[string, int](3)
+	m.now = func() time.Time { return clock }
+
+	for i := 1; i <= 5; i++ {
+		m.Put("user", i)
+		clock = clock.Add(time.Second)
+	}
+	m.Put("other", 42)
+
+	if m.Size() != 2 || m.Depth() != 3 {
+		t.Fatalf("expected size 2 depth 3 got %d %d", m.Size(), m.Depth())
+	}
+	if v, ok := m.Get("user"); !ok || v != 5 {
+		t.Fatalf("expected 5 got %d %v", v, ok)
+	}
+
+	history := m.GetHistory("user")
+	if len(history) != 3 {
+		t.Fatalf("expected 3 versions got %d", len(history))
+	}
+	for i, v := range history {
+		if v.Value != i+3 || !v.At.Equal(time.Unix(int64(102+i), 0)) {
+			t.Fatalf("unexpected version %d: %+v", i, v)
+		}
+	}
+
+	since := m.GetSince("user", time.Unix(103, 0))
+	if len(since) != 2 || since[0].Value != 4 {
+		t.Fatalf("expected versions 4 and 5 got %+v", since)
+	}
+	if m.GetHistory("missing") != nil {
+		t.Fatalf("expected nil history for a missing key")
+	}
+	if _, ok := m.Get("missing"); ok {
+		t.Fatalf("expected missing key")
+	}
+}
+
+func TestHistoryMapRemoveAndClear(t *testing.T) {
+	m := NewHistoryMap[int, string](0)
+	m.PutAt(1, "a", time.Unix(5, 0))
+	m.PutAt(1, "b", time.Unix(1, 0))
+	if h := m.GetHistory(1); len(h) != 2 || h[1].Value != "b" {
+		t.Fatalf("expected insertion order to be kept got %+v", h)
+	}
+	if v, _ := m.Get(1); v != "b" {
+		t.Fatalf("expected b got %s", v)
+	}
+
+	m.PutAt(2, "c", time.Unix(0, 0))
+	if !m.Remove(1) || m.Remove(1) || m.Size() != 1 {
+		t.Fatalf("unexpected Remove results")
+	}
+	if keys := slices.Collect(m.Keys()); len(keys) != 1 || keys[0] != 2 {
+		t.Fatalf("expected [2] got %v", keys)
+	}
+	if values := slices.Collect(m.Values()); !slices.Equal(values, []string{"c"}) {
+		t.Fatalf("expected the latest values [c] got %v", values)
+	}
+	m.Clear()
+	if !m.IsEmpty() {
+		t.Fatalf("expected empty map after Clear")
+	}
+}
@@ -0,0 +1,42 @@
+package maps
+
+// Hooks are callbacks a map runs after each change to its pairs
+// Nil callbacks are skipped; the callbacks must not change the map they observe
+type Hooks[K any, V any] struct {
+	// OnAdd runs for every pair that joins the map
+	OnAdd func(key K, value V)
+	// OnRemove runs for every pair that leaves the map, except through Clear
+	OnRemove func(key K, value V)
+	// OnClear runs once after Clear instead of OnRemove for each pair
+	OnClear func()
+}
+
+func (h Hooks[K, V]) added(key K, value V) {
+	if h.OnAdd != nil {
+		h.OnAdd(key, value)
+	}
+}
+
+func (h Hooks[K, V]) removed(key K, value V) {
+	if h.OnRemove != nil {
+		h.OnRemove(key, value)
+	}
+}
+
+func (h Hooks[K, V]) cleared() {
+	if h.OnClear != nil {
+		h.OnClear()
+	}
+}
+
+// inverse returns the hooks with key and value swapped, for an inverse view
+func (h Hooks[K, V]) inverse() Hooks[V, K] {
+	inv := Hooks[V, K]{OnClear: h.OnClear}
+	if h.OnAdd != nil {
+		inv.OnAdd = func(value V, key K) { h.OnAdd(key, value) }
+	}
+	if h.OnRemove != nil {
+		inv.OnRemove = func(value V, key K) { h.OnRemove(key, value) }
+	}
+	return inv
+}
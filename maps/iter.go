@@ -0,0 +1,14 @@
+package maps
+
+import "iter"
+
+// keys returns a sequence of the keys of m in unspecified order
+func keys[K comparable, V any](m map[K]V) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package maps
+
+import "encoding/json"
+
+// MarshalJSON encodes the map as a JSON object from keys to values
+// Keys must be strings, integers or implement encoding.TextMarshaler
+func (m *BiMap[K, V]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.forward)
+}
+
+// UnmarshalJSON replaces the contents of the map with the pairs of a JSON object
+// Returns error if two keys share a value, leaving the map unchanged
+func (m *BiMap[K, V]) UnmarshalJSON(data []byte) error {
+	var forward map[K]V
+	if err := json.Unmarshal(data, &forward); err != nil {
+		return err
+	}
+	return m.replace(forward)
+}
+
+// MarshalJSON encodes the counter as a JSON object from elements to counts
+// Elements must be strings, integers or implement encoding.TextMarshaler
+func (c *Counter[T]) MarshalJSON() ([]byte, error) {
+	counts := make(map[T]int, len(c.entries))
+	for elem, e := range c.entries {
+		counts[elem] = e.count
+	}
+	return json.Marshal(counts)
+}
+
+// UnmarshalJSON replaces the contents of the counter with the counts of a JSON object
+// Counts of zero or below are dropped, and elements with equal counts get an arbitrary order
+func (c *Counter[T]) UnmarshalJSON(data []byte) error {
+	var counts map[T]int
+	if err := json.Unmarshal(data, &counts); err != nil {
+		return err
+	}
+	c.Clear()
+	for elem, n := range counts {
+		c.Add(elem, n)
+	}
+	return nil
+}
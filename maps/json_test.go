@@ -0,0 +1,63 @@
+package maps
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestBiMapJSON(t *testing.T) {
+	m := NewBiMap[string, int]()
+	m.Put("one", 1)
+	m.Put("two", 2)
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != `{"one":1,"two":2}` {
+		t.Fatalf(`expected {"one":1,"two":2} got %s`, data)
+	}
+
+	var decoded *BiMap[string, int]
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if k, ok := decoded.GetByValue(2); !ok || k != "two" || decoded.Size() != 2 {
+		t.Fatalf("unexpected decoded map %v", decoded)
+	}
+
+	inverse := m.Inverse()
+	if err := json.Unmarshal([]byte(`{"three":3}`), m); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if k, ok := inverse.GetByKey(3); !ok || k != "three" || inverse.Size() != 1 {
+		t.Fatalf("expected the inverse view to follow the decoded map got %v", inverse)
+	}
+
+	err = json.Unmarshal([]byte(`{"a":1,"b":1}`), m)
+	if !errors.Is(err, ErrDuplicateValue) {
+		t.Fatalf("expected ErrDuplicateValue got %v", err)
+	}
+	if !m.ContainsKey("three") || m.Size() != 1 {
+		t.Fatalf("expected a failed decode to leave the map unchanged got %v", m)
+	}
+}
+
+func TestCounterJSON(t *testing.T) {
+	c := NewCounterFromSlice([]string{"a", "b", "a"})
+	data, err := json.Marshal(c)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != `{"a":2,"b":1}` {
+		t.Fatalf(`expected {"a":2,"b":1} got %s`, data)
+	}
+
+	var decoded Counter[string]
+	if err := json.Unmarshal([]byte(`{"x":3,"y":0,"z":1}`), &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decoded.Count("x") != 3 || decoded.Contains("y") || decoded.Total() != 4 {
+		t.Fatalf("unexpected decoded counter %v", &decoded)
+	}
+}
@@ -0,0 +1,221 @@
+package maps
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/profoundwu/containers/pair"
+)
+
+var (
+	ErrDuplicateKey = errors.New("duplicate key")
+	ErrBuildFailed  = errors.New("could not find a perfect hash for the key set")
+)
+
+// maxDisplacementSeed bounds the seed search for a single bucket
+const maxDisplacementSeed = 1 << 20
+
+// PerfectMap is an immutable map over a fixed set of string keys using a minimal perfect hash
+// Every key maps to its own slot, so a lookup costs two hashes and one comparison
+type PerfectMap[V any] struct {
+	seeds  []int32
+	keys   []string
+	values []V
+}
+
+// PerfectMapBuilder accumulates key-value pairs for a PerfectMap
+type PerfectMapBuilder[V any] struct {
+	keys   []string
+	values []V
+}
+
+// NewPerfectMapBuilder creates a new empty perfect map builder
+func NewPerfectMapBuilder[V any]() *PerfectMapBuilder[V] {
+	return &PerfectMapBuilder[V]{}
+}
+
+// Add queues a key-value pair for the map being built
+func (b *PerfectMapBuilder[V]) Add(key string, value V) *PerfectMapBuilder[V] {
+	b.keys = append(b.keys, key)
+	b.values = append(b.values, value)
+	return b
+}
+
+// Size returns the number of pairs queued in the builder
+func (b *PerfectMapBuilder[V]) Size() int {
+	return len(b.keys)
+}
+
+// Build computes the perfect hash for the queued keys and returns the frozen map
+// Returns error if a key was added twice or no perfect hash could be found
+func (b *PerfectMapBuilder[V]) Build() (*PerfectMap[V], error) {
+	n := len(b.keys)
+	pm := &PerfectMap[V]{
+		seeds:  make([]int32, n),
+		keys:   make([]string, n),
+		values: make([]V, n),
+	}
+	if n == 0 {
+		return pm, nil
+	}
+
+	buckets := make([][]int, n)
+	seen := make(map[string]bool, n)
+	for i, k := range b.keys {
+		if seen[k] {
+			return nil, fmt.Errorf("%w: %q", ErrDuplicateKey, k)
+		}
+		seen[k] = true
+		bucket := perfectHash(0, k) % uint64(n)
+		buckets[bucket] = append(buckets[bucket], i)
+	}
+
+	// Place the largest buckets first while most slots are still free
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, c int) int {
+		return len(buckets[c]) - len(buckets[a])
+	})
+
+	occupied := make([]bool, n)
+	freeSlots := make([]int, 0, n)
+	for _, bucket := range order {
+		items := buckets[bucket]
+		if len(items) == 0 {
+			break
+		}
+		if len(items) == 1 {
+			// Singletons point straight at a free slot through a negative seed
+			if len(freeSlots) == 0 {
+				for slot := n - 1; slot >= 0; slot-- {
+					if !occupied[slot] {
+						freeSlots = append(freeSlots, slot)
+					}
+				}
+			}
+			slot := freeSlots[len(freeSlots)-1]
+			freeSlots = freeSlots[:len(freeSlots)-1]
+			pm.place(slot, b.keys[items[0]], b.values[items[0]], occupied)
+			pm.seeds[bucket] = -int32(slot) - 1
+			continue
+		}
+
+		slots, seed, ok := findSeed(b.keys, items, occupied)
+		if !ok {
+			return nil, ErrBuildFailed
+		}
+		for j, i := range items {
+			pm.place(slots[j], b.keys[i], b.values[i], occupied)
+		}
+		pm.seeds[bucket] = seed
+	}
+	return pm, nil
+}
+
+// findSeed searches for a seed that sends every key of a bucket to distinct free slots
+func findSeed(keys []string, items []int, occupied []bool) ([]int, int32, bool) {
+	n := uint64(len(occupied))
+	slots := make([]int, len(items))
+	for seed := int32(1); seed < maxDisplacementSeed; seed++ {
+		ok := true
+		for j, i := range items {
+			slot := int(perfectHash(uint64(seed), keys[i]) % n)
+			if occupied[slot] || slices.Contains(slots[:j], slot) {
+				ok = false
+				break
+			}
+			slots[j] = slot
+		}
+		if ok {
+			return slots, seed, true
+		}
+	}
+	return nil, 0, false
+}
+
+// place stores a pair in slot
+func (pm *PerfectMap[V]) place(slot int, key string, value V, occupied []bool) {
+	occupied[slot] = true
+	pm.keys[slot] = key
+	pm.values[slot] = value
+}
+
+// Size returns the number of pairs in the map
+func (pm *PerfectMap[V]) Size() int {
+	return len(pm.keys)
+}
+
+// IsEmpty checks if the map is empty
+func (pm *PerfectMap[V]) IsEmpty() bool {
+	return len(pm.keys) == 0
+}
+
+// Get returns the value stored for key
+// Returns false if the key is not in the map
+func (pm *PerfectMap[V]) Get(key string) (V, bool) {
+	slot, ok := pm.slot(key)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return pm.values[slot], true
+}
+
+// Contains checks if the map contains the specified key
+func (pm *PerfectMap[V]) Contains(key string) bool {
+	_, ok := pm.slot(key)
+	return ok
+}
+
+// Keys returns a sequence of the keys in slot order
+func (pm *PerfectMap[V]) Keys() iter.Seq[string] {
+	return slices.Values(pm.keys)
+}
+
+// Values returns a sequence of the values in slot order
+func (pm *PerfectMap[V]) Values() iter.Seq[V] {
+	return slices.Values(pm.values)
+}
+
+// Entries returns all key-value pairs in slot order
+func (pm *PerfectMap[V]) Entries() []pair.Pair[string, V] {
+	entries := make([]pair.Pair[string, V], len(pm.keys))
+	for i, k := range pm.keys {
+		entries[i] = pair.New(k, pm.values[i])
+	}
+	return entries
+}
+
+// slot resolves the slot holding key
+func (pm *PerfectMap[V]) slot(key string) (int, bool) {
+	n := uint64(len(pm.keys))
+	if n == 0 {
+		return 0, false
+	}
+
+	var slot int
+	seed := pm.seeds[perfectHash(0, key)%n]
+	if seed < 0 {
+		slot = int(-seed - 1)
+	} else {
+		slot = int(perfectHash(uint64(seed), key) % n)
+	}
+	return slot, pm.keys[slot] == key
+}
+
+// perfectHash is a seeded FNV-1a hash with a final avalanche step
+func perfectHash(seed uint64, key string) uint64 {
+	h := uint64(14695981039346656037) ^ (seed * 0x9e3779b97f4a7c15)
+	for i := 0; i < len(key); i++ {
+		h ^= uint64(key[i])
+		h *= 1099511628211
+	}
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	return h
+}
@@ -0,0 +1,93 @@
+package maps
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestPerfectMapEmpty(t *testing.T) {
+	pm, err := NewPerfectMapBuilder[int]().Build()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !pm.IsEmpty() || pm.Contains("a") {
+		t.Fatalf("expected empty map")
+	}
+}
+
+func TestPerfectMapLookup(t *testing.T) {
+	codes := map[string]string{
+		"US": "United States", "FR": "France", "DE": "Germany", "JP": "Japan",
+		"CN": "China", "BR": "Brazil", "IN": "India", "GB": "United Kingdom",
+	}
+	b := NewPerfectMapBuilder[string]()
+	for k, v := range codes {
+		b.Add(k, v)
+	}
+	if b.Size() != len(codes) {
+		t.Fatalf("expected builder size %d got %d", len(codes), b.Size())
+	}
+	pm, err := b.Build()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pm.Size() != len(codes) {
+		t.Fatalf("expected size %d got %d", len(codes), pm.Size())
+	}
+	for k, want := range codes {
+		got, ok := pm.Get(k)
+		if !ok || got != want {
+			t.Fatalf("lookup %s expected %s got %s ok=%v", k, want, got, ok)
+		}
+	}
+	for _, missing := range []string{"", "XX", "us", "USA"} {
+		if pm.Contains(missing) {
+			t.Fatalf("unexpected hit for %q", missing)
+		}
+	}
+	if keys := slices.Collect(pm.Keys()); len(keys) != len(codes) || len(slices.Collect(pm.Values())) != len(codes) {
+		t.Fatalf("expected %d keys got %d", len(codes), len(keys))
+	}
+}
+
+func TestPerfectMapLargeKeySet(t *testing.T) {
+	b := NewPerfectMapBuilder[int]()
+	for i := 0; i < 5000; i++ {
+		b.Add(fmt.Sprintf("key-%d", i), i)
+	}
+	pm, err := b.Build()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i := 0; i < 5000; i++ {
+		v, ok := pm.Get(fmt.Sprintf("key-%d", i))
+		if !ok || v != i {
+			t.Fatalf("lookup key-%d expected %d got %d ok=%v", i, i, v, ok)
+		}
+	}
+	if pm.Contains("key-5000") {
+		t.Fatalf("unexpected hit for key outside the set")
+	}
+}
+
+func TestPerfectMapDuplicateKey(t *testing.T) {
+	_, err := NewPerfectMapBuilder[int]().Add("a", 1).Add("b", 2).Add("a", 3).Build()
+	if !errors.Is(err, ErrDuplicateKey) {
+		t.Fatalf("expected ErrDuplicateKey got %v", err)
+	}
+}
+
+func TestPerfectMapEntries(t *testing.T) {
+	pm, _ := NewPerfectMapBuilder[int]().Add("a", 1).Add("b", 2).Add("c", 3).Build()
+	entries := pm.Entries()
+	if len(entries) != 3 {
+		t.Fatalf("expected 3 entries got %d", len(entries))
+	}
+	for _, e := range entries {
+		if v, _ := pm.Get(e.Key()); v != e.Value() {
+			t.Fatalf("entry %v does not match the map", e)
+		}
+	}
+}
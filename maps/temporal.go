@@ -0,0 +1,143 @@
+package maps
+
+import (
+	"iter"
+	"slices"
+	"sort"
+	"time"
+)
+
+// Version is a value recorded for a key, effective from At until the key's next version
+type Version[V any] struct {
+	At    time.Time
+	Value V
+}
+
+// TemporalMap keeps the full history of every key so lookups can ask what a key held at any point in time
+// Versions may be recorded out of order; each key's history is kept sorted by timestamp
+type TemporalMap[K comparable, V any] struct {
+	history  map[K][]Version[V]
+	versions int
+}
+
+// NewTemporalMap creates a new empty temporal map
+func NewTemporalMap[K comparable, V any]() *TemporalMap[K, V] {
+	return &TemporalMap[K, V]{history: make(map[K][]Version[V])}
+}
+
+// Size returns the number of keys with at least one version
+func (m *TemporalMap[K, V]) Size() int {
+	return len(m.history)
+}
+
+// IsEmpty checks if the map is empty
+func (m *TemporalMap[K, V]) IsEmpty() bool {
+	return len(m.history) == 0
+}
+
+// Versions returns the number of versions stored across all keys
+func (m *TemporalMap[K, V]) Versions() int {
+	return m.versions
+}
+
+// Put records value as the value of key from at onwards
+// A version already recorded for key at exactly the same time is replaced
+func (m *TemporalMap[K, V]) Put(key K, value V, at time.Time) {
+	versions := m.history[key]
+	i := sort.Search(len(versions), func(i int) bool {
+		return !versions[i].At.Before(at)
+	})
+	if i < len(versions) && versions[i].At.Equal(at) {
+		versions[i].Value = value
+		return
+	}
+	m.history[key] = slices.Insert(versions, i, Version[V]{At: at, Value: value})
+	m.versions++
+}
+
+// Get returns the latest version of key
+// Returns false if the key has no versions
+func (m *TemporalMap[K, V]) Get(key K) (V, bool) {
+	versions := m.history[key]
+	if len(versions) == 0 {
+		var zero V
+		return zero, false
+	}
+	return versions[len(versions)-1].Value, true
+}
+
+// GetAsOf returns the value key held at time at, which is the latest version recorded at or before at
+// Returns false if the key has no version that old
+func (m *TemporalMap[K, V]) GetAsOf(key K, at time.Time) (V, bool) {
+	versions := m.history[key]
+	i := m.effective(versions, at)
+	if i < 0 {
+		var zero V
+		return zero, false
+	}
+	return versions[i].Value, true
+}
+
+// History returns every version of key, oldest first
+func (m *TemporalMap[K, V]) History(key K) []Version[V] {
+	return slices.Clone(m.history[key])
+}
+
+// Remove deletes the whole history of key
+// Returns true if the key existed, false otherwise
+func (m *TemporalMap[K, V]) Remove(key K) bool {
+	versions, ok := m.history[key]
+	if !ok {
+		return false
+	}
+	m.versions -= len(versions)
+	delete(m.history, key)
+	return true
+}
+
+// Prune drops versions that can no longer answer GetAsOf for times at or after before
+// The version effective at before is kept, so lookups from before onwards are unaffected
+// Returns the number of versions removed
+func (m *TemporalMap[K, V]) Prune(before time.Time) int {
+	removed := 0
+	for key, versions := range m.history {
+		i := m.effective(versions, before)
+		if i <= 0 {
+			continue
+		}
+		clear(versions[:i])
+		m.history[key] = versions[i:]
+		removed += i
+	}
+	m.versions -= removed
+	return removed
+}
+
+// Keys returns a sequence of every key with at least one version, in no particular order
+func (m *TemporalMap[K, V]) Keys() iter.Seq[K] {
+	return keys(m.history)
+}
+
+// Values returns a sequence of the latest version of every key, in no particular order
+func (m *TemporalMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, versions := range m.history {
+			if !yield(versions[len(versions)-1].Value) {
+				return
+			}
+		}
+	}
+}
+
+// Clear removes every key and its history
+func (m *TemporalMap[K, V]) Clear() {
+	clear(m.history)
+	m.versions = 0
+}
+
+// effective returns the index of the version in effect at time at, or -1 if there is none
+func (m *TemporalMap[K, V]) effective(versions []Version[V], at time.Time) int {
+	return sort.Search(len(versions), func(i int) bool {
+		return versions[i].At.After(at)
+	}) - 1
+}
@@ -0,0 +1,89 @@
+package maps
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+var epoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func day(n int) time.Time {
+	return epoch.AddDate(0, 0, n)
+}
+
+func TestTemporalMapGetAsOf(t *testing.T) {
+	m := NewTemporalMap[string, int]()
+	m.Put("price", 10, day(1))
+	m.Put("price", 30, day(10))
+	m.Put("price", 20, day(5))
+
+	cases := []struct {
+		at   time.Time
+		want int
+		ok   bool
+	}{
+		{day(0), 0, false},
+		{day(1), 10, true},
+		{day(4), 10, true},
+		{day(5), 20, true},
+		{day(9), 20, true},
+		{day(30), 30, true},
+	}
+	for _, c := range cases {
+		if v, ok := m.GetAsOf("price", c.at); v != c.want || ok != c.ok {
+			t.Fatalf("as of %v: got %d/%v want %d/%v", c.at, v, ok, c.want, c.ok)
+		}
+	}
+	if v, _ := m.Get("price"); v != 30 {
+		t.Fatalf("expected latest 30 got %d", v)
+	}
+	if _, ok := m.GetAsOf("missing", day(3)); ok {
+		t.Fatalf("unexpected hit for missing key")
+	}
+
+	m.Put("price", 11, day(1))
+	if m.Versions() != 3 {
+		t.Fatalf("same timestamp should replace, got %d versions", m.Versions())
+	}
+	history := m.History("price")
+	if len(history) != 3 || history[0].Value != 11 || !history[2].At.Equal(day(10)) {
+		t.Fatalf("unexpected history %v", history)
+	}
+}
+
+func TestTemporalMapPrune(t *testing.T) {
+	m := NewTemporalMap[string, string]()
+	m.Put("owner", "ann", day(1))
+	m.Put("owner", "bob", day(3))
+	m.Put("owner", "cyd", day(6))
+	m.Put("future", "x", day(8))
+
+	if removed := m.Prune(day(4)); removed != 1 {
+		t.Fatalf("expected 1 version pruned got %d", removed)
+	}
+	if v, _ := m.GetAsOf("owner", day(4)); v != "bob" {
+		t.Fatalf("version effective at the cutoff must survive got %s", v)
+	}
+	if _, ok := m.GetAsOf("owner", day(2)); ok {
+		t.Fatalf("pruned version should be gone")
+	}
+	if m.Versions() != 3 || m.Size() != 2 {
+		t.Fatalf("unexpected counts: %d versions, %d keys", m.Versions(), m.Size())
+	}
+
+	if !m.Remove("owner") || m.Remove("owner") {
+		t.Fatalf("expected a single successful remove")
+	}
+	if m.Versions() != 1 || len(slices.Collect(m.Keys())) != 1 {
+		t.Fatalf("unexpected counts after remove")
+	}
+	latest, _ := m.Get(slices.Collect(m.Keys())[0])
+	if values := slices.Collect(m.Values()); !slices.Equal(values, []string{latest}) {
+		t.Fatalf("expected the latest version %v got %v", latest, values)
+	}
+	m.Clear()
+	if !m.IsEmpty() || m.Versions() != 0 {
+		t.Fatalf("expected empty map after clear")
+	}
+}
@@ -0,0 +1,125 @@
+// Package metrics provides opt-in operation counters for the containers of this module
+// Containers only count when they are constructed with a *Counters, so uninstrumented containers
+// pay for a nil check at most
+package metrics
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// Metrics is a point in time copy of a container's counters
+type Metrics struct {
+	Adds       uint64
+	Removes    uint64
+	Lookups    uint64
+	Growths    uint64
+	Evictions  uint64
+	Collisions uint64
+}
+
+// Each calls fn with the name and value of every counter, in a fixed order
+// The names follow the Prometheus convention for counters, such as adds_total
+func (m Metrics) Each(fn func(name string, value uint64)) {
+	fn("adds_total", m.Adds)
+	fn("removes_total", m.Removes)
+	fn("lookups_total", m.Lookups)
+	fn("growths_total", m.Growths)
+	fn("evictions_total", m.Evictions)
+	fn("collisions_total", m.Collisions)
+}
+
+// Counters accumulates operation counts for one or more containers
+// It is safe to read from other goroutines while the containers count, and all methods
+// accept a nil receiver, which counts nothing
+type Counters struct {
+	adds       atomic.Uint64
+	removes    atomic.Uint64
+	lookups    atomic.Uint64
+	growths    atomic.Uint64
+	evictions  atomic.Uint64
+	collisions atomic.Uint64
+}
+
+// New creates a new set of counters starting at zero
+func New() *Counters {
+	return &Counters{}
+}
+
+// CountAdd records an element or entry joining a container
+func (c *Counters) CountAdd() {
+	if c != nil {
+		c.adds.Add(1)
+	}
+}
+
+// CountRemove records an element or entry leaving a container on request
+func (c *Counters) CountRemove() {
+	if c != nil {
+		c.removes.Add(1)
+	}
+}
+
+// CountLookup records a positional or keyed read
+func (c *Counters) CountLookup() {
+	if c != nil {
+		c.lookups.Add(1)
+	}
+}
+
+// CountGrowth records a reallocation of a container's storage
+func (c *Counters) CountGrowth() {
+	if c != nil {
+		c.growths.Add(1)
+	}
+}
+
+// CountEviction records an entry dropped to make room for another
+func (c *Counters) CountEviction() {
+	if c != nil {
+		c.evictions.Add(1)
+	}
+}
+
+// CountCollision records an item displaced from its slot by another, such as a cuckoo relocation
+func (c *Counters) CountCollision() {
+	if c != nil {
+		c.collisions.Add(1)
+	}
+}
+
+// Snapshot returns the current counts
+func (c *Counters) Snapshot() Metrics {
+	if c == nil {
+		return Metrics{}
+	}
+	return Metrics{
+		Adds:       c.adds.Load(),
+		Removes:    c.removes.Load(),
+		Lookups:    c.lookups.Load(),
+		Growths:    c.growths.Load(),
+		Evictions:  c.evictions.Load(),
+		Collisions: c.collisions.Load(),
+	}
+}
+
+// Reset sets every counter back to zero
+func (c *Counters) Reset() {
+	if c == nil {
+		return
+	}
+	c.adds.Store(0)
+	c.removes.Store(0)
+	c.lookups.Store(0)
+	c.growths.Store(0)
+	c.evictions.Store(0)
+	c.collisions.Store(0)
+}
+
+// String renders the current counts as a JSON object, which makes Counters an expvar.Var
+// that can be published with expvar.Publish
+func (c *Counters) String() string {
+	m := c.Snapshot()
+	return fmt.Sprintf(`{"adds": %d, "removes": %d, "lookups": %d, "growths": %d, "evictions": %d, "collisions": %d}`,
+		m.Adds, m.Removes, m.Lookups, m.Growths, m.Evictions, m.Collisions)
+}
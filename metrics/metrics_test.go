@@ -0,0 +1,59 @@
+package metrics
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+)
+
+func TestCounters(t *testing.T) {
+	var nilCounters *Counters
+	nilCounters.CountAdd()
+	if nilCounters.Snapshot() != (Metrics{}) {
+		t.Fatalf("expected nil counters to count nothing")
+	}
+
+	c := New()
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				c.CountAdd()
+				c.CountLookup()
+			}
+		}()
+	}
+	wg.Wait()
+	c.CountRemove()
+	c.CountGrowth()
+	c.CountEviction()
+	c.CountCollision()
+	c.CountCollision()
+
+	want := Metrics{Adds: 400, Removes: 1, Lookups: 400, Growths: 1, Evictions: 1, Collisions: 2}
+	if got := c.Snapshot(); got != want {
+		t.Fatalf("expected %+v got %+v", want, got)
+	}
+
+	var decoded map[string]uint64
+	if err := json.Unmarshal([]byte(c.String()), &decoded); err != nil || decoded["collisions"] != 2 {
+		t.Fatalf("expected a JSON rendering got %s %v", c.String(), err)
+	}
+	names := 0
+	c.Snapshot().Each(func(name string, value uint64) {
+		if name == "adds_total" && value != 400 {
+			t.Fatalf("expected adds_total 400 got %d", value)
+		}
+		names++
+	})
+	if names != 6 {
+		t.Fatalf("expected 6 counters got %d", names)
+	}
+
+	c.Reset()
+	if c.Snapshot() != (Metrics{}) {
+		t.Fatalf("expected zero counts after Reset got %+v", c.Snapshot())
+	}
+}
@@ -0,0 +1,87 @@
+// Package pair provides small tuple types shared by the map-like containers of this module
+package pair
+
+import "fmt"
+
+// Pair is an immutable key-value pair, used as the entry type of map-like containers
+type Pair[K any, V any] struct {
+	key   K
+	value V
+}
+
+// New creates a pair of key and value
+func New[K any, V any](key K, value V) Pair[K, V] {
+	return Pair[K, V]{key: key, value: value}
+}
+
+// Key returns the first element of the pair
+func (p Pair[K, V]) Key() K {
+	return p.key
+}
+
+// Value returns the second element of the pair
+func (p Pair[K, V]) Value() V {
+	return p.value
+}
+
+// Unpack returns both elements of the pair
+func (p Pair[K, V]) Unpack() (K, V) {
+	return p.key, p.value
+}
+
+// String returns a string representation of the pair
+func (p Pair[K, V]) String() string {
+	return fmt.Sprintf("(%v, %v)", p.key, p.value)
+}
+
+// Equals checks if two pairs hold equal keys and equal values
+func Equals[K comparable, V comparable](a, b Pair[K, V]) bool {
+	return a.key == b.key && a.value == b.value
+}
+
+// EqualsFunc checks if two pairs are equal using the given key and value equality functions
+func EqualsFunc[K any, V any](a, b Pair[K, V], keyEq func(x, y K) bool, valueEq func(x, y V) bool) bool {
+	return keyEq(a.key, b.key) && valueEq(a.value, b.value)
+}
+
+// Triple is an immutable group of three values
+type Triple[A any, B any, C any] struct {
+	first  A
+	second B
+	third  C
+}
+
+// NewTriple creates a triple of first, second and third
+func NewTriple[A any, B any, C any](first A, second B, third C) Triple[A, B, C] {
+	return Triple[A, B, C]{first: first, second: second, third: third}
+}
+
+// First returns the first element of the triple
+func (t Triple[A, B, C]) First() A {
+	return t.first
+}
+
+// Second returns the second element of the triple
+func (t Triple[A, B, C]) Second() B {
+	return t.second
+}
+
+// Third returns the third element of the triple
+func (t Triple[A, B, C]) Third() C {
+	return t.third
+}
+
+// Unpack returns all three elements of the triple
+func (t Triple[A, B, C]) Unpack() (A, B, C) {
+	return t.first, t.second, t.third
+}
+
+// String returns a string representation of the triple
+func (t Triple[A, B, C]) String() string {
+	return fmt.Sprintf("(%v, %v, %v)", t.first, t.second, t.third)
+}
+
+// TripleEquals checks if two triples hold equal elements
+func TripleEquals[A comparable, B comparable, C comparable](a, b Triple[A, B, C]) bool {
+	return a == b
+}
@@ -0,0 +1,42 @@
+package pair
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPair(t *testing.T) {
+	p := New("answer", 42)
+	if p.Key() != "answer" || p.Value() != 42 {
+		t.Fatalf("unexpected accessors %v", p)
+	}
+	k, v := p.Unpack()
+	if k != "answer" || v != 42 {
+		t.Fatalf("unexpected unpack %s %d", k, v)
+	}
+	if p.String() != "(answer, 42)" {
+		t.Fatalf("unexpected string %s", p)
+	}
+	if !Equals(p, New("answer", 42)) || Equals(p, New("answer", 41)) {
+		t.Fatalf("unexpected equality results")
+	}
+
+	a, b := New([]int{1}, "X"), New([]int{1}, "x")
+	sliceEq := func(x, y []int) bool { return len(x) == len(y) && x[0] == y[0] }
+	if !EqualsFunc(a, b, sliceEq, strings.EqualFold) {
+		t.Fatalf("expected pairs equal under custom equality")
+	}
+}
+
+func TestTriple(t *testing.T) {
+	tr := NewTriple(1, "two", 3.0)
+	if tr.First() != 1 || tr.Second() != "two" || tr.Third() != 3.0 {
+		t.Fatalf("unexpected accessors %v", tr)
+	}
+	if tr.String() != "(1, two, 3)" {
+		t.Fatalf("unexpected string %s", tr)
+	}
+	if !TripleEquals(tr, NewTriple(1, "two", 3.0)) || TripleEquals(tr, NewTriple(1, "two", 4.0)) {
+		t.Fatalf("unexpected equality results")
+	}
+}
@@ -0,0 +1,142 @@
+// Package partition splits the elements of any container into a fixed number of stable shards
+// so that parallel workers can each take a deterministic share of the work
+package partition
+
+import (
+	"fmt"
+	"iter"
+	"math"
+)
+
+// Partitioner assigns keys to one of a fixed number of partitions by hash
+// The default hash depends only on the key's value, so assignments are the same across processes,
+// and jump consistent hashing moves only about 1/n of the keys when the partition count changes
+type Partitioner[K comparable] struct {
+	n    int
+	hash func(key K) uint64
+}
+
+// NewPartitioner creates a partitioner over n partitions using the default stable hash
+// Values of n below 1 fall back to a single partition
+func NewPartitioner[K comparable](n int) *Partitioner[K] {
+	return NewPartitionerWithHash[K](n, stableHash[K])
+}
+
+// NewPartitionerWithHash creates a partitioner over n partitions using hash,
+// which must return the same value for equal keys
+// Values of n below 1 fall back to a single partition
+func NewPartitionerWithHash[K comparable](n int, hash func(key K) uint64) *Partitioner[K] {
+	return &Partitioner[K]{n: max(n, 1), hash: hash}
+}
+
+// Partitions returns the number of partitions
+func (p *Partitioner[K]) Partitions() int {
+	return p.n
+}
+
+// Partition returns the partition of key, in [0, Partitions())
+func (p *Partitioner[K]) Partition(key K) int {
+	return jumpHash(p.hash(key), p.n)
+}
+
+// Split distributes the elements of seq into Partitions() slices, keeping their relative order
+func (p *Partitioner[K]) Split(seq iter.Seq[K]) [][]K {
+	parts := make([][]K, p.n)
+	for k := range seq {
+		i := p.Partition(k)
+		parts[i] = append(parts[i], k)
+	}
+	return parts
+}
+
+// Shard returns a sequence of the elements of seq that fall into partition index
+// It filters lazily, so every worker can walk the same source without materializing the other shards
+func (p *Partitioner[K]) Shard(seq iter.Seq[K], index int) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range seq {
+			if p.Partition(k) == index && !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// SplitMap distributes the pairs of seq into Partitions() maps by key
+func SplitMap[K comparable, V any](p *Partitioner[K], seq iter.Seq2[K, V]) []map[K]V {
+	parts := make([]map[K]V, p.n)
+	for i := range parts {
+		parts[i] = make(map[K]V)
+	}
+	for k, v := range seq {
+		parts[p.Partition(k)][k] = v
+	}
+	return parts
+}
+
+// ShardPairs returns a sequence of the pairs of seq whose key falls into partition index
+func ShardPairs[K comparable, V any](p *Partitioner[K], seq iter.Seq2[K, V], index int) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range seq {
+			if p.Partition(k) == index && !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// jumpHash maps key to a bucket in [0, n) with the jump consistent hash of Lamping and Veach
+func jumpHash(key uint64, n int) int {
+	var b int64 = -1
+	var j int64
+	for j < int64(n) {
+		b = j
+		key = key*2862933555777941757 + 1
+		j = int64(float64(b+1) * (float64(int64(1)<<31) / float64((key>>33)+1)))
+	}
+	return int(b)
+}
+
+// stableHash hashes the value of key with FNV-1a
+// Strings and numbers are hashed directly, other keys through their %#v rendering,
+// which is not stable across processes for keys holding pointers
+func stableHash[K comparable](key K) uint64 {
+	switch k := any(key).(type) {
+	case string:
+		return fnv1a(k)
+	case int:
+		return mix(uint64(k))
+	case int64:
+		return mix(uint64(k))
+	case int32:
+		return mix(uint64(k))
+	case uint:
+		return mix(uint64(k))
+	case uint64:
+		return mix(k)
+	case uint32:
+		return mix(uint64(k))
+	case float64:
+		return mix(math.Float64bits(k))
+	default:
+		return fnv1a(fmt.Sprintf("%#v", key))
+	}
+}
+
+func fnv1a(s string) uint64 {
+	h := uint64(14695981039346656037)
+	for i := 0; i < len(s); i++ {
+		h ^= uint64(s[i])
+		h *= 1099511628211
+	}
+	return mix(h)
+}
+
+// mix scrambles the bits of h so that sequential keys spread evenly
+func mix(h uint64) uint64 {
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	h *= 0xc4ceb9fe1a85ec53
+	h ^= h >> 33
+	return h
+}
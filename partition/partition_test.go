@@ -0,0 +1,90 @@
+package partition
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/profoundwu/containers/list"
+)
+
+func TestPartitionerStable(t *testing.T) {
+	p := NewPartitioner[string](4)
+	if p.Partitions() != 4 || NewPartitioner[int](0).Partitions() != 1 {
+		t.Fatalf("unexpected partition counts")
+	}
+	// Pinned assignments guard against hashes that vary between runs
+	if got := p.Partition("alpha"); got != 1 {
+		t.Fatalf("expected alpha in partition 1 got %d", got)
+	}
+	if got := NewPartitioner[int](4).Partition(42); got != 0 {
+		t.Fatalf("expected 42 in partition 0 got %d", got)
+	}
+
+	counts := make([]int, 4)
+	for i := 0; i < 4000; i++ {
+		counts[NewPartitioner[int](4).Partition(i)]++
+	}
+	for i, c := range counts {
+		if c < 800 || c > 1200 {
+			t.Fatalf("partition %d got %d of 4000 keys", i, c)
+		}
+	}
+
+	type point struct{ X, Y int }
+	pp := NewPartitioner[point](8)
+	if pp.Partition(point{1, 2}) != pp.Partition(point{1, 2}) {
+		t.Fatalf("expected equal structs to share a partition")
+	}
+}
+
+func TestPartitionerConsistent(t *testing.T) {
+	moved := 0
+	for i := 0; i < 10000; i++ {
+		if NewPartitioner[int](10).Partition(i) != NewPartitioner[int](11).Partition(i) {
+			moved++
+		}
+	}
+	// Growing from 10 to 11 partitions should move about 1/11 of the keys
+	if moved > 1200 {
+		t.Fatalf("expected about 900 keys to move got %d", moved)
+	}
+}
+
+func TestPartitionerSplitAndShard(t *testing.T) {
+	l := list.NewArrayListFromSlice([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+	p := NewPartitioner[int](3)
+
+	parts := p.Split(l.Values())
+	var all []int
+	for i, part := range parts {
+		for _, v := range part {
+			if p.Partition(v) != i {
+				t.Fatalf("expected %d in partition %d", v, p.Partition(v))
+			}
+		}
+		if shard := slices.Collect(p.Shard(l.Values(), i)); !slices.Equal(shard, part) {
+			t.Fatalf("expected shard %v got %v", part, shard)
+		}
+		all = append(all, part...)
+	}
+	slices.Sort(all)
+	if !slices.Equal(all, l.ToSlice()) {
+		t.Fatalf("expected every element once got %v", all)
+	}
+}
+
+func TestSplitMapAndShardPairs(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3, "d": 4, "e": 5}
+	p := NewPartitionerWithHash[string](2, func(k string) uint64 { return uint64(k[0]) })
+
+	parts := SplitMap(p, maps.All(m))
+	if len(parts[0])+len(parts[1]) != len(m) {
+		t.Fatalf("expected %d pairs got %v", len(m), parts)
+	}
+	for i, part := range parts {
+		if got := maps.Collect(ShardPairs(p, maps.All(m), i)); !maps.Equal(got, part) {
+			t.Fatalf("expected shard %v got %v", part, got)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package queue
+
+import (
+	"context"
+	"time"
+
+	"github.com/profoundwu/containers/internal/utils"
+)
+
+// BlockingQueue is a bounded FIFO whose Put waits while it is full and whose Take waits while it is empty
+// Unlike a channel it can be peeked at, drained in one call and resized while in use
+// It is safe for concurrent use
+type BlockingQueue[T any] struct {
+	ring[T]
+}
+
+// NewBlockingQueue creates a new empty blocking queue holding up to capacity items
+// Values of capacity below 1 fall back to the default capacity
+func NewBlockingQueue[T any](capacity int) *BlockingQueue[T] {
+	q := &BlockingQueue[T]{}
+	q.init(capacity)
+	return q
+}
+
+// Size returns the number of items in the queue
+func (q *BlockingQueue[T]) Size() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.count
+}
+
+// IsEmpty checks if the queue is empty
+func (q *BlockingQueue[T]) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// Capacity returns the maximum number of items the queue accepts
+func (q *BlockingQueue[T]) Capacity() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.capacity
+}
+
+// SetCapacity changes the maximum number of items the queue accepts
+// Shrinking below the current size keeps every queued item, Puts wait until the queue drains below the new bound
+// Values of capacity below 1 fall back to the default capacity
+func (q *BlockingQueue[T]) SetCapacity(capacity int) {
+	if capacity < 1 {
+		capacity = utils.DefaultCapacity
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.resize(capacity)
+}
+
+// Put appends value, waiting for room
+// Returns ErrClosed if the queue has been closed
+func (q *BlockingQueue[T]) Put(value T) error {
+	return q.PutCtx(context.Background(), value)
+}
+
+// PutCtx appends value, waiting for room until ctx is done
+// Returns ErrClosed if the queue has been closed, or the context's error if ctx ends first
+func (q *BlockingQueue[T]) PutCtx(ctx context.Context, value T) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if err := q.awaitRoom(ctx, nil); err != nil {
+		return err
+	}
+	q.push(value)
+	return nil
+}
+
+// TryPut appends value, waiting at most timeout for room
+// A timeout of zero or less does not wait
+// Returns false if the queue stayed full or is closed
+func (q *BlockingQueue[T]) TryPut(value T, timeout time.Duration) bool {
+	ctx, cancel := timeoutContext(timeout)
+	defer cancel()
+	return q.PutCtx(ctx, value) == nil
+}
+
+// Take removes and returns the oldest item, waiting until one arrives
+// Returns ErrClosed once the queue is closed and drained
+func (q *BlockingQueue[T]) Take() (T, error) {
+	return q.TakeCtx(context.Background())
+}
+
+// TakeCtx removes and returns the oldest item, waiting until one arrives or ctx is done
+// Returns ErrClosed once the queue is closed and drained, or the context's error if ctx ends first
+func (q *BlockingQueue[T]) TakeCtx(ctx context.Context) (T, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if err := q.awaitItem(ctx); err != nil {
+		var zero T
+		return zero, err
+	}
+	value, _ := q.pop()
+	return value, nil
+}
+
+// TryTake removes and returns the oldest item, waiting at most timeout for one to arrive
+// A timeout of zero or less does not wait
+// Returns false if the queue stayed empty
+func (q *BlockingQueue[T]) TryTake(timeout time.Duration) (T, bool) {
+	ctx, cancel := timeoutContext(timeout)
+	defer cancel()
+	v, err := q.TakeCtx(ctx)
+	return v, err == nil
+}
+
+// Peek returns the oldest item without removing it
+// Returns false if the queue is empty
+func (q *BlockingQueue[T]) Peek() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.count == 0 {
+		var zero T
+		return zero, false
+	}
+	return q.items[q.head], true
+}
+
+// Drain removes and returns up to n of the oldest items without waiting, all of them if n is below 1
+func (q *BlockingQueue[T]) Drain(n int) []T {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if n < 1 || n > q.count {
+		n = q.count
+	}
+	drained := make([]T, n)
+	for i := range drained {
+		drained[i], _ = q.pop()
+	}
+	return drained
+}
+
+// Close stops the queue from accepting items; items already queued can still be taken
+func (q *BlockingQueue[T]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.close()
+}
+
+// timeoutContext returns a context that ends after timeout, or one that has already ended
+// when timeout is not positive, so waiting calls give up at once
+func timeoutContext(timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		return ctx, cancel
+	}
+	return context.WithTimeout(context.Background(), timeout)
+}
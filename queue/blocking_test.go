@@ -0,0 +1,124 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBlockingQueue(t *testing.T) {
+	q := NewBlockingQueue[int](2)
+	if !q.TryPut(1, 0) || !q.TryPut(2, 0) || q.TryPut(3, 5*time.Millisecond) {
+		t.Fatalf("expected exactly two items to fit")
+	}
+	if v, ok := q.Peek(); !ok || v != 1 || q.Size() != 2 {
+		t.Fatalf("expected to peek 1 without removing it got %d %v", v, ok)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := q.PutCtx(ctx, 3); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected DeadlineExceeded got %v", err)
+	}
+
+	done := make(chan error)
+	go func() { done <- q.Put(3) }()
+	time.Sleep(5 * time.Millisecond)
+	if v, _ := q.Take(); v != 1 {
+		t.Fatalf("expected 1 got %d", v)
+	}
+	if err := <-done; err != nil {
+		t.Fatalf("expected no error got %v", err)
+	}
+
+	if got := q.Drain(1); !slices.Equal(got, []int{2}) {
+		t.Fatalf("expected [2] got %v", got)
+	}
+	if got := q.Drain(0); !slices.Equal(got, []int{3}) {
+		t.Fatalf("expected [3] got %v", got)
+	}
+	if _, ok := q.TryTake(5 * time.Millisecond); ok {
+		t.Fatalf("expected an empty queue")
+	}
+
+	go func() {
+		time.Sleep(5 * time.Millisecond)
+		q.Put(4)
+	}()
+	if v, ok := q.TryTake(time.Second); !ok || v != 4 {
+		t.Fatalf("expected 4 got %d %v", v, ok)
+	}
+
+	q.Put(5)
+	q.Close()
+	if err := q.Put(6); !errors.Is(err, ErrClosed) {
+		t.Fatalf("expected ErrClosed got %v", err)
+	}
+	if v, err := q.Take(); err != nil || v != 5 {
+		t.Fatalf("expected 5 got %d %v", v, err)
+	}
+	if _, err := q.Take(); !errors.Is(err, ErrClosed) {
+		t.Fatalf("expected ErrClosed got %v", err)
+	}
+}
+
+func TestBlockingQueueSetCapacity(t *testing.T) {
+	q := NewBlockingQueue[int](4)
+	for i := 1; i <= 4; i++ {
+		q.Put(i)
+	}
+	q.Take()
+	q.Put(5) // wraps around the ring
+
+	q.SetCapacity(2)
+	if q.Capacity() != 2 || q.Size() != 4 {
+		t.Fatalf("expected every item kept got capacity %d size %d", q.Capacity(), q.Size())
+	}
+	if q.TryPut(6, 0) {
+		t.Fatalf("expected puts to wait until the queue drains below the new capacity")
+	}
+	q.Drain(3)
+	if !q.TryPut(6, 0) || q.TryPut(7, 0) {
+		t.Fatalf("expected room for exactly one more item")
+	}
+
+	blocked := make(chan error)
+	go func() { blocked <- q.Put(7) }()
+	time.Sleep(5 * time.Millisecond)
+	q.SetCapacity(8)
+	if err := <-blocked; err != nil {
+		t.Fatalf("expected growing the queue to release the put got %v", err)
+	}
+	if got := q.Drain(0); !slices.Equal(got, []int{5, 6, 7}) {
+		t.Fatalf("expected [5 6 7] got %v", got)
+	}
+}
+
+func TestBlockingQueueConcurrent(t *testing.T) {
+	q := NewBlockingQueue[int](3)
+	var wg sync.WaitGroup
+	for p := 0; p < 4; p++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				q.Put(i)
+			}
+		}()
+	}
+	sum := 0
+	for i := 0; i < 400; i++ {
+		v, err := q.Take()
+		if err != nil {
+			t.Fatalf("expected no error got %v", err)
+		}
+		sum += v
+	}
+	wg.Wait()
+	if sum != 4*4950 {
+		t.Fatalf("expected %d got %d", 4*4950, sum)
+	}
+}
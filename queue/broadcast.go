@@ -0,0 +1,222 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/profoundwu/containers/internal/utils"
+)
+
+var (
+	ErrClosed = errors.New("queue is closed")
+)
+
+// OverflowPolicy decides what Publish does when the ring is full for the slowest subscriber
+type OverflowPolicy int
+
+const (
+	// Overwrite replaces the oldest message, subscribers that fall behind skip ahead and count the loss
+	Overwrite OverflowPolicy = iota
+	// BlockOnSlowest makes Publish wait until the slowest subscriber has read the oldest message
+	BlockOnSlowest
+)
+
+// Broadcast fans messages out to any number of subscribers through one shared ring buffer
+// Every subscriber reads every message at its own pace from an independent cursor, so a message is stored once
+// no matter how many subscribers there are
+type Broadcast[T any] struct {
+	mu     sync.Mutex
+	ring   []T
+	next   uint64 // sequence number of the next published message
+	policy OverflowPolicy
+	subs   map[*Subscriber[T]]struct{}
+	closed bool
+	// signal is closed and replaced whenever a waiter might be able to make progress
+	signal chan struct{}
+}
+
+// Subscriber is one reader of a Broadcast
+type Subscriber[T any] struct {
+	b      *Broadcast[T]
+	next   uint64
+	missed uint64
+	done   bool
+}
+
+// NewBroadcast creates a broadcast buffer holding up to capacity messages
+// Values of capacity below 1 fall back to the default capacity
+func NewBroadcast[T any](capacity int, policy OverflowPolicy) *Broadcast[T] {
+	if capacity < 1 {
+		capacity = utils.DefaultCapacity
+	}
+	return &Broadcast[T]{
+		ring:   make([]T, capacity),
+		policy: policy,
+		subs:   make(map[*Subscriber[T]]struct{}),
+		signal: make(chan struct{}),
+	}
+}
+
+// Capacity returns the number of messages the ring can hold
+func (b *Broadcast[T]) Capacity() int {
+	return len(b.ring)
+}
+
+// Subscribers returns the number of active subscribers
+func (b *Broadcast[T]) Subscribers() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.subs)
+}
+
+// Subscribe registers a subscriber that receives every message published from now on
+func (b *Broadcast[T]) Subscribe() *Subscriber[T] {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	s := &Subscriber[T]{b: b, next: b.next, done: b.closed}
+	if !b.closed {
+		b.subs[s] = struct{}{}
+	}
+	return s
+}
+
+// Publish appends value to the ring
+// With BlockOnSlowest it waits for room until ctx is done, returning the context's error
+// Returns ErrClosed if the broadcast has been closed
+func (b *Broadcast[T]) Publish(ctx context.Context, value T) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for {
+		if b.closed {
+			return ErrClosed
+		}
+		if b.policy != BlockOnSlowest || b.next-b.slowest() < uint64(len(b.ring)) {
+			break
+		}
+		if err := b.wait(ctx); err != nil {
+			return err
+		}
+	}
+	b.ring[b.next%uint64(len(b.ring))] = value
+	b.next++
+	b.notify()
+	return nil
+}
+
+// Close stops publishing; subscribers can still read what is buffered and then receive ErrClosed
+func (b *Broadcast[T]) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.closed {
+		b.closed = true
+		b.notify()
+	}
+}
+
+// slowest returns the smallest read position of any subscriber, or the write position if there are none
+func (b *Broadcast[T]) slowest() uint64 {
+	lowest := b.next
+	for s := range b.subs {
+		lowest = min(lowest, s.next)
+	}
+	return lowest
+}
+
+// oldest returns the sequence number of the oldest message still in the ring
+func (b *Broadcast[T]) oldest() uint64 {
+	if b.next < uint64(len(b.ring)) {
+		return 0
+	}
+	return b.next - uint64(len(b.ring))
+}
+
+func (b *Broadcast[T]) notify() {
+	close(b.signal)
+	b.signal = make(chan struct{})
+}
+
+// wait releases the lock until the next notify or until ctx is done
+func (b *Broadcast[T]) wait(ctx context.Context) error {
+	signal := b.signal
+	b.mu.Unlock()
+	defer b.mu.Lock()
+	select {
+	case <-signal:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Next returns the subscriber's next message, waiting until one is published or ctx is done
+// Returns ErrClosed once the broadcast is closed and drained, or the subscriber has been closed
+func (s *Subscriber[T]) Next(ctx context.Context) (T, error) {
+	b := s.b
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for {
+		if v, ok := s.read(); ok {
+			return v, nil
+		}
+		if s.done || b.closed {
+			var zero T
+			return zero, ErrClosed
+		}
+		if err := b.wait(ctx); err != nil {
+			var zero T
+			return zero, err
+		}
+	}
+}
+
+// TryNext returns the subscriber's next message without waiting
+// Returns false if no message is available
+func (s *Subscriber[T]) TryNext() (T, bool) {
+	s.b.mu.Lock()
+	defer s.b.mu.Unlock()
+	return s.read()
+}
+
+// Missed returns how many messages were overwritten before the subscriber read them
+func (s *Subscriber[T]) Missed() uint64 {
+	s.b.mu.Lock()
+	defer s.b.mu.Unlock()
+	return s.missed
+}
+
+// Close unsubscribes, which also releases publishers waiting on this subscriber
+func (s *Subscriber[T]) Close() {
+	b := s.b
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !s.done {
+		s.done = true
+		delete(b.subs, s)
+		b.notify()
+	}
+}
+
+// read takes the next message for the subscriber, skipping anything already overwritten
+// The caller must hold the broadcast lock
+func (s *Subscriber[T]) read() (T, bool) {
+	b := s.b
+	if s.done {
+		var zero T
+		return zero, false
+	}
+	if oldest := b.oldest(); s.next < oldest {
+		s.missed += oldest - s.next
+		s.next = oldest
+	}
+	if s.next == b.next {
+		var zero T
+		return zero, false
+	}
+	v := b.ring[s.next%uint64(len(b.ring))]
+	s.next++
+	if b.policy == BlockOnSlowest {
+		b.notify()
+	}
+	return v, true
+}
@@ -0,0 +1,129 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBroadcastFanOut(t *testing.T) {
+	b := NewBroadcast[int](4, Overwrite)
+	early := b.Subscribe()
+	b.Publish(context.Background(), 1)
+	late := b.Subscribe()
+	b.Publish(context.Background(), 2)
+
+	if v, ok := early.TryNext(); !ok || v != 1 {
+		t.Fatalf("expected 1 got %d ok=%v", v, ok)
+	}
+	if v, _ := early.TryNext(); v != 2 {
+		t.Fatalf("expected 2 got %d", v)
+	}
+	if v, _ := late.TryNext(); v != 2 {
+		t.Fatalf("late subscriber should only see later messages got %d", v)
+	}
+	if _, ok := late.TryNext(); ok {
+		t.Fatalf("expected no more messages")
+	}
+	if b.Subscribers() != 2 || b.Capacity() != 4 {
+		t.Fatalf("unexpected subscribers %d capacity %d", b.Subscribers(), b.Capacity())
+	}
+}
+
+func TestBroadcastOverwrite(t *testing.T) {
+	b := NewBroadcast[int](3, Overwrite)
+	s := b.Subscribe()
+	for i := 1; i <= 5; i++ {
+		if err := b.Publish(context.Background(), i); err != nil {
+			t.Fatalf("overwrite publish should never block: %v", err)
+		}
+	}
+	if v, _ := s.TryNext(); v != 3 {
+		t.Fatalf("expected oldest retained message 3 got %d", v)
+	}
+	if s.Missed() != 2 {
+		t.Fatalf("expected 2 missed messages got %d", s.Missed())
+	}
+}
+
+func TestBroadcastBlockOnSlowest(t *testing.T) {
+	b := NewBroadcast[int](2, BlockOnSlowest)
+	s := b.Subscribe()
+	b.Publish(context.Background(), 1)
+	b.Publish(context.Background(), 2)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := b.Publish(ctx, 3); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected publish to block until the deadline got %v", err)
+	}
+
+	done := make(chan error)
+	go func() { done <- b.Publish(context.Background(), 3) }()
+	if v, _ := s.Next(context.Background()); v != 1 {
+		t.Fatalf("expected 1 got %d", v)
+	}
+	if err := <-done; err != nil {
+		t.Fatalf("publish should proceed once the subscriber reads: %v", err)
+	}
+
+	// Closing the only subscriber releases publishers entirely
+	s.Close()
+	for i := 0; i < 5; i++ {
+		if err := b.Publish(context.Background(), i); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if _, err := s.Next(context.Background()); !errors.Is(err, ErrClosed) {
+		t.Fatalf("closed subscriber should get ErrClosed got %v", err)
+	}
+}
+
+func TestBroadcastCloseDrains(t *testing.T) {
+	b := NewBroadcast[string](4, Overwrite)
+	s := b.Subscribe()
+	b.Publish(context.Background(), "last")
+	b.Close()
+	if err := b.Publish(context.Background(), "late"); !errors.Is(err, ErrClosed) {
+		t.Fatalf("expected ErrClosed got %v", err)
+	}
+	if v, err := s.Next(context.Background()); err != nil || v != "last" {
+		t.Fatalf("expected buffered message got %q err=%v", v, err)
+	}
+	if _, err := s.Next(context.Background()); !errors.Is(err, ErrClosed) {
+		t.Fatalf("expected ErrClosed got %v", err)
+	}
+}
+
+func TestBroadcastConcurrentSubscribers(t *testing.T) {
+	b := NewBroadcast[int](8, BlockOnSlowest)
+	const messages = 200
+	var wg sync.WaitGroup
+	sums := make([]int, 4)
+	for i := range sums {
+		s := b.Subscribe()
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for {
+				v, err := s.Next(context.Background())
+				if err != nil {
+					return
+				}
+				sums[i] += v
+			}
+		}(i)
+	}
+	for i := 1; i <= messages; i++ {
+		b.Publish(context.Background(), i)
+	}
+	b.Close()
+	wg.Wait()
+	for i, sum := range sums {
+		if sum != messages*(messages+1)/2 {
+			t.Fatalf("subscriber %d got sum %d", i, sum)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package queue
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+)
+
+type delayItem[T any] struct {
+	value T
+	ready time.Time
+	seq   uint64
+}
+
+// delayHeap orders items by ready time, then by offer order
+type delayHeap[T any] struct {
+	items []delayItem[T]
+}
+
+func (h *delayHeap[T]) Len() int { return len(h.items) }
+
+func (h *delayHeap[T]) Less(i, j int) bool {
+	if !h.items[i].ready.Equal(h.items[j].ready) {
+		return h.items[i].ready.Before(h.items[j].ready)
+	}
+	return h.items[i].seq < h.items[j].seq
+}
+
+func (h *delayHeap[T]) Swap(i, j int) { h.items[i], h.items[j] = h.items[j], h.items[i] }
+
+func (h *delayHeap[T]) Push(x any) { h.items = append(h.items, x.(delayItem[T])) }
+
+func (h *delayHeap[T]) Pop() any {
+	n := len(h.items)
+	item := h.items[n-1]
+	h.items[n-1] = delayItem[T]{}
+	h.items = h.items[:n-1]
+	return item
+}
+
+// DelayQueue holds elements that only become available once their delay has expired,
+// handing them out in order of readiness
+// It is safe for concurrent use
+type DelayQueue[T any] struct {
+	mu    sync.Mutex
+	items delayHeap[T]
+	seq   uint64
+	// signal is closed and replaced whenever the earliest ready time may have changed
+	signal chan struct{}
+
+	now func() time.Time
+}
+
+// NewDelayQueue creates a new empty delay queue
+func NewDelayQueue[T any]() *DelayQueue[T] {
+	return &DelayQueue[T]{signal: make(chan struct{}), now: time.Now}
+}
+
+// Size returns the number of elements in the queue, ready or not
+func (q *DelayQueue[T]) Size() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.items.Len()
+}
+
+// IsEmpty checks if the queue is empty
+func (q *DelayQueue[T]) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// Offer adds value to become available after delay; a delay of zero or less makes it ready at once
+func (q *DelayQueue[T]) Offer(value T, delay time.Duration) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	heap.Push(&q.items, delayItem[T]{value: value, ready: q.now().Add(delay), seq: q.seq})
+	q.seq++
+	close(q.signal)
+	q.signal = make(chan struct{})
+}
+
+// Poll removes and returns the earliest element if its delay has expired
+// Returns false if the queue is empty or nothing is ready yet
+func (q *DelayQueue[T]) Poll() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.items.Len() == 0 || q.items.items[0].ready.After(q.now()) {
+		var zero T
+		return zero, false
+	}
+	return heap.Pop(&q.items).(delayItem[T]).value, true
+}
+
+// Delay returns how long until the earliest element is ready, zero if it already is
+// Returns false if the queue is empty
+func (q *DelayQueue[T]) Delay() (time.Duration, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.items.Len() == 0 {
+		return 0, false
+	}
+	return max(q.items.items[0].ready.Sub(q.now()), 0), true
+}
+
+// Take removes and returns the earliest element, waiting until it is ready or ctx is done
+// Returns the context's error if ctx ends first
+func (q *DelayQueue[T]) Take(ctx context.Context) (T, error) {
+	for {
+		q.mu.Lock()
+		signal := q.signal
+		var wait time.Duration = -1
+		if q.items.Len() > 0 {
+			wait = q.items.items[0].ready.Sub(q.now())
+			if wait <= 0 {
+				value := heap.Pop(&q.items).(delayItem[T]).value
+				q.mu.Unlock()
+				return value, nil
+			}
+		}
+		q.mu.Unlock()
+
+		// Wake on a new offer as well, since it may be ready sooner than the current head
+		if err := q.waitFor(ctx, signal, wait); err != nil {
+			var zero T
+			return zero, err
+		}
+	}
+}
+
+// waitFor blocks until signal fires, d has passed or ctx is done; a negative d waits without a timeout
+func (q *DelayQueue[T]) waitFor(ctx context.Context, signal <-chan struct{}, d time.Duration) error {
+	var timeout <-chan time.Time
+	if d >= 0 {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	select {
+	case <-signal:
+	case <-timeout:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return nil
+}
+
+// Clear removes all elements from the queue
+func (q *DelayQueue[T]) Clear() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	clear(q.items.items)
+	q.items.items = q.items.items[:0]
+}
@@ -0,0 +1,94 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDelayQueuePoll(t *testing.T) {
+	clock := time.Unix(1000, 0)
+	q := NewDelayQueue[string]()
+	q.now = func() time.Time { return clock }
+
+	q.Offer("later", 2*time.Second)
+	q.Offer("soon", time.Second)
+	q.Offer("now", 0)
+	q.Offer("also now", -time.Second)
+	if q.Size() != 4 {
+		t.Fatalf("expected size 4 got %d", q.Size())
+	}
+
+	if v, ok := q.Poll(); !ok || v != "also now" {
+		t.Fatalf("expected the overdue element first got %q ok=%v", v, ok)
+	}
+	if v, _ := q.Poll(); v != "now" {
+		t.Fatalf("expected now got %q", v)
+	}
+	if _, ok := q.Poll(); ok {
+		t.Fatalf("nothing else should be ready yet")
+	}
+	if d, ok := q.Delay(); !ok || d != time.Second {
+		t.Fatalf("expected 1s until the next element got %v", d)
+	}
+
+	clock = clock.Add(2 * time.Second)
+	if v, _ := q.Poll(); v != "soon" {
+		t.Fatalf("expected soon got %q", v)
+	}
+	if v, _ := q.Poll(); v != "later" {
+		t.Fatalf("expected later got %q", v)
+	}
+	if _, ok := q.Delay(); ok || !q.IsEmpty() {
+		t.Fatalf("expected empty queue")
+	}
+}
+
+func TestDelayQueueSameReadyTimeIsFIFO(t *testing.T) {
+	clock := time.Unix(0, 0)
+	q := NewDelayQueue[int]()
+	q.now = func() time.Time { return clock }
+	for i := 0; i < 5; i++ {
+		q.Offer(i, time.Minute)
+	}
+	clock = clock.Add(time.Minute)
+	for i := 0; i < 5; i++ {
+		if v, _ := q.Poll(); v != i {
+			t.Fatalf("expected %d got %d", i, v)
+		}
+	}
+}
+
+func TestDelayQueueTake(t *testing.T) {
+	q := NewDelayQueue[int]()
+	q.Offer(1, 20*time.Millisecond)
+	start := time.Now()
+	v, err := q.Take(context.Background())
+	if err != nil || v != 1 {
+		t.Fatalf("expected 1 got %d err=%v", v, err)
+	}
+	if time.Since(start) < 20*time.Millisecond {
+		t.Fatalf("Take returned before the delay expired")
+	}
+
+	// An earlier offer arriving while Take waits is picked up first
+	q.Offer(2, time.Hour)
+	go func() {
+		time.Sleep(5 * time.Millisecond)
+		q.Offer(3, 0)
+	}()
+	if v, _ := q.Take(context.Background()); v != 3 {
+		t.Fatalf("expected 3 got %d", v)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := q.Take(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded got %v", err)
+	}
+	q.Clear()
+	if !q.IsEmpty() {
+		t.Fatalf("expected empty queue after clear")
+	}
+}
@@ -0,0 +1,117 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// Future holds the result of work that completes later, typically a work item handed to a
+// consumer over a queue so the producer can wait for the response
+// A future completes exactly once, with either a value or an error
+type Future[T any] struct {
+	once  sync.Once
+	done  chan struct{}
+	value T
+	err   error
+}
+
+// NewFuture creates a new pending future
+func NewFuture[T any]() *Future[T] {
+	return &Future[T]{done: make(chan struct{})}
+}
+
+// Set completes the future with value
+// Returns false if the future was already completed
+func (f *Future[T]) Set(value T) bool {
+	return f.complete(value, nil)
+}
+
+// SetErr completes the future with err
+// Returns false if the future was already completed
+func (f *Future[T]) SetErr(err error) bool {
+	var zero T
+	return f.complete(zero, err)
+}
+
+// Wait blocks until the future completes or ctx is done
+// Returns the context's error if ctx ends first, otherwise the value or error the future completed with
+func (f *Future[T]) Wait(ctx context.Context) (T, error) {
+	select {
+	case <-f.done:
+		return f.value, f.err
+	default:
+	}
+
+	select {
+	case <-f.done:
+		return f.value, f.err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+// Done returns a channel that is closed once the future completes
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+// IsDone checks if the future has completed
+func (f *Future[T]) IsDone() bool {
+	select {
+	case <-f.done:
+		return true
+	default:
+		return false
+	}
+}
+
+func (f *Future[T]) complete(value T, err error) bool {
+	completed := false
+	f.once.Do(func() {
+		f.value = value
+		f.err = err
+		close(f.done)
+		completed = true
+	})
+	return completed
+}
+
+// Request is a work item queued together with the future its consumer completes,
+// which gives request-response exchanges over a BlockingQueue
+type Request[T any, R any] struct {
+	Value  T
+	Result *Future[R]
+}
+
+// Submit queues a request for value on q, waiting for room until ctx is done, and returns the
+// future the consumer completes with the response
+// Returns ErrClosed if q has been closed, or the context's error if ctx ends first
+func Submit[T any, R any](ctx context.Context, q *BlockingQueue[Request[T, R]], value T) (*Future[R], error) {
+	f := NewFuture[R]()
+	if err := q.PutCtx(ctx, Request[T, R]{Value: value, Result: f}); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Serve takes requests from q and completes each one's future with the result of fn, returning
+// once q is closed and drained
+// Returns the context's error if ctx ends first, leaving the requests still queued pending
+func Serve[T any, R any](ctx context.Context, q *BlockingQueue[Request[T, R]], fn func(ctx context.Context, value T) (R, error)) error {
+	for {
+		req, err := q.TakeCtx(ctx)
+		if errors.Is(err, ErrClosed) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if result, err := fn(ctx, req.Value); err != nil {
+			req.Result.SetErr(err)
+		} else {
+			req.Result.Set(result)
+		}
+	}
+}
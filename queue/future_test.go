@@ -0,0 +1,117 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestFutureSet(t *testing.T) {
+	f := NewFuture[int]()
+	if f.IsDone() {
+		t.Fatalf("new future should be pending")
+	}
+	if !f.Set(42) {
+		t.Fatalf("first Set should complete the future")
+	}
+	if f.Set(7) || f.SetErr(errors.New("late")) {
+		t.Fatalf("completed future should ignore later results")
+	}
+	v, err := f.Wait(context.Background())
+	if err != nil || v != 42 {
+		t.Fatalf("expected 42 got %d err=%v", v, err)
+	}
+	if !f.IsDone() {
+		t.Fatalf("expected future to be done")
+	}
+}
+
+func TestFutureSetErr(t *testing.T) {
+	f := NewFuture[string]()
+	boom := errors.New("boom")
+	f.SetErr(boom)
+	if v, err := f.Wait(context.Background()); !errors.Is(err, boom) || v != "" {
+		t.Fatalf("expected boom got %q err=%v", v, err)
+	}
+}
+
+func TestFutureWaitAcrossGoroutines(t *testing.T) {
+	requests := make(chan *Future[int])
+	go func() {
+		for f := range requests {
+			f.Set(1)
+		}
+	}()
+	defer close(requests)
+
+	f := NewFuture[int]()
+	requests <- f
+	<-f.Done()
+	if v, _ := f.Wait(context.Background()); v != 1 {
+		t.Fatalf("expected 1 got %d", v)
+	}
+}
+
+func TestFutureWaitContext(t *testing.T) {
+	f := NewFuture[int]()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := f.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded got %v", err)
+	}
+
+	// A completed future wins over an already cancelled context
+	f.Set(3)
+	if v, err := f.Wait(ctx); err != nil || v != 3 {
+		t.Fatalf("expected 3 got %d err=%v", v, err)
+	}
+}
+
+func TestSubmitServe(t *testing.T) {
+	ctx := context.Background()
+	q := NewBlockingQueue[Request[int, int]](2)
+	errOdd := errors.New("odd")
+	served := make(chan error, 1)
+	go func() {
+		served <- Serve(ctx, q, func(_ context.Context, v int) (int, error) {
+			if v%2 == 1 {
+				return 0, errOdd
+			}
+			return v * v, nil
+		})
+	}()
+
+	futures := make([]*Future[int], 5)
+	for i := range futures {
+		f, err := Submit(ctx, q, i)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		futures[i] = f
+	}
+	for i, f := range futures {
+		v, err := f.Wait(ctx)
+		if i%2 == 1 {
+			if !errors.Is(err, errOdd) {
+				t.Fatalf("expected errOdd for %d got %v", i, err)
+			}
+		} else if err != nil || v != i*i {
+			t.Fatalf("expected %d got %d %v", i*i, v, err)
+		}
+	}
+
+	q.Close()
+	if err := <-served; err != nil {
+		t.Fatalf("expected Serve to end cleanly got %v", err)
+	}
+	if _, err := Submit(ctx, q, 9); !errors.Is(err, ErrClosed) {
+		t.Fatalf("expected ErrClosed got %v", err)
+	}
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if err := Serve(cancelled, NewBlockingQueue[Request[int, int]](1), nil); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled got %v", err)
+	}
+}
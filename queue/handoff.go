@@ -0,0 +1,200 @@
+package queue
+
+import (
+	"context"
+	"time"
+)
+
+// HandoffStats is a snapshot of a Handoff's activity
+// Counters are totals since creation, rates and wait times cover the interval since the previous snapshot
+type HandoffStats struct {
+	Depth    int
+	Capacity int
+	Enqueued uint64
+	Dequeued uint64
+	// BlockedPuts counts the Puts that had to wait for room, BlockedTime their total wait
+	BlockedPuts uint64
+	BlockedTime time.Duration
+
+	Interval    time.Duration
+	EnqueueRate float64 // items per second
+	DequeueRate float64 // items per second
+	// MeanWait and MaxWait describe how long dequeued items sat in the queue, zero without timing
+	MeanWait time.Duration
+	MaxWait  time.Duration
+}
+
+// Handoff is a bounded FIFO for passing items between goroutines
+// Put blocks while the queue is full, pushing back on producers, and the queue records depth, throughput
+// and backpressure so pipelines can be sized from data
+// It is safe for concurrent use
+type Handoff[T any] struct {
+	ring[T]
+	times []time.Time // enqueue times parallel to items, nil without timing
+
+	enqueued    uint64
+	dequeued    uint64
+	blockedPuts uint64
+	blockedTime time.Duration
+
+	// Interval state reset by Stats
+	last         time.Time
+	lastEnqueued uint64
+	lastDequeued uint64
+	waitTotal    time.Duration
+	waitCount    uint64
+	waitMax      time.Duration
+
+	now func() time.Time
+}
+
+// NewHandoff creates a new empty handoff queue holding up to capacity items
+// Values of capacity below 1 fall back to the default capacity
+func NewHandoff[T any](capacity int) *Handoff[T] {
+	h := &Handoff[T]{now: time.Now}
+	h.init(capacity)
+	h.last = h.now()
+	return h
+}
+
+// NewHandoffWithTiming creates a new empty handoff queue that also records how long each item waits
+func NewHandoffWithTiming[T any](capacity int) *Handoff[T] {
+	h := NewHandoff[T](capacity)
+	h.times = make([]time.Time, len(h.items))
+	return h
+}
+
+// Size returns the number of items in the queue
+func (h *Handoff[T]) Size() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.count
+}
+
+// IsEmpty checks if the queue is empty
+func (h *Handoff[T]) IsEmpty() bool {
+	return h.Size() == 0
+}
+
+// Capacity returns the maximum number of items the queue holds
+func (h *Handoff[T]) Capacity() int {
+	return h.capacity
+}
+
+// Put appends value, waiting for room until ctx is done
+// Returns ErrClosed if the queue has been closed, or the context's error if ctx ends first
+func (h *Handoff[T]) Put(ctx context.Context, value T) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var blockedSince time.Time
+	err := h.awaitRoom(ctx, func() {
+		blockedSince = h.now()
+		h.blockedPuts++
+	})
+	if !blockedSince.IsZero() {
+		h.blockedTime += h.now().Sub(blockedSince)
+	}
+	if err != nil {
+		return err
+	}
+	h.push(value)
+	return nil
+}
+
+// TryPut appends value if there is room
+// Returns false if the queue is full or closed
+func (h *Handoff[T]) TryPut(value T) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closed || h.isFull() {
+		return false
+	}
+	h.push(value)
+	return true
+}
+
+// Take removes and returns the oldest item, waiting until one arrives or ctx is done
+// Returns ErrClosed once the queue is closed and drained, or the context's error if ctx ends first
+func (h *Handoff[T]) Take(ctx context.Context) (T, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if err := h.awaitItem(ctx); err != nil {
+		var zero T
+		return zero, err
+	}
+	return h.pop(), nil
+}
+
+// TryTake removes and returns the oldest item without waiting
+// Returns false if the queue is empty
+func (h *Handoff[T]) TryTake() (T, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.count == 0 {
+		var zero T
+		return zero, false
+	}
+	return h.pop(), true
+}
+
+// Close stops the queue from accepting items; items already queued can still be taken
+func (h *Handoff[T]) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.close()
+}
+
+// Stats returns a snapshot of the queue's activity and starts a new measurement interval
+func (h *Handoff[T]) Stats() HandoffStats {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := h.now()
+	interval := now.Sub(h.last)
+	stats := HandoffStats{
+		Depth:       h.count,
+		Capacity:    h.capacity,
+		Enqueued:    h.enqueued,
+		Dequeued:    h.dequeued,
+		BlockedPuts: h.blockedPuts,
+		BlockedTime: h.blockedTime,
+		Interval:    interval,
+		MaxWait:     h.waitMax,
+	}
+	if seconds := interval.Seconds(); seconds > 0 {
+		stats.EnqueueRate = float64(h.enqueued-h.lastEnqueued) / seconds
+		stats.DequeueRate = float64(h.dequeued-h.lastDequeued) / seconds
+	}
+	if h.waitCount > 0 {
+		stats.MeanWait = h.waitTotal / time.Duration(h.waitCount)
+	}
+
+	h.last, h.lastEnqueued, h.lastDequeued = now, h.enqueued, h.dequeued
+	h.waitTotal, h.waitCount, h.waitMax = 0, 0, 0
+	return stats
+}
+
+// push stores value at the tail, stamping it when timing is on
+// The caller holds the lock and has checked for room
+func (h *Handoff[T]) push(value T) {
+	slot := h.ring.push(value)
+	if h.times != nil {
+		h.times[slot] = h.now()
+	}
+	h.enqueued++
+}
+
+// pop removes the head, recording how long it waited when timing is on
+// The caller holds the lock and has checked that the queue is not empty
+func (h *Handoff[T]) pop() T {
+	value, slot := h.ring.pop()
+	if h.times != nil {
+		wait := h.now().Sub(h.times[slot])
+		h.waitTotal += wait
+		h.waitCount++
+		h.waitMax = max(h.waitMax, wait)
+	}
+	h.dequeued++
+	return value
+}
@@ -0,0 +1,109 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestHandoffFIFOAndBackpressure(t *testing.T) {
+	h := NewHandoff[int](2)
+	if !h.TryPut(1) || !h.TryPut(2) || h.TryPut(3) {
+		t.Fatalf("expected exactly two items to fit")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := h.Put(ctx, 3); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected DeadlineExceeded got %v", err)
+	}
+
+	done := make(chan error)
+	go func() { done <- h.Put(context.Background(), 3) }()
+	time.Sleep(5 * time.Millisecond)
+	if v, _ := h.Take(context.Background()); v != 1 {
+		t.Fatalf("expected 1 got %d", v)
+	}
+	if err := <-done; err != nil {
+		t.Fatalf("expected no error got %v", err)
+	}
+
+	stats := h.Stats()
+	if stats.Depth != 2 || stats.Capacity != 2 || stats.Enqueued != 3 || stats.Dequeued != 1 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+	if stats.BlockedPuts != 2 || stats.BlockedTime < 10*time.Millisecond {
+		t.Fatalf("expected two blocked puts of at least 10ms got %+v", stats)
+	}
+
+	h.Close()
+	if err := h.Put(context.Background(), 4); !errors.Is(err, ErrClosed) {
+		t.Fatalf("expected ErrClosed got %v", err)
+	}
+	for _, want := range []int{2, 3} {
+		if v, err := h.Take(context.Background()); err != nil || v != want {
+			t.Fatalf("expected %d got %d %v", want, v, err)
+		}
+	}
+	if _, err := h.Take(context.Background()); !errors.Is(err, ErrClosed) {
+		t.Fatalf("expected ErrClosed got %v", err)
+	}
+}
+
+func TestHandoffStatsTiming(t *testing.T) {
+	clock := time.Unix(0, 0)
+	h := NewHandoffWithTiming[string](4)
+	h.now = func() time.Time { return clock }
+	h.Stats()
+
+	h.TryPut("a")
+	clock = clock.Add(time.Second)
+	h.TryPut("b")
+	clock = clock.Add(time.Second)
+	h.TryTake()
+	h.TryTake()
+
+	stats := h.Stats()
+	if stats.Interval != 2*time.Second || stats.EnqueueRate != 1 || stats.DequeueRate != 1 {
+		t.Fatalf("unexpected rates %+v", stats)
+	}
+	if stats.MeanWait != 1500*time.Millisecond || stats.MaxWait != 2*time.Second {
+		t.Fatalf("expected mean 1.5s max 2s got %v %v", stats.MeanWait, stats.MaxWait)
+	}
+
+	clock = clock.Add(time.Second)
+	if stats := h.Stats(); stats.EnqueueRate != 0 || stats.MaxWait != 0 || stats.Enqueued != 2 {
+		t.Fatalf("expected a fresh interval with totals kept got %+v", stats)
+	}
+}
+
+func TestHandoffConcurrent(t *testing.T) {
+	h := NewHandoff[int](8)
+	var wg sync.WaitGroup
+	for p := 0; p < 4; p++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 250; i++ {
+				h.Put(context.Background(), i)
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		h.Close()
+	}()
+
+	n := 0
+	for {
+		if _, err := h.Take(context.Background()); err != nil {
+			break
+		}
+		n++
+	}
+	if n != 1000 || h.Stats().Dequeued != 1000 {
+		t.Fatalf("expected 1000 items got %d", n)
+	}
+}
@@ -0,0 +1,213 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+var (
+	ErrKeyNotInFlight = errors.New("key is not being processed")
+)
+
+type keyedBacklog[T any] struct {
+	items    []T
+	head     int
+	inFlight bool
+}
+
+// KeyedQueue hands out items so that items sharing a key are processed one at a time in FIFO order,
+// while items of different keys can be processed concurrently
+// A key taken by Take stays in flight until Done is called for it, and keys become ready in the order
+// their backlog became available, so a busy key cannot starve the others
+// It is safe for concurrent use
+type KeyedQueue[K comparable, T any] struct {
+	mu     sync.Mutex
+	keys   map[K]*keyedBacklog[T]
+	ready  []K
+	head   int
+	size   int
+	closed bool
+	// signal is closed and replaced whenever a key becomes ready or the queue closes
+	signal chan struct{}
+}
+
+// NewKeyedQueue creates a new empty keyed queue
+func NewKeyedQueue[K comparable, T any]() *KeyedQueue[K, T] {
+	return &KeyedQueue[K, T]{keys: make(map[K]*keyedBacklog[T]), signal: make(chan struct{})}
+}
+
+// Size returns the number of items waiting, excluding items already taken
+func (q *KeyedQueue[K, T]) Size() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.size
+}
+
+// IsEmpty checks if no items are waiting
+func (q *KeyedQueue[K, T]) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// InFlight returns the number of keys taken and not yet marked done
+func (q *KeyedQueue[K, T]) InFlight() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	n := 0
+	for _, b := range q.keys {
+		if b.inFlight {
+			n++
+		}
+	}
+	return n
+}
+
+// Enqueue adds value to the backlog of key
+// Returns ErrClosed if the queue has been closed
+func (q *KeyedQueue[K, T]) Enqueue(key K, value T) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return ErrClosed
+	}
+
+	b, ok := q.keys[key]
+	if !ok {
+		b = &keyedBacklog[T]{}
+		q.keys[key] = b
+	}
+	b.items = append(b.items, value)
+	q.size++
+	// A key with a single waiting item that is not in flight has just become ready
+	if !b.inFlight && len(b.items)-b.head == 1 {
+		q.markReady(key)
+	}
+	return nil
+}
+
+// TryTake removes and returns the oldest item of the longest ready key without waiting,
+// marking the key in flight
+// Returns false if no key is ready
+func (q *KeyedQueue[K, T]) TryTake() (K, T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.take()
+}
+
+// Take removes and returns the oldest item of the longest ready key, waiting until one is ready,
+// and marks the key in flight until Done is called for it
+// Returns ErrClosed once the queue is closed and drained, or the context's error if ctx ends first
+func (q *KeyedQueue[K, T]) Take(ctx context.Context) (K, T, error) {
+	for {
+		q.mu.Lock()
+		key, value, ok := q.take()
+		drained, signal := q.closed && q.size == 0, q.signal
+		q.mu.Unlock()
+		if ok {
+			return key, value, nil
+		}
+		if drained {
+			var zeroK K
+			var zeroT T
+			return zeroK, zeroT, ErrClosed
+		}
+
+		select {
+		case <-signal:
+		case <-ctx.Done():
+			var zeroK K
+			var zeroT T
+			return zeroK, zeroT, ctx.Err()
+		}
+	}
+}
+
+// Done releases key after its item was processed, making its next item available
+// Returns error if key is not in flight
+func (q *KeyedQueue[K, T]) Done(key K) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	b, ok := q.keys[key]
+	if !ok || !b.inFlight {
+		return fmt.Errorf("%w: %v", ErrKeyNotInFlight, key)
+	}
+
+	b.inFlight = false
+	if b.head < len(b.items) {
+		q.markReady(key)
+	} else {
+		delete(q.keys, key)
+	}
+	// Wake waiters even without a ready key, since a closed queue may now be drained
+	q.wake()
+	return nil
+}
+
+// Process runs fn on every item with at most workers concurrent calls, keeping items of a key in order
+// It returns once the queue is closed and drained, or with the context's error if ctx ends first
+// Values of workers below 1 fall back to one worker
+func (q *KeyedQueue[K, T]) Process(ctx context.Context, workers int, fn func(key K, value T)) error {
+	workers = max(workers, 1)
+	errs := make(chan error, workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			for {
+				key, value, err := q.Take(ctx)
+				if err != nil {
+					errs <- err
+					return
+				}
+				fn(key, value)
+				q.Done(key)
+			}
+		}()
+	}
+
+	var result error
+	for i := 0; i < workers; i++ {
+		if err := <-errs; !errors.Is(err, ErrClosed) && result == nil {
+			result = err
+		}
+	}
+	return result
+}
+
+// Close stops the queue from accepting items; items already queued can still be taken
+func (q *KeyedQueue[K, T]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if !q.closed {
+		q.closed = true
+		q.wake()
+	}
+}
+
+// take pops the next item of the longest ready key, the caller holds the lock
+func (q *KeyedQueue[K, T]) take() (K, T, bool) {
+	if q.head == len(q.ready) {
+		var zeroK K
+		var zeroT T
+		return zeroK, zeroT, false
+	}
+
+	var key K
+	key, q.ready, q.head = popFront(q.ready, q.head)
+	b := q.keys[key]
+	var value T
+	value, b.items, b.head = popFront(b.items, b.head)
+	b.inFlight = true
+	q.size--
+	return key, value, true
+}
+
+// markReady queues key for taking and wakes waiters, the caller holds the lock
+func (q *KeyedQueue[K, T]) markReady(key K) {
+	q.ready = append(q.ready, key)
+	q.wake()
+}
+
+func (q *KeyedQueue[K, T]) wake() {
+	close(q.signal)
+	q.signal = make(chan struct{})
+}
@@ -0,0 +1,136 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestKeyedQueueOrdering(t *testing.T) {
+	q := NewKeyedQueue[string, int]()
+	q.Enqueue("a", 1)
+	q.Enqueue("a", 2)
+	q.Enqueue("b", 3)
+
+	key, v, ok := q.TryTake()
+	if !ok || key != "a" || v != 1 {
+		t.Fatalf("expected a 1 got %s %d %v", key, v, ok)
+	}
+	// a is in flight, so b is the only ready key
+	key, v, _ = q.TryTake()
+	if key != "b" || v != 3 {
+		t.Fatalf("expected b 3 got %s %d", key, v)
+	}
+	if _, _, ok := q.TryTake(); ok {
+		t.Fatalf("expected nothing ready while a is in flight")
+	}
+	if q.Size() != 1 || q.InFlight() != 2 {
+		t.Fatalf("expected size 1 in flight 2 got %d %d", q.Size(), q.InFlight())
+	}
+
+	if err := q.Done("a"); err != nil {
+		t.Fatalf("expected no error got %v", err)
+	}
+	if err := q.Done("a"); !errors.Is(err, ErrKeyNotInFlight) {
+		t.Fatalf("expected ErrKeyNotInFlight got %v", err)
+	}
+	key, v, _ = q.TryTake()
+	if key != "a" || v != 2 {
+		t.Fatalf("expected a 2 got %s %d", key, v)
+	}
+}
+
+func TestKeyedQueueTakeAndClose(t *testing.T) {
+	q := NewKeyedQueue[int, string]()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, _, err := q.Take(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected DeadlineExceeded got %v", err)
+	}
+
+	done := make(chan string)
+	go func() {
+		_, v, _ := q.Take(context.Background())
+		done <- v
+	}()
+	q.Enqueue(1, "x")
+	if v := <-done; v != "x" {
+		t.Fatalf("expected x got %s", v)
+	}
+
+	q.Enqueue(1, "y")
+	q.Close()
+	if err := q.Enqueue(2, "z"); !errors.Is(err, ErrClosed) {
+		t.Fatalf("expected ErrClosed got %v", err)
+	}
+	go func() {
+		time.Sleep(5 * time.Millisecond)
+		q.Done(1)
+	}()
+	// y waits behind the in-flight key and is still delivered after Close
+	if _, v, err := q.Take(context.Background()); err != nil || v != "y" {
+		t.Fatalf("expected y got %s %v", v, err)
+	}
+	q.Done(1)
+	if _, _, err := q.Take(context.Background()); !errors.Is(err, ErrClosed) {
+		t.Fatalf("expected ErrClosed got %v", err)
+	}
+}
+
+func TestKeyedQueueProcess(t *testing.T) {
+	q := NewKeyedQueue[int, int]()
+	const keys, perKey = 8, 50
+	for i := 0; i < perKey; i++ {
+		for k := 0; k < keys; k++ {
+			q.Enqueue(k, i)
+		}
+	}
+	q.Close()
+
+	var mu sync.Mutex
+	last := make(map[int]int)
+	active := make(map[int]bool)
+	var total atomic.Int64
+	err := q.Process(context.Background(), 4, func(key, value int) {
+		mu.Lock()
+		if active[key] {
+			t.Errorf("key %d processed concurrently", key)
+		}
+		if prev, ok := last[key]; ok && value != prev+1 {
+			t.Errorf("key %d expected %d got %d", key, prev+1, value)
+		}
+		active[key] = true
+		last[key] = value
+		mu.Unlock()
+
+		total.Add(1)
+		mu.Lock()
+		active[key] = false
+		mu.Unlock()
+	})
+	if err != nil {
+		t.Fatalf("expected no error got %v", err)
+	}
+	if total.Load() != keys*perKey {
+		t.Fatalf("expected %d items got %d", keys*perKey, total.Load())
+	}
+}
+
+func TestKeyedQueueHotKeyBacklogStaysBounded(t *testing.T) {
+	q := NewKeyedQueue[string, int]()
+	q.Enqueue("hot", 0)
+	// The key always has an item waiting, so its backlog never empties
+	for i := 1; i <= 10000; i++ {
+		q.Enqueue("hot", i)
+		if _, v, ok := q.TryTake(); !ok || v != i-1 {
+			t.Fatalf("expected %d got %d %v", i-1, v, ok)
+		}
+		q.Done("hot")
+	}
+	if b := q.keys["hot"]; len(b.items) > 4 || len(q.ready) > 4 {
+		t.Fatalf("expected compacted backlogs got %d items %d ready", len(b.items), len(q.ready))
+	}
+}
@@ -0,0 +1,179 @@
+package queue
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrEmptyQueue     = errors.New("queue is empty")
+	ErrUnknownClass   = errors.New("unknown queue class")
+	ErrDuplicateClass = errors.New("queue class already exists")
+	ErrInvalidWeight  = errors.New("class weight must be positive")
+)
+
+type class[C comparable, T any] struct {
+	name    C
+	weight  int
+	deficit int
+	items   []T
+	head    int
+}
+
+func (c *class[C, T]) size() int {
+	return len(c.items) - c.head
+}
+
+func (c *class[C, T]) pop() T {
+	var item T
+	item, c.items, c.head = popFront(c.items, c.head)
+	return item
+}
+
+// popFront removes the item at head from a slice consumed from the front, returning the item and
+// the updated slice and head
+// The consumed prefix is reclaimed once it dominates the slice, so a slice that never empties
+// does not grow without bound
+func popFront[T any](items []T, head int) (T, []T, int) {
+	var zero T
+	item := items[head]
+	items[head] = zero
+	head++
+	if head > len(items)/2 {
+		n := copy(items, items[head:])
+		clear(items[n:])
+		items, head = items[:n], 0
+	}
+	return item, items, head
+}
+
+// MultiQueue holds one FIFO subqueue per class and dequeues across them with deficit round robin
+// Each visit grants a class its weight in credit and items are served while the credit covers their cost,
+// so with unit costs a class of weight 3 gets three items for every one of a class of weight 1
+// Empty classes give up their credit, which keeps idle classes from bursting later
+type MultiQueue[C comparable, T any] struct {
+	classes []*class[C, T]
+	index   map[C]int
+	cost    func(item T) int
+	current int
+	granted bool
+	size    int
+}
+
+// NewMultiQueue creates a new multi-queue where every item costs one unit of credit
+func NewMultiQueue[C comparable, T any]() *MultiQueue[C, T] {
+	return NewMultiQueueWithCost[C, T](func(T) int { return 1 })
+}
+
+// NewMultiQueueWithCost creates a new multi-queue where an item costs cost(item) units of credit,
+// for example its size in bytes
+func NewMultiQueueWithCost[C comparable, T any](cost func(item T) int) *MultiQueue[C, T] {
+	return &MultiQueue[C, T]{index: make(map[C]int), cost: cost}
+}
+
+// AddClass registers a class with the given weight, served in registration order
+// Returns error if the class exists or weight is not positive
+func (q *MultiQueue[C, T]) AddClass(name C, weight int) error {
+	if weight < 1 {
+		return fmt.Errorf("%w: %d", ErrInvalidWeight, weight)
+	}
+	if _, ok := q.index[name]; ok {
+		return fmt.Errorf("%w: %v", ErrDuplicateClass, name)
+	}
+	q.index[name] = len(q.classes)
+	q.classes = append(q.classes, &class[C, T]{name: name, weight: weight})
+	return nil
+}
+
+// Size returns the number of items across all classes
+func (q *MultiQueue[C, T]) Size() int {
+	return q.size
+}
+
+// IsEmpty checks if every class is empty
+func (q *MultiQueue[C, T]) IsEmpty() bool {
+	return q.size == 0
+}
+
+// SizeOf returns the number of items queued in a class
+// Returns error if the class does not exist
+func (q *MultiQueue[C, T]) SizeOf(name C) (int, error) {
+	c, err := q.class(name)
+	if err != nil {
+		return 0, err
+	}
+	return c.size(), nil
+}
+
+// Enqueue adds item to the back of a class
+// Returns error if the class does not exist
+func (q *MultiQueue[C, T]) Enqueue(name C, item T) error {
+	c, err := q.class(name)
+	if err != nil {
+		return err
+	}
+	c.items = append(c.items, item)
+	q.size++
+	return nil
+}
+
+// Dequeue removes and returns the next item chosen by deficit round robin along with its class
+// Returns error if every class is empty
+func (q *MultiQueue[C, T]) Dequeue() (C, T, error) {
+	if q.size == 0 {
+		var zeroC C
+		var zeroT T
+		return zeroC, zeroT, ErrEmptyQueue
+	}
+
+	for {
+		c := q.classes[q.current]
+		if c.size() == 0 {
+			c.deficit = 0
+			q.advance()
+			continue
+		}
+		if !q.granted {
+			c.deficit += c.weight
+			q.granted = true
+		}
+		if cost := q.cost(c.items[c.head]); c.deficit >= cost {
+			c.deficit -= cost
+			item := c.pop()
+			q.size--
+			if c.size() == 0 {
+				c.deficit = 0
+				q.advance()
+			}
+			return c.name, item, nil
+		}
+		q.advance()
+	}
+}
+
+// Clear removes every item while keeping the classes and their weights
+func (q *MultiQueue[C, T]) Clear() {
+	for _, c := range q.classes {
+		clear(c.items)
+		c.items = c.items[:0]
+		c.head = 0
+		c.deficit = 0
+	}
+	q.current = 0
+	q.granted = false
+	q.size = 0
+}
+
+func (q *MultiQueue[C, T]) class(name C) (*class[C, T], error) {
+	i, ok := q.index[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", ErrUnknownClass, name)
+	}
+	return q.classes[i], nil
+}
+
+// advance moves the round robin to the next class, which has not been granted its credit yet
+func (q *MultiQueue[C, T]) advance() {
+	q.current = (q.current + 1) % len(q.classes)
+	q.granted = false
+}
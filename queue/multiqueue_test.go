@@ -0,0 +1,98 @@
+package queue
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestMultiQueueWeightedOrder(t *testing.T) {
+	q := NewMultiQueue[string, int]()
+	q.AddClass("gold", 3)
+	q.AddClass("bronze", 1)
+	for i := 0; i < 6; i++ {
+		q.Enqueue("gold", i)
+		q.Enqueue("bronze", 100+i)
+	}
+	if q.Size() != 12 {
+		t.Fatalf("expected size 12 got %d", q.Size())
+	}
+
+	var order []string
+	for i := 0; i < 8; i++ {
+		class, _, err := q.Dequeue()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		order = append(order, class[:1])
+	}
+	if got := strings.Join(order, ""); got != "gggbgggb" {
+		t.Fatalf("unexpected service order %s", got)
+	}
+	if n, _ := q.SizeOf("bronze"); n != 4 {
+		t.Fatalf("expected 4 bronze items left got %d", n)
+	}
+}
+
+func TestMultiQueueFIFOWithinClass(t *testing.T) {
+	q := NewMultiQueue[int, string]()
+	q.AddClass(1, 1)
+	for _, s := range []string{"a", "b", "c"} {
+		q.Enqueue(1, s)
+	}
+	var got string
+	for !q.IsEmpty() {
+		_, s, _ := q.Dequeue()
+		got += s
+	}
+	if got != "abc" {
+		t.Fatalf("expected FIFO order got %s", got)
+	}
+	if _, _, err := q.Dequeue(); !errors.Is(err, ErrEmptyQueue) {
+		t.Fatalf("expected ErrEmptyQueue got %v", err)
+	}
+}
+
+func TestMultiQueueDeficitCost(t *testing.T) {
+	// Byte-sized items: the bulk class sends one large item per round,
+	// the interactive class several small ones
+	q := NewMultiQueueWithCost[string, string](func(s string) int { return len(s) })
+	q.AddClass("bulk", 4)
+	q.AddClass("interactive", 4)
+	q.Enqueue("bulk", "xxxxxxxx")
+	q.Enqueue("bulk", "yyyyyyyy")
+	for i := 0; i < 8; i++ {
+		q.Enqueue("interactive", "k")
+	}
+
+	var order []string
+	for !q.IsEmpty() {
+		_, item, _ := q.Dequeue()
+		order = append(order, item)
+	}
+	if got := strings.Join(order, " "); got != "k k k k xxxxxxxx k k k k yyyyyyyy" {
+		t.Fatalf("unexpected order %s", got)
+	}
+}
+
+func TestMultiQueueErrors(t *testing.T) {
+	q := NewMultiQueue[string, int]()
+	if err := q.AddClass("a", 0); !errors.Is(err, ErrInvalidWeight) {
+		t.Fatalf("expected ErrInvalidWeight got %v", err)
+	}
+	q.AddClass("a", 1)
+	if err := q.AddClass("a", 2); !errors.Is(err, ErrDuplicateClass) {
+		t.Fatalf("expected ErrDuplicateClass got %v", err)
+	}
+	if err := q.Enqueue("b", 1); !errors.Is(err, ErrUnknownClass) {
+		t.Fatalf("expected ErrUnknownClass got %v", err)
+	}
+	if _, err := q.SizeOf("b"); !errors.Is(err, ErrUnknownClass) {
+		t.Fatalf("expected ErrUnknownClass got %v", err)
+	}
+	q.Enqueue("a", 1)
+	q.Clear()
+	if !q.IsEmpty() {
+		t.Fatalf("expected empty queue after clear")
+	}
+}
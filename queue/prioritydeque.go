@@ -0,0 +1,180 @@
+package queue
+
+import (
+	"cmp"
+	"container/heap"
+
+	"github.com/profoundwu/containers/compare"
+	"github.com/profoundwu/containers/internal/utils"
+)
+
+type pdEntry[P any, T any] struct {
+	priority P
+	value    T
+	seq      uint64
+	// index[0] is the position in the min heap, index[1] in the max heap
+	index [2]int
+}
+
+// pdHeap is one side of a PriorityDeque, ordered by priority in its direction and then by insertion
+type pdHeap[P any, T any] struct {
+	entries []*pdEntry[P, T]
+	compare compare.Comparator[P]
+	side    int
+}
+
+func (h *pdHeap[P, T]) Len() int { return len(h.entries) }
+
+func (h *pdHeap[P, T]) Less(i, j int) bool {
+	a, b := h.entries[i], h.entries[j]
+	c := h.compare(a.priority, b.priority)
+	if h.side == 1 {
+		c = -c
+	}
+	if c != 0 {
+		return c < 0
+	}
+	return a.seq < b.seq
+}
+
+func (h *pdHeap[P, T]) Swap(i, j int) {
+	h.entries[i], h.entries[j] = h.entries[j], h.entries[i]
+	h.entries[i].index[h.side] = i
+	h.entries[j].index[h.side] = j
+}
+
+func (h *pdHeap[P, T]) Push(x any) {
+	e := x.(*pdEntry[P, T])
+	e.index[h.side] = len(h.entries)
+	h.entries = append(h.entries, e)
+}
+
+func (h *pdHeap[P, T]) Pop() any {
+	n := len(h.entries)
+	e := h.entries[n-1]
+	h.entries[n-1] = nil
+	h.entries = h.entries[:n-1]
+	return e
+}
+
+// PriorityDeque is a double-ended priority queue that can serve both its lowest and highest priority items
+// Items of equal priority leave in insertion order from either end, which keeps job queues fair
+type PriorityDeque[P any, T any] struct {
+	min pdHeap[P, T]
+	max pdHeap[P, T]
+	seq uint64
+}
+
+// NewPriorityDeque creates a new empty priority deque for ordered priorities
+func NewPriorityDeque[P cmp.Ordered, T any]() *PriorityDeque[P, T] {
+	return NewPriorityDequeWithComparator[P, T](compare.Natural[P]())
+}
+
+// NewPriorityDequeWithComparator creates a new empty priority deque ordering priorities with comparator,
+// which returns a negative number when a is lower than b, zero when equal and a positive number otherwise
+func NewPriorityDequeWithComparator[P any, T any](comparator compare.Comparator[P]) *PriorityDeque[P, T] {
+	return &PriorityDeque[P, T]{
+		min: pdHeap[P, T]{compare: comparator, side: 0},
+		max: pdHeap[P, T]{compare: comparator, side: 1},
+	}
+}
+
+// Size returns the number of items in the deque
+func (d *PriorityDeque[P, T]) Size() int {
+	return d.min.Len()
+}
+
+// IsEmpty checks if the deque is empty
+func (d *PriorityDeque[P, T]) IsEmpty() bool {
+	return d.min.Len() == 0
+}
+
+// Push adds value with the given priority
+func (d *PriorityDeque[P, T]) Push(priority P, value T) {
+	e := &pdEntry[P, T]{priority: priority, value: value, seq: d.seq}
+	d.seq++
+	heap.Push(&d.min, e)
+	heap.Push(&d.max, e)
+}
+
+// PeekMin returns the lowest priority item, the earliest inserted among equals, without removing it
+// Returns error if deque is empty
+func (d *PriorityDeque[P, T]) PeekMin() (P, T, error) {
+	return d.peek(&d.min)
+}
+
+// PeekMax returns the highest priority item, the earliest inserted among equals, without removing it
+// Returns error if deque is empty
+func (d *PriorityDeque[P, T]) PeekMax() (P, T, error) {
+	return d.peek(&d.max)
+}
+
+// PopMin removes and returns the lowest priority item, the earliest inserted among equals
+// Returns error if deque is empty
+func (d *PriorityDeque[P, T]) PopMin() (P, T, error) {
+	return d.pop(&d.min, &d.max)
+}
+
+// PopMax removes and returns the highest priority item, the earliest inserted among equals
+// Returns error if deque is empty
+func (d *PriorityDeque[P, T]) PopMax() (P, T, error) {
+	return d.pop(&d.max, &d.min)
+}
+
+// MustPeekMin returns the lowest priority item without removing it, panicking if the deque is empty
+func (d *PriorityDeque[P, T]) MustPeekMin() (P, T) {
+	priority, value, err := d.PeekMin()
+	utils.MustSucceed("MustPeekMin", err)
+	return priority, value
+}
+
+// MustPeekMax returns the highest priority item without removing it, panicking if the deque is empty
+func (d *PriorityDeque[P, T]) MustPeekMax() (P, T) {
+	priority, value, err := d.PeekMax()
+	utils.MustSucceed("MustPeekMax", err)
+	return priority, value
+}
+
+// MustPopMin removes and returns the lowest priority item, panicking if the deque is empty
+func (d *PriorityDeque[P, T]) MustPopMin() (P, T) {
+	priority, value, err := d.PopMin()
+	utils.MustSucceed("MustPopMin", err)
+	return priority, value
+}
+
+// MustPopMax removes and returns the highest priority item, panicking if the deque is empty
+func (d *PriorityDeque[P, T]) MustPopMax() (P, T) {
+	priority, value, err := d.PopMax()
+	utils.MustSucceed("MustPopMax", err)
+	return priority, value
+}
+
+// Clear removes all items from the deque
+func (d *PriorityDeque[P, T]) Clear() {
+	clear(d.min.entries)
+	clear(d.max.entries)
+	d.min.entries = d.min.entries[:0]
+	d.max.entries = d.max.entries[:0]
+}
+
+func (d *PriorityDeque[P, T]) peek(h *pdHeap[P, T]) (P, T, error) {
+	if h.Len() == 0 {
+		var zeroP P
+		var zeroT T
+		return zeroP, zeroT, ErrEmptyQueue
+	}
+	e := h.entries[0]
+	return e.priority, e.value, nil
+}
+
+// pop removes the top of h and the same entry from the opposite heap
+func (d *PriorityDeque[P, T]) pop(h, other *pdHeap[P, T]) (P, T, error) {
+	if h.Len() == 0 {
+		var zeroP P
+		var zeroT T
+		return zeroP, zeroT, ErrEmptyQueue
+	}
+	e := heap.Pop(h).(*pdEntry[P, T])
+	heap.Remove(other, e.index[other.side])
+	return e.priority, e.value, nil
+}
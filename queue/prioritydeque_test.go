@@ -0,0 +1,108 @@
+package queue
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestPriorityDequeFIFOTiebreak(t *testing.T) {
+	d := NewPriorityDeque[int, string]()
+	if _, _, err := d.PopMin(); !errors.Is(err, ErrEmptyQueue) {
+		t.Fatalf("expected ErrEmptyQueue got %v", err)
+	}
+	d.Push(1, "low-a")
+	d.Push(5, "high-a")
+	d.Push(1, "low-b")
+	d.Push(5, "high-b")
+	d.Push(3, "mid")
+
+	if p, v, _ := d.PeekMin(); p != 1 || v != "low-a" {
+		t.Fatalf("expected low-a got %d %s", p, v)
+	}
+	if p, v, _ := d.PeekMax(); p != 5 || v != "high-a" {
+		t.Fatalf("expected high-a got %d %s", p, v)
+	}
+
+	var order []string
+	for _, pop := range []func() (int, string, error){d.PopMax, d.PopMin, d.PopMax, d.PopMin, d.PopMax} {
+		_, v, err := pop()
+		if err != nil {
+			t.Fatalf("expected no error got %v", err)
+		}
+		order = append(order, v)
+	}
+	if !slices.Equal(order, []string{"high-a", "low-a", "high-b", "low-b", "mid"}) {
+		t.Fatalf("unexpected order %v", order)
+	}
+	if !d.IsEmpty() {
+		t.Fatalf("expected empty deque")
+	}
+}
+
+func TestPriorityDequeMust(t *testing.T) {
+	d := NewPriorityDeque[int, string]()
+	d.Push(2, "b")
+	d.Push(1, "a")
+	if p, v := d.MustPeekMax(); p != 2 || v != "b" {
+		t.Fatalf("expected b got %d %s", p, v)
+	}
+	if _, v := d.MustPopMin(); v != "a" {
+		t.Fatalf("expected a got %s", v)
+	}
+	d.MustPopMax()
+	defer func() {
+		if err, _ := recover().(error); !errors.Is(err, ErrEmptyQueue) {
+			t.Fatalf("expected panic with ErrEmptyQueue got %v", err)
+		}
+	}()
+	d.MustPeekMin()
+}
+
+func TestPriorityDequeRandomized(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	d := NewPriorityDequeWithComparator[int, int](func(a, b int) int { return a - b })
+	type item struct{ priority, seq int }
+	var model []item
+
+	for step := 0; step < 2000; step++ {
+		if r.Intn(3) > 0 || len(model) == 0 {
+			p := r.Intn(10)
+			d.Push(p, step)
+			model = append(model, item{p, step})
+			continue
+		}
+
+		// Model: stable sort by priority, then take either end, earliest insertion among equals
+		slices.SortStableFunc(model, func(a, b item) int { return a.priority - b.priority })
+		var want item
+		var got int
+		var err error
+		if r.Intn(2) == 0 {
+			want = model[0]
+			model = model[1:]
+			_, got, err = d.PopMin()
+		} else {
+			last := len(model) - 1
+			first := last
+			for first > 0 && model[first-1].priority == model[last].priority {
+				first--
+			}
+			want = model[first]
+			model = slices.Delete(model, first, first+1)
+			_, got, err = d.PopMax()
+		}
+		if err != nil || got != want.seq {
+			t.Fatalf("step %d: expected %d got %d (%v)", step, want.seq, got, err)
+		}
+		if d.Size() != len(model) {
+			t.Fatalf("expected size %d got %d", len(model), d.Size())
+		}
+	}
+
+	d.Clear()
+	if !d.IsEmpty() {
+		t.Fatalf("expected empty deque after Clear")
+	}
+}
@@ -0,0 +1,129 @@
+package queue
+
+import (
+	"context"
+	"sync"
+
+	"github.com/profoundwu/containers/internal/utils"
+)
+
+// ring is the bounded FIFO behind Handoff and BlockingQueue: a ring buffer guarded by mu
+// with a signal that wakes goroutines waiting for items or room
+// Methods other than init expect the caller to hold mu
+type ring[T any] struct {
+	mu       sync.Mutex
+	items    []T
+	head     int
+	count    int
+	capacity int
+	closed   bool
+	// signal is closed and replaced whenever a waiter might be able to make progress
+	signal chan struct{}
+}
+
+// init sets up an empty ring holding up to capacity items
+// Values of capacity below 1 fall back to the default capacity
+func (r *ring[T]) init(capacity int) {
+	if capacity < 1 {
+		capacity = utils.DefaultCapacity
+	}
+	r.items, r.capacity, r.signal = make([]T, capacity), capacity, make(chan struct{})
+}
+
+func (r *ring[T]) isFull() bool {
+	return r.count >= r.capacity
+}
+
+// awaitRoom waits until the ring has room, calling onBlock once if it has to wait
+// Returns ErrClosed if the ring is closed, or the context's error if ctx ends first
+func (r *ring[T]) awaitRoom(ctx context.Context, onBlock func()) error {
+	blocked := false
+	for !r.closed && r.isFull() {
+		if !blocked && onBlock != nil {
+			onBlock()
+		}
+		blocked = true
+		if err := r.wait(ctx); err != nil {
+			return err
+		}
+	}
+	if r.closed {
+		return ErrClosed
+	}
+	return nil
+}
+
+// awaitItem waits until the ring holds an item
+// Returns ErrClosed once the ring is closed and drained, or the context's error if ctx ends first
+func (r *ring[T]) awaitItem(ctx context.Context) error {
+	for r.count == 0 {
+		if r.closed {
+			return ErrClosed
+		}
+		if err := r.wait(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// push stores value at the tail and returns its slot, the caller has checked for room
+func (r *ring[T]) push(value T) int {
+	tail := (r.head + r.count) % len(r.items)
+	r.items[tail] = value
+	r.count++
+	r.notify()
+	return tail
+}
+
+// pop removes the head and returns it with the slot it held, the caller has checked that the ring
+// is not empty
+func (r *ring[T]) pop() (T, int) {
+	var zero T
+	slot := r.head
+	value := r.items[slot]
+	r.items[slot] = zero
+	r.head = (r.head + 1) % len(r.items)
+	r.count--
+	r.notify()
+	return value, slot
+}
+
+// resize changes the capacity, keeping every item even when there are more than capacity
+func (r *ring[T]) resize(capacity int) {
+	r.capacity = capacity
+	if size := max(capacity, r.count); size != len(r.items) {
+		items := make([]T, size)
+		for i := 0; i < r.count; i++ {
+			items[i] = r.items[(r.head+i)%len(r.items)]
+		}
+		r.items, r.head = items, 0
+	}
+	r.notify()
+}
+
+// close stops the ring from accepting items and wakes every waiter
+func (r *ring[T]) close() {
+	if !r.closed {
+		r.closed = true
+		r.notify()
+	}
+}
+
+func (r *ring[T]) notify() {
+	close(r.signal)
+	r.signal = make(chan struct{})
+}
+
+// wait releases the lock until the next notify or until ctx is done
+func (r *ring[T]) wait(ctx context.Context) error {
+	signal := r.signal
+	r.mu.Unlock()
+	defer r.mu.Lock()
+	select {
+	case <-signal:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,42 @@
+package queue
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrInvariantViolated = errors.New("queue invariant violated")
+)
+
+// Validate checks the internal invariants of the priority deque: both heaps hold the same entries,
+// every entry knows its position in each heap, and neither heap has a child ordered before its parent
+// It is meant for tests and debugging; a deque only changed through its methods always passes
+// Returns error describing the first violated invariant
+func (d *PriorityDeque[P, T]) Validate() error {
+	if len(d.min.entries) != len(d.max.entries) {
+		return fmt.Errorf("%w: min heap holds %d entries, max heap %d",
+			ErrInvariantViolated, len(d.min.entries), len(d.max.entries))
+	}
+	for _, h := range [2]*pdHeap[P, T]{&d.min, &d.max} {
+		other := &d.max
+		if h.side == 1 {
+			other = &d.min
+		}
+		for i, e := range h.entries {
+			if e.index[h.side] != i {
+				return fmt.Errorf("%w: entry at %d of heap %d records index %d",
+					ErrInvariantViolated, i, h.side, e.index[h.side])
+			}
+			if j := e.index[other.side]; j < 0 || j >= len(other.entries) || other.entries[j] != e {
+				return fmt.Errorf("%w: entry at %d of heap %d is missing from heap %d",
+					ErrInvariantViolated, i, h.side, other.side)
+			}
+			if i > 0 && h.Less(i, (i-1)/2) {
+				return fmt.Errorf("%w: entry at %d of heap %d is ordered before its parent",
+					ErrInvariantViolated, i, h.side)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package queue
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestPriorityDequeValidate(t *testing.T) {
+	r := rand.New(rand.NewSource(4))
+	d := NewPriorityDeque[int, int]()
+	for step := 0; step < 1000; step++ {
+		switch r.Intn(4) {
+		case 0:
+			d.PopMin()
+		case 1:
+			d.PopMax()
+		default:
+			d.Push(r.Intn(50), step)
+		}
+		if err := d.Validate(); err != nil {
+			t.Fatalf("step %d: %v", step, err)
+		}
+	}
+
+	d.min.entries[0], d.min.entries[len(d.min.entries)-1] = d.min.entries[len(d.min.entries)-1], d.min.entries[0]
+	if err := d.Validate(); !errors.Is(err, ErrInvariantViolated) {
+		t.Fatalf("expected ErrInvariantViolated got %v", err)
+	}
+}
@@ -0,0 +1,107 @@
+package set
+
+import (
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Builder accumulates elements cheaply and turns them into a read-only set in one step, for static
+// data loaded at startup
+// Adding appends without hashing, and Freeze sizes the set's table once for every element added
+type Builder[T comparable] struct {
+	elems []T
+}
+
+// NewBuilder creates a new empty set builder
+func NewBuilder[T comparable]() *Builder[T] {
+	return &Builder[T]{}
+}
+
+// Size returns the number of elements added since the last Freeze, counting repeated elements
+func (b *Builder[T]) Size() int {
+	return len(b.elems)
+}
+
+// Grow makes room for at least n more elements, so the next n additions do not reallocate
+func (b *Builder[T]) Grow(n int) {
+	if n > 0 {
+		b.elems = slices.Grow(b.elems, n)
+	}
+}
+
+// Add appends elems, which may repeat
+func (b *Builder[T]) Add(elems ...T) {
+	b.elems = append(b.elems, elems...)
+}
+
+// Freeze builds a new read-only set of the distinct elements added and empties the builder
+// Later additions do not affect the set
+func (b *Builder[T]) Freeze() *FrozenSet[T] {
+	elems := make(map[T]struct{}, len(b.elems))
+	for _, elem := range b.elems {
+		elems[elem] = struct{}{}
+	}
+	b.elems = nil
+	return &FrozenSet[T]{elems: elems}
+}
+
+// FrozenSet is a read-only set produced by Builder
+// It has no mutating methods, so it can be shared by multiple goroutines without locking
+type FrozenSet[T comparable] struct {
+	elems map[T]struct{}
+}
+
+// Size returns the number of elements in the set
+func (s *FrozenSet[T]) Size() int {
+	return len(s.elems)
+}
+
+// IsEmpty checks if the set is empty
+func (s *FrozenSet[T]) IsEmpty() bool {
+	return len(s.elems) == 0
+}
+
+// Contains checks if elem is in the set
+func (s *FrozenSet[T]) Contains(elem T) bool {
+	_, ok := s.elems[elem]
+	return ok
+}
+
+// Values returns a sequence of the elements in no particular order
+func (s *FrozenSet[T]) Values() iter.Seq[T] {
+	return maps.Keys(s.elems)
+}
+
+// ForEach calls fn for every element in no particular order
+func (s *FrozenSet[T]) ForEach(fn func(elem T)) {
+	for elem := range s.elems {
+		fn(elem)
+	}
+}
+
+// ToSlice returns the elements in no particular order
+func (s *FrozenSet[T]) ToSlice() []T {
+	return slices.AppendSeq(make([]T, 0, len(s.elems)), s.Values())
+}
+
+// String returns a string representation of the set
+func (s *FrozenSet[T]) String() string {
+	var sb strings.Builder
+	sb.WriteString("{")
+	for i, elem := range s.ToSlice() {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%v", elem))
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// MarshalText implements encoding.TextMarshaler with the String rendering
+func (s *FrozenSet[T]) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
@@ -0,0 +1,38 @@
+package set
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBuilder(t *testing.T) {
+	b := NewBuilder[string]()
+	b.Grow(4)
+	b.Add("b", "a")
+	b.Add("b", "c")
+	if b.Size() != 4 {
+		t.Fatalf("expected 4 elements got %d", b.Size())
+	}
+	s := b.Freeze()
+	if s.Size() != 3 || !s.Contains("a") || s.Contains("d") || b.Size() != 0 {
+		t.Fatalf("expected 3 distinct elements and an empty builder got %s %d", s, b.Size())
+	}
+	got := slices.Sorted(s.Values())
+	if !slices.Equal(got, []string{"a", "b", "c"}) {
+		t.Fatalf("expected [a b c] got %v", got)
+	}
+	n := 0
+	s.ForEach(func(string) { n++ })
+	if n != 3 || len(s.ToSlice()) != 3 {
+		t.Fatalf("expected 3 elements visited got %d", n)
+	}
+
+	// Later additions do not reach the frozen set
+	b.Add("d")
+	if s.Contains("d") {
+		t.Fatalf("expected the frozen set to be independent of the builder")
+	}
+	if empty := NewBuilder[int]().Freeze(); !empty.IsEmpty() || empty.String() != "{}" {
+		t.Fatalf("expected an empty set got %s", empty)
+	}
+}
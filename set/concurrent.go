@@ -0,0 +1,134 @@
+package set
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+	"sync"
+)
+
+// ConcurrentSet is a set that is safe for use by multiple goroutines
+// Add and Remove report whether they changed the set, so concurrent workers can use them
+// to claim an element exactly once
+type ConcurrentSet[T comparable] struct {
+	mu    sync.RWMutex
+	elems map[T]struct{}
+}
+
+// NewConcurrentSet creates a new empty concurrent set
+func NewConcurrentSet[T comparable]() *ConcurrentSet[T] {
+	return &ConcurrentSet[T]{elems: make(map[T]struct{})}
+}
+
+// NewConcurrentSetFromSeq creates a concurrent set holding the distinct values of seq
+func NewConcurrentSetFromSeq[T comparable](seq iter.Seq[T]) *ConcurrentSet[T] {
+	s := NewConcurrentSet[T]()
+	for v := range seq {
+		s.elems[v] = struct{}{}
+	}
+	return s
+}
+
+// Size returns the number of elements in the set
+func (s *ConcurrentSet[T]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.elems)
+}
+
+// IsEmpty checks if the set is empty
+func (s *ConcurrentSet[T]) IsEmpty() bool {
+	return s.Size() == 0
+}
+
+// Add inserts elem
+// Returns true if the element was not already present
+func (s *ConcurrentSet[T]) Add(elem T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.elems[elem]; ok {
+		return false
+	}
+	s.elems[elem] = struct{}{}
+	return true
+}
+
+// AddAll inserts every element of elems under a single lock
+// Returns the number of elements that were not already present
+func (s *ConcurrentSet[T]) AddAll(elems ...T) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	added := 0
+	for _, elem := range elems {
+		if _, ok := s.elems[elem]; !ok {
+			s.elems[elem] = struct{}{}
+			added++
+		}
+	}
+	return added
+}
+
+// Remove deletes elem
+// Returns true if the element was present
+func (s *ConcurrentSet[T]) Remove(elem T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.elems[elem]; !ok {
+		return false
+	}
+	delete(s.elems, elem)
+	return true
+}
+
+// Contains checks if elem is in the set
+func (s *ConcurrentSet[T]) Contains(elem T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, ok := s.elems[elem]
+	return ok
+}
+
+// Clear removes every element
+func (s *ConcurrentSet[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	clear(s.elems)
+}
+
+// ForEach calls fn for a snapshot of the elements in no particular order
+// fn runs without the lock held, so it may use the set
+func (s *ConcurrentSet[T]) ForEach(fn func(elem T)) {
+	for _, elem := range s.ToSlice() {
+		fn(elem)
+	}
+}
+
+// ToSlice returns the elements in no particular order
+func (s *ConcurrentSet[T]) ToSlice() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	elems := make([]T, 0, len(s.elems))
+	for elem := range s.elems {
+		elems = append(elems, elem)
+	}
+	return elems
+}
+
+// String returns a string representation of the set
+func (s *ConcurrentSet[T]) String() string {
+	var sb strings.Builder
+	sb.WriteString("{")
+	for i, elem := range s.ToSlice() {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%v", elem))
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// MarshalText implements encoding.TextMarshaler with the String rendering
+func (s *ConcurrentSet[T]) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
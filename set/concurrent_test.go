@@ -0,0 +1,72 @@
+package set
+
+import (
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestConcurrentSet(t *testing.T) {
+	s := NewConcurrentSet[string]()
+	if !s.Add("a") || s.Add("a") {
+		t.Fatalf("expected only the first Add to insert")
+	}
+	if n := s.AddAll("a", "b", "c", "b"); n != 2 {
+		t.Fatalf("expected 2 added got %d", n)
+	}
+	if s.Size() != 3 || !s.Contains("c") || s.Contains("d") {
+		t.Fatalf("unexpected contents %v", s)
+	}
+	if !s.Remove("b") || s.Remove("b") {
+		t.Fatalf("expected only the first Remove to delete")
+	}
+	got := s.ToSlice()
+	slices.Sort(got)
+	if !slices.Equal(got, []string{"a", "c"}) {
+		t.Fatalf("expected [a c] got %v", got)
+	}
+	s.ForEach(func(elem string) { s.Remove(elem) })
+	if !s.IsEmpty() {
+		t.Fatalf("expected ForEach to allow removal got %v", s)
+	}
+	s.Add("x")
+	if s.String() != "{x}" {
+		t.Fatalf("expected {x} got %s", s)
+	}
+	s.Clear()
+	if s.Size() != 0 {
+		t.Fatalf("expected an empty set")
+	}
+}
+
+func TestNewConcurrentSetFromSeq(t *testing.T) {
+	s := NewConcurrentSetFromSeq(slices.Values([]int{3, 1, 3}))
+	got := s.ToSlice()
+	slices.Sort(got)
+	if !slices.Equal(got, []int{1, 3}) {
+		t.Fatalf("expected [1 3] got %v", got)
+	}
+}
+
+func TestConcurrentSetClaimsOnce(t *testing.T) {
+	s := NewConcurrentSet[int]()
+	var claimed atomic.Int64
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				if s.Add(i) {
+					claimed.Add(1)
+				}
+				s.Contains(i)
+			}
+		}()
+	}
+	wg.Wait()
+	if claimed.Load() != 1000 || s.Size() != 1000 {
+		t.Fatalf("expected each element claimed once got %d claims, size %d", claimed.Load(), s.Size())
+	}
+}
@@ -0,0 +1,69 @@
+package set
+
+// DisjointSet partitions elements into disjoint sets that can be merged, a union-find forest with
+// path compression and union by rank, so Find and Union run in near constant amortized time
+// Elements join as singleton sets the first time they are passed to Find, Union or Connected
+type DisjointSet[T comparable] struct {
+	parent map[T]T
+	rank   map[T]int
+	sets   int
+}
+
+// NewDisjointSet creates a new empty disjoint set
+func NewDisjointSet[T comparable]() *DisjointSet[T] {
+	return &DisjointSet[T]{
+		parent: make(map[T]T),
+		rank:   make(map[T]int),
+	}
+}
+
+// Size returns the number of elements across all sets
+func (ds *DisjointSet[T]) Size() int {
+	return len(ds.parent)
+}
+
+// Sets returns the number of disjoint sets
+func (ds *DisjointSet[T]) Sets() int {
+	return ds.sets
+}
+
+// Find returns the representative of the set containing v
+func (ds *DisjointSet[T]) Find(v T) T {
+	p, ok := ds.parent[v]
+	if !ok {
+		ds.parent[v] = v
+		ds.sets++
+		return v
+	}
+	if p == v {
+		return v
+	}
+	root := ds.Find(p)
+	ds.parent[v] = root
+	return root
+}
+
+// Union merges the sets containing a and b
+// Returns false if they were already in the same set
+func (ds *DisjointSet[T]) Union(a, b T) bool {
+	ra, rb := ds.Find(a), ds.Find(b)
+	if ra == rb {
+		return false
+	}
+	switch {
+	case ds.rank[ra] < ds.rank[rb]:
+		ds.parent[ra] = rb
+	case ds.rank[ra] > ds.rank[rb]:
+		ds.parent[rb] = ra
+	default:
+		ds.parent[rb] = ra
+		ds.rank[ra]++
+	}
+	ds.sets--
+	return true
+}
+
+// Connected checks if a and b are in the same set
+func (ds *DisjointSet[T]) Connected(a, b T) bool {
+	return ds.Find(a) == ds.Find(b)
+}
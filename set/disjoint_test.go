@@ -0,0 +1,46 @@
+package set
+
+import "testing"
+
+func TestDisjointSet(t *testing.T) {
+	ds := NewDisjointSet[string]()
+	if ds.Size() != 0 || ds.Sets() != 0 {
+		t.Fatalf("expected an empty disjoint set")
+	}
+	if !ds.Union("a", "b") || !ds.Union("c", "d") || ds.Union("b", "a") {
+		t.Fatalf("expected only unions of separate sets to succeed")
+	}
+	if ds.Size() != 4 || ds.Sets() != 2 {
+		t.Fatalf("expected 4 elements in 2 sets got %d %d", ds.Size(), ds.Sets())
+	}
+	if !ds.Connected("a", "b") || ds.Connected("a", "c") {
+		t.Fatalf("unexpected connectivity")
+	}
+	ds.Union("b", "d")
+	if !ds.Connected("a", "c") || ds.Find("d") != ds.Find("a") || ds.Sets() != 1 {
+		t.Fatalf("expected every element in one set")
+	}
+	if ds.Find("e") != "e" || ds.Sets() != 2 {
+		t.Fatalf("expected a new singleton set got %d sets", ds.Sets())
+	}
+}
+
+func TestDisjointSetChain(t *testing.T) {
+	ds := NewDisjointSet[int]()
+	for i := 1; i < 1000; i++ {
+		ds.Union(i-1, i)
+	}
+	if ds.Sets() != 1 || !ds.Connected(0, 999) {
+		t.Fatalf("expected one set got %d", ds.Sets())
+	}
+	// Ranks keep the forest shallow, so every element reaches the root in a few steps
+	for i := 0; i < 1000; i++ {
+		depth := 0
+		for v := i; ds.parent[v] != v; v = ds.parent[v] {
+			depth++
+		}
+		if depth > 10 {
+			t.Fatalf("expected a shallow forest got depth %d for %d", depth, i)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package set
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrOutOfUniverse = errors.New("id outside of the sparse set universe")
+)
+
+// SparseSet is a set of integer ids in the universe [0, universe) backed by a sparse/dense array pair
+// Add, Remove, Contains and Clear are O(1) and the members sit contiguously for fast iteration
+// Members are kept in insertion order until a Remove moves the last member into the freed slot
+type SparseSet struct {
+	sparse []int
+	dense  []int
+	size   int
+}
+
+// NewSparseSet creates a new empty sparse set for ids in [0, universe)
+// Values of universe below 0 are treated as an empty universe
+func NewSparseSet(universe int) *SparseSet {
+	universe = max(universe, 0)
+	return &SparseSet{
+		sparse: make([]int, universe),
+		dense:  make([]int, universe),
+	}
+}
+
+// Universe returns the exclusive upper bound on ids the set can hold
+func (s *SparseSet) Universe() int {
+	return len(s.sparse)
+}
+
+// Size returns the number of ids in the set
+func (s *SparseSet) Size() int {
+	return s.size
+}
+
+// IsEmpty checks if the set is empty
+func (s *SparseSet) IsEmpty() bool {
+	return s.size == 0
+}
+
+// Add inserts id into the set
+// Returns true if id was not already present
+// Returns error if id is outside of the universe
+func (s *SparseSet) Add(id int) (bool, error) {
+	if id < 0 || id >= len(s.sparse) {
+		return false, fmt.Errorf("%w: %d, universe: %d", ErrOutOfUniverse, id, len(s.sparse))
+	}
+	if s.Contains(id) {
+		return false, nil
+	}
+	s.sparse[id] = s.size
+	s.dense[s.size] = id
+	s.size++
+	return true, nil
+}
+
+// Remove deletes id from the set
+// Returns true if id was present, false otherwise
+func (s *SparseSet) Remove(id int) bool {
+	if !s.Contains(id) {
+		return false
+	}
+	// Move the last member into the freed slot
+	index := s.sparse[id]
+	last := s.dense[s.size-1]
+	s.dense[index] = last
+	s.sparse[last] = index
+	s.size--
+	return true
+}
+
+// Contains checks if id is in the set
+func (s *SparseSet) Contains(id int) bool {
+	if id < 0 || id >= len(s.sparse) {
+		return false
+	}
+	index := s.sparse[id]
+	// sparse may hold stale indexes after Remove or Clear, so confirm against dense
+	return index < s.size && s.dense[index] == id
+}
+
+// Clear removes all ids from the set in O(1)
+func (s *SparseSet) Clear() {
+	s.size = 0
+}
+
+// ForEach calls fn for every id in the set in dense order
+// fn must not add or remove ids
+func (s *SparseSet) ForEach(fn func(id int)) {
+	for _, id := range s.dense[:s.size] {
+		fn(id)
+	}
+}
+
+// ToSlice returns the ids of the set in dense order
+func (s *SparseSet) ToSlice() []int {
+	slice := make([]int, s.size)
+	copy(slice, s.dense[:s.size])
+	return slice
+}
+
+// String returns a string representation of the set in dense order
+func (s *SparseSet) String() string {
+	var sb strings.Builder
+	sb.WriteString("{")
+	for i, id := range s.dense[:s.size] {
+		sb.WriteString(fmt.Sprintf("%d", id))
+		if i < s.size-1 {
+			sb.WriteString(", ")
+		}
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
@@ -0,0 +1,95 @@
+package set
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestSparseSetAddRemove(t *testing.T) {
+	s := NewSparseSet(10)
+	if s.Universe() != 10 || !s.IsEmpty() {
+		t.Fatalf("expected empty set over universe 10")
+	}
+	for _, id := range []int{3, 7, 1} {
+		if added, err := s.Add(id); !added || err != nil {
+			t.Fatalf("expected %d to be added got added=%v err=%v", id, added, err)
+		}
+	}
+	if added, _ := s.Add(7); added {
+		t.Fatalf("duplicate add should report false")
+	}
+	if _, err := s.Add(10); !errors.Is(err, ErrOutOfUniverse) {
+		t.Fatalf("expected ErrOutOfUniverse got %v", err)
+	}
+	if _, err := s.Add(-1); !errors.Is(err, ErrOutOfUniverse) {
+		t.Fatalf("expected ErrOutOfUniverse got %v", err)
+	}
+	if s.String() != "{3, 7, 1}" {
+		t.Fatalf("unexpected string %s", s)
+	}
+
+	if !s.Remove(3) || s.Remove(3) || s.Contains(3) {
+		t.Fatalf("expected a single successful remove")
+	}
+	if s.String() != "{1, 7}" {
+		t.Fatalf("last member should fill the freed slot got %s", s)
+	}
+	if s.Contains(-5) || s.Contains(42) {
+		t.Fatalf("out of universe ids are never members")
+	}
+}
+
+func TestSparseSetClear(t *testing.T) {
+	s := NewSparseSet(5)
+	s.Add(1)
+	s.Add(4)
+	s.Clear()
+	if !s.IsEmpty() || s.Contains(1) || s.Contains(4) {
+		t.Fatalf("expected empty set after clear")
+	}
+	s.Add(4)
+	if !s.Contains(4) || s.Contains(1) || s.Size() != 1 {
+		t.Fatalf("stale entries should not reappear after clear")
+	}
+	sum := 0
+	s.ForEach(func(id int) { sum += id })
+	if sum != 4 {
+		t.Fatalf("expected ForEach to visit 4 got %d", sum)
+	}
+}
+
+func TestSparseSetMatchesModel(t *testing.T) {
+	r := rand.New(rand.NewSource(9))
+	s := NewSparseSet(64)
+	model := make(map[int]bool)
+	for i := 0; i < 3000; i++ {
+		id := r.Intn(64)
+		switch r.Intn(4) {
+		case 0:
+			if s.Remove(id) != model[id] {
+				t.Fatalf("remove mismatch for %d", id)
+			}
+			delete(model, id)
+		case 1:
+			if r.Intn(20) == 0 {
+				s.Clear()
+				clear(model)
+			}
+		default:
+			added, _ := s.Add(id)
+			if added == model[id] {
+				t.Fatalf("add mismatch for %d", id)
+			}
+			model[id] = true
+		}
+	}
+	if s.Size() != len(model) {
+		t.Fatalf("size mismatch got %d want %d", s.Size(), len(model))
+	}
+	for _, id := range s.ToSlice() {
+		if !model[id] {
+			t.Fatalf("unexpected member %d", id)
+		}
+	}
+}
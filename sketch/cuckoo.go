@@ -0,0 +1,321 @@
+package sketch
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+	"math/rand"
+
+	"github.com/profoundwu/containers/metrics"
+)
+
+var (
+	ErrInvalidEncoding = errors.New("invalid sketch encoding")
+)
+
+const (
+	cuckooBucketSize = 4
+	cuckooMaxKicks   = 500
+	// cuckooFingerprintBits is the default size of the fingerprint stored per item; a false positive needs
+	// a match among the 2*cuckooBucketSize slots of two buckets, so the rate is about 8/2^bits
+	cuckooFingerprintBits = 8
+	// cuckooLoadFactor is the default fraction of slots a filter sized for its capacity is expected to fill
+	cuckooLoadFactor = 0.95
+	cuckooMagic      = "CKF1"
+)
+
+// CuckooFilter is an approximate set membership filter that, unlike a Bloom filter, supports deletion
+// It stores a short fingerprint of every item in one of two candidate buckets, relocating existing
+// fingerprints cuckoo-style to make room. Contains has no false negatives for items that were added
+// and not deleted, and a false positive rate of roughly 3% with 8-bit fingerprints
+// Deleting an item that was never added may remove another item's fingerprint
+type CuckooFilter struct {
+	slots  []uint16 // numBuckets*cuckooBucketSize fingerprints, zero marks an empty slot
+	mask   uint64   // numBuckets - 1, the bucket count is a power of two
+	fpBits uint
+	count  int
+
+	// victim holds a fingerprint that could not be placed after cuckooMaxKicks relocations,
+	// so that a failed Add never loses an item that was already in the filter
+	victim      uint16
+	victimIndex uint64
+	hasVictim   bool
+
+	metrics *metrics.Counters
+}
+
+// NewCuckooFilter creates a new empty filter sized to hold capacity items
+// Values of capacity below 1 fall back to a single bucket
+func NewCuckooFilter(capacity int) *CuckooFilter {
+	return NewCuckooFilterWithConfig(capacity, cuckooFingerprintBits, cuckooLoadFactor)
+}
+
+// NewCuckooFilterWithConfig creates a new empty filter sized to hold capacity items with fpBits-bit
+// fingerprints while filling loadFactor of its slots
+// Every extra fingerprint bit halves the false positive rate of about 8/2^fpBits, and a lower load
+// factor makes Add less likely to fail at the cost of more memory
+// Values of fpBits outside 1 to 16 fall back to 8, values of loadFactor outside (0, 1] to 0.95
+func NewCuckooFilterWithConfig(capacity, fpBits int, loadFactor float64) *CuckooFilter {
+	if fpBits < 1 || fpBits > 16 {
+		fpBits = cuckooFingerprintBits
+	}
+	if !(loadFactor > 0 && loadFactor <= 1) {
+		loadFactor = cuckooLoadFactor
+	}
+	buckets := uint64(math.Ceil(float64(max(capacity, 1)) / cuckooBucketSize / loadFactor))
+	if buckets&(buckets-1) != 0 {
+		buckets = 1 << bits.Len64(buckets)
+	}
+	return &CuckooFilter{
+		slots:  make([]uint16, buckets*cuckooBucketSize),
+		mask:   buckets - 1,
+		fpBits: uint(fpBits),
+	}
+}
+
+// NewCuckooFilterWithMetrics creates a new empty filter like NewCuckooFilter that counts its
+// insertions, lookups, deletions and relocations into m, where every relocated fingerprint counts
+// as a collision
+func NewCuckooFilterWithMetrics(capacity int, m *metrics.Counters) *CuckooFilter {
+	f := NewCuckooFilter(capacity)
+	f.metrics = m
+	return f
+}
+
+// Metrics returns the operation counts of a filter created with NewCuckooFilterWithMetrics
+// Filters created without counters report zero counts
+func (f *CuckooFilter) Metrics() metrics.Metrics {
+	return f.metrics.Snapshot()
+}
+
+// Size returns the number of items in the filter
+func (f *CuckooFilter) Size() int {
+	return f.count
+}
+
+// IsEmpty checks if the filter holds no items
+func (f *CuckooFilter) IsEmpty() bool {
+	return f.count == 0
+}
+
+// FingerprintBits returns the size of the fingerprint stored per item
+func (f *CuckooFilter) FingerprintBits() int {
+	return int(f.fpBits)
+}
+
+// Capacity returns the total number of fingerprint slots
+func (f *CuckooFilter) Capacity() int {
+	return len(f.slots)
+}
+
+// LoadFactor returns the fraction of slots in use
+func (f *CuckooFilter) LoadFactor() float64 {
+	return float64(f.count) / float64(len(f.slots))
+}
+
+// Add inserts item into the filter
+// Returns false if the filter is too full to place it, in which case nothing was added
+// Adding the same item twice stores it twice, so it must also be deleted twice
+func (f *CuckooFilter) Add(item []byte) bool {
+	if f.hasVictim {
+		return false
+	}
+	fp, i1, i2 := f.locate(item)
+	f.metrics.CountAdd()
+	if f.insertAt(i1, fp) || f.insertAt(i2, fp) {
+		f.count++
+		return true
+	}
+
+	// Relocate fingerprints until one lands in a bucket with room
+	index := []uint64{i1, i2}[rand.Intn(2)]
+	for kick := 0; kick < cuckooMaxKicks; kick++ {
+		slot := index*cuckooBucketSize + uint64(rand.Intn(cuckooBucketSize))
+		fp, f.slots[slot] = f.slots[slot], fp
+		f.metrics.CountCollision()
+		index = f.altIndex(index, fp)
+		if f.insertAt(index, fp) {
+			f.count++
+			return true
+		}
+	}
+	f.victim, f.victimIndex, f.hasVictim = fp, index, true
+	f.count++
+	return true
+}
+
+// Contains checks if item may be in the filter
+// A false result is definite, a true result is wrong with a small probability
+func (f *CuckooFilter) Contains(item []byte) bool {
+	f.metrics.CountLookup()
+	fp, i1, i2 := f.locate(item)
+	if f.hasVictim && f.victim == fp && (f.victimIndex == i1 || f.victimIndex == i2) {
+		return true
+	}
+	return f.findIn(i1, fp) >= 0 || f.findIn(i2, fp) >= 0
+}
+
+// Delete removes one copy of item from the filter
+// Returns true if a matching fingerprint was found and removed
+func (f *CuckooFilter) Delete(item []byte) bool {
+	fp, i1, i2 := f.locate(item)
+	if f.hasVictim && f.victim == fp && (f.victimIndex == i1 || f.victimIndex == i2) {
+		f.hasVictim = false
+		f.count--
+		f.metrics.CountRemove()
+		return true
+	}
+	for _, index := range [2]uint64{i1, i2} {
+		if slot := f.findIn(index, fp); slot >= 0 {
+			f.slots[slot] = 0
+			f.count--
+			f.metrics.CountRemove()
+			f.reinsertVictim()
+			return true
+		}
+	}
+	return false
+}
+
+// Clear removes all items from the filter
+func (f *CuckooFilter) Clear() {
+	clear(f.slots)
+	f.count = 0
+	f.hasVictim = false
+}
+
+// MarshalBinary encodes the filter, including its configuration, into a portable byte slice
+func (f *CuckooFilter) MarshalBinary() ([]byte, error) {
+	fpBytes := fingerprintBytes(f.fpBits)
+	buf := make([]byte, 0, 4+1+8+8+1+2+8+len(f.slots)*fpBytes)
+	buf = append(buf, cuckooMagic...)
+	buf = append(buf, byte(f.fpBits))
+	buf = binary.BigEndian.AppendUint64(buf, f.mask+1)
+	buf = binary.BigEndian.AppendUint64(buf, uint64(f.count))
+	if f.hasVictim {
+		buf = append(buf, 1)
+	} else {
+		buf = append(buf, 0)
+	}
+	buf = binary.BigEndian.AppendUint16(buf, f.victim)
+	buf = binary.BigEndian.AppendUint64(buf, f.victimIndex)
+	for _, fp := range f.slots {
+		if fpBytes == 1 {
+			buf = append(buf, byte(fp))
+		} else {
+			buf = binary.BigEndian.AppendUint16(buf, fp)
+		}
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary replaces the filter with one decoded from data produced by MarshalBinary
+// Returns error if data is not a valid encoding
+func (f *CuckooFilter) UnmarshalBinary(data []byte) error {
+	const header = 4 + 1 + 8 + 8 + 1 + 2 + 8
+	if len(data) < header || string(data[:4]) != cuckooMagic {
+		return fmt.Errorf("%w: missing cuckoo filter header", ErrInvalidEncoding)
+	}
+	fpBits := uint(data[4])
+	buckets := binary.BigEndian.Uint64(data[5:])
+	count := binary.BigEndian.Uint64(data[13:])
+	if fpBits < 1 || fpBits > 16 || buckets == 0 || buckets&(buckets-1) != 0 {
+		return fmt.Errorf("%w: bad cuckoo filter parameters", ErrInvalidEncoding)
+	}
+	fpBytes := fingerprintBytes(fpBits)
+	body := data[header:]
+	// Compare by division so a huge bucket count cannot overflow into a matching size
+	bucketBytes := uint64(cuckooBucketSize * fpBytes)
+	if uint64(len(body))%bucketBytes != 0 || buckets != uint64(len(body))/bucketBytes {
+		return fmt.Errorf("%w: cuckoo filter body has %d bytes", ErrInvalidEncoding, len(body))
+	}
+
+	maxFingerprint := uint16(1<<fpBits - 1)
+	slots := make([]uint16, buckets*cuckooBucketSize)
+	for i := range slots {
+		if fpBytes == 1 {
+			slots[i] = uint16(body[i])
+		} else {
+			slots[i] = binary.BigEndian.Uint16(body[2*i:])
+		}
+		if slots[i] > maxFingerprint {
+			return fmt.Errorf("%w: fingerprint %d exceeds %d bits", ErrInvalidEncoding, slots[i], fpBits)
+		}
+	}
+	hasVictim := data[21] == 1
+	victim := binary.BigEndian.Uint16(data[22:])
+	victimIndex := binary.BigEndian.Uint64(data[24:])
+	if count > uint64(len(slots))+1 || victimIndex > buckets-1 || victim > maxFingerprint ||
+		(hasVictim && victim == 0) {
+		return fmt.Errorf("%w: bad cuckoo filter state", ErrInvalidEncoding)
+	}
+	*f = CuckooFilter{
+		slots:       slots,
+		mask:        buckets - 1,
+		fpBits:      fpBits,
+		count:       int(count),
+		hasVictim:   hasVictim,
+		victim:      victim,
+		victimIndex: victimIndex,
+		metrics:     f.metrics,
+	}
+	return nil
+}
+
+// locate returns the fingerprint of item and its two candidate buckets
+func (f *CuckooFilter) locate(item []byte) (uint16, uint64, uint64) {
+	h := hash64(0, item)
+	fp := uint16(h>>32) & (1<<f.fpBits - 1)
+	if fp == 0 {
+		// Zero marks an empty slot
+		fp = 1
+	}
+	i1 := h & f.mask
+	return fp, i1, f.altIndex(i1, fp)
+}
+
+// altIndex returns the other candidate bucket of a fingerprint stored in bucket index
+// It is its own inverse, so a fingerprint can move between its buckets without the original item
+func (f *CuckooFilter) altIndex(index uint64, fp uint16) uint64 {
+	return (index ^ mix64(uint64(fp))) & f.mask
+}
+
+func (f *CuckooFilter) insertAt(index uint64, fp uint16) bool {
+	bucket := f.slots[index*cuckooBucketSize : (index+1)*cuckooBucketSize]
+	for i, v := range bucket {
+		if v == 0 {
+			bucket[i] = fp
+			return true
+		}
+	}
+	return false
+}
+
+// findIn returns the slot holding fp in bucket index, or -1
+func (f *CuckooFilter) findIn(index uint64, fp uint16) int {
+	for i := index * cuckooBucketSize; i < (index+1)*cuckooBucketSize; i++ {
+		if f.slots[i] == fp {
+			return int(i)
+		}
+	}
+	return -1
+}
+
+// reinsertVictim tries to move the stashed victim into a slot freed by Delete
+func (f *CuckooFilter) reinsertVictim() {
+	if !f.hasVictim {
+		return
+	}
+	if f.insertAt(f.victimIndex, f.victim) || f.insertAt(f.altIndex(f.victimIndex, f.victim), f.victim) {
+		f.hasVictim = false
+	}
+}
+
+func fingerprintBytes(fpBits uint) int {
+	if fpBits <= 8 {
+		return 1
+	}
+	return 2
+}
@@ -0,0 +1,219 @@
+package sketch
+
+import (
+	"encoding/binary"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/profoundwu/containers/metrics"
+)
+
+func TestCuckooFilterAddContains(t *testing.T) {
+	f := NewCuckooFilter(1000)
+	if !f.IsEmpty() {
+		t.Fatalf("expected empty filter")
+	}
+	for i := 0; i < 1000; i++ {
+		if !f.Add([]byte(strconv.Itoa(i))) {
+			t.Fatalf("expected Add(%d) to succeed", i)
+		}
+	}
+	if f.Size() != 1000 {
+		t.Fatalf("expected size 1000 got %d", f.Size())
+	}
+	for i := 0; i < 1000; i++ {
+		if !f.Contains([]byte(strconv.Itoa(i))) {
+			t.Fatalf("expected filter to contain %d", i)
+		}
+	}
+
+	falsePositives := 0
+	for i := 1000; i < 11000; i++ {
+		if f.Contains([]byte(strconv.Itoa(i))) {
+			falsePositives++
+		}
+	}
+	if rate := float64(falsePositives) / 10000; rate > 0.05 {
+		t.Fatalf("expected false positive rate below 0.05 got %f", rate)
+	}
+}
+
+func TestCuckooFilterDelete(t *testing.T) {
+	f := NewCuckooFilter(100)
+	for i := 0; i < 100; i++ {
+		f.Add([]byte(strconv.Itoa(i)))
+	}
+	for i := 0; i < 100; i += 2 {
+		if !f.Delete([]byte(strconv.Itoa(i))) {
+			t.Fatalf("expected Delete(%d) to succeed", i)
+		}
+	}
+	if f.Size() != 50 {
+		t.Fatalf("expected size 50 got %d", f.Size())
+	}
+	for i := 1; i < 100; i += 2 {
+		if !f.Contains([]byte(strconv.Itoa(i))) {
+			t.Fatalf("expected filter to still contain %d", i)
+		}
+	}
+
+	f.Add([]byte("dup"))
+	f.Add([]byte("dup"))
+	f.Delete([]byte("dup"))
+	if !f.Contains([]byte("dup")) {
+		t.Fatalf("expected second copy of dup to remain")
+	}
+	f.Delete([]byte("dup"))
+
+	f.Clear()
+	if !f.IsEmpty() || f.Contains([]byte("1")) {
+		t.Fatalf("expected empty filter after Clear")
+	}
+}
+
+func TestCuckooFilterFull(t *testing.T) {
+	f := NewCuckooFilter(8)
+	added := 0
+	for i := 0; i < 1000; i++ {
+		if !f.Add([]byte(strconv.Itoa(i))) {
+			break
+		}
+		added++
+	}
+	if added > f.Capacity()+1 {
+		t.Fatalf("expected at most %d items got %d", f.Capacity()+1, added)
+	}
+	// Every accepted item, including the stashed victim, must still be found
+	for i := 0; i < added; i++ {
+		if !f.Contains([]byte(strconv.Itoa(i))) {
+			t.Fatalf("expected filter to contain %d", i)
+		}
+	}
+}
+
+func TestCuckooFilterMarshalBinary(t *testing.T) {
+	f := NewCuckooFilter(500)
+	for i := 0; i < 500; i++ {
+		f.Add([]byte(strconv.Itoa(i)))
+	}
+	data, err := f.MarshalBinary()
+	if err != nil {
+		t.Fatalf("expected no error got %v", err)
+	}
+
+	var g CuckooFilter
+	if err := g.UnmarshalBinary(data); err != nil {
+		t.Fatalf("expected no error got %v", err)
+	}
+	if g.Size() != f.Size() || g.Capacity() != f.Capacity() {
+		t.Fatalf("expected size %d capacity %d got %d %d", f.Size(), f.Capacity(), g.Size(), g.Capacity())
+	}
+	for i := 0; i < 500; i++ {
+		if !g.Contains([]byte(strconv.Itoa(i))) {
+			t.Fatalf("expected decoded filter to contain %d", i)
+		}
+	}
+	if !g.Delete([]byte("7")) || g.Size() != 499 {
+		t.Fatalf("expected decoded filter to support Delete")
+	}
+
+	if err := g.UnmarshalBinary(data[:10]); !errors.Is(err, ErrInvalidEncoding) {
+		t.Fatalf("expected ErrInvalidEncoding got %v", err)
+	}
+	if err := g.UnmarshalBinary(data[:len(data)-1]); !errors.Is(err, ErrInvalidEncoding) {
+		t.Fatalf("expected ErrInvalidEncoding got %v", err)
+	}
+}
+
+func TestCuckooFilterUnmarshalBinaryCorrupt(t *testing.T) {
+	f := NewCuckooFilter(8)
+	f.Add([]byte("a"))
+	valid, _ := f.MarshalBinary()
+	corrupt := func(edit func(data []byte) []byte) []byte {
+		return edit(append([]byte(nil), valid...))
+	}
+	cases := map[string][]byte{
+		// A bucket count whose body size overflows to zero
+		"overflowing buckets": corrupt(func(data []byte) []byte {
+			binary.BigEndian.PutUint64(data[5:], 1<<62)
+			return data[:30]
+		}),
+		"count": corrupt(func(data []byte) []byte {
+			binary.BigEndian.PutUint64(data[13:], uint64(f.Capacity())+2)
+			return data
+		}),
+		"victim index": corrupt(func(data []byte) []byte {
+			binary.BigEndian.PutUint64(data[24:], uint64(f.Capacity()))
+			return data
+		}),
+		"fingerprint": corrupt(func(data []byte) []byte {
+			data[4] = 4
+			data[30] = 0xff
+			return data
+		}),
+	}
+	for name, data := range cases {
+		var g CuckooFilter
+		if err := g.UnmarshalBinary(data); !errors.Is(err, ErrInvalidEncoding) {
+			t.Fatalf("expected ErrInvalidEncoding for %s got %v", name, err)
+		}
+	}
+}
+
+func TestCuckooFilterWithConfig(t *testing.T) {
+	f := NewCuckooFilterWithConfig(1000, 16, 0.5)
+	if f.FingerprintBits() != 16 || f.Capacity() < 2000 {
+		t.Fatalf("expected 16-bit fingerprints and at least 2000 slots got %d %d", f.FingerprintBits(), f.Capacity())
+	}
+	for i := 0; i < 1000; i++ {
+		if !f.Add([]byte(strconv.Itoa(i))) {
+			t.Fatalf("expected Add(%d) to succeed", i)
+		}
+	}
+	falsePositives := 0
+	for i := 1000; i < 101000; i++ {
+		if f.Contains([]byte(strconv.Itoa(i))) {
+			falsePositives++
+		}
+	}
+	if rate := float64(falsePositives) / 100000; rate > 0.001 {
+		t.Fatalf("expected false positive rate below 0.001 got %f", rate)
+	}
+
+	// Wide fingerprints survive an encoding round trip
+	data, _ := f.MarshalBinary()
+	var g CuckooFilter
+	if err := g.UnmarshalBinary(data); err != nil || g.FingerprintBits() != 16 {
+		t.Fatalf("expected a 16-bit filter got %d %v", g.FingerprintBits(), err)
+	}
+	for i := 0; i < 1000; i++ {
+		if !g.Contains([]byte(strconv.Itoa(i))) {
+			t.Fatalf("expected decoded filter to contain %d", i)
+		}
+	}
+
+	d := NewCuckooFilterWithConfig(1000, 0, 2)
+	if want := NewCuckooFilter(1000); d.FingerprintBits() != 8 || d.Capacity() != want.Capacity() {
+		t.Fatalf("expected default configuration got %d bits %d slots", d.FingerprintBits(), d.Capacity())
+	}
+}
+
+func TestCuckooFilterMetrics(t *testing.T) {
+	m := metrics.New()
+	f := NewCuckooFilterWithMetrics(64, m)
+	for i := 0; i < 200; i++ {
+		f.Add([]byte(strconv.Itoa(i)))
+	}
+	f.Contains([]byte("1"))
+	f.Delete([]byte("1"))
+
+	got := f.Metrics()
+	if got.Adds == 0 || got.Collisions == 0 || got.Lookups != 1 || got.Removes != 1 {
+		t.Fatalf("unexpected metrics %+v", got)
+	}
+	data, _ := f.MarshalBinary()
+	if err := f.UnmarshalBinary(data); err != nil || f.Metrics() != m.Snapshot() {
+		t.Fatalf("expected decoding to keep the counters got %v", err)
+	}
+}
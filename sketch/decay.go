@@ -0,0 +1,218 @@
+package sketch
+
+import (
+	"container/heap"
+	"math"
+	"math/rand"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/profoundwu/containers/internal/utils"
+)
+
+const (
+	// DefaultDecayAlpha weights roughly the last five minutes of data
+	DefaultDecayAlpha = 0.015
+	// decayRescaleExponent bounds alpha times the landmark's lag, so weights stay below e^30 for any
+	// alpha instead of overflowing past e^709
+	decayRescaleExponent = 30
+)
+
+type decaySample struct {
+	value    float64
+	weight   float64
+	priority float64
+}
+
+// decayHeap orders samples by priority, lowest first, so the least important sample is dropped
+type decayHeap []decaySample
+
+func (h decayHeap) Len() int { return len(h) }
+
+func (h decayHeap) Less(i, j int) bool { return h[i].priority < h[j].priority }
+
+func (h decayHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *decayHeap) Push(x any) { *h = append(*h, x.(decaySample)) }
+
+func (h *decayHeap) Pop() any {
+	old := *h
+	n := len(old)
+	s := old[n-1]
+	*h = old[:n-1]
+	return s
+}
+
+// DecayingSample keeps a fixed size random sample of a stream biased toward recent values
+// using forward exponential decay, so statistics reflect roughly the last 1/alpha seconds
+// It is safe for concurrent use
+type DecayingSample struct {
+	mu       sync.Mutex
+	size     int
+	alpha    float64
+	samples  decayHeap
+	landmark time.Time
+	// decayedCount is the sum of the weights of every update, used for Rate
+	decayedCount float64
+	count        uint64
+
+	now func() time.Time
+}
+
+// NewDecayingSample creates a new empty sample retaining at most size values
+// with the default decay factor
+func NewDecayingSample(size int) *DecayingSample {
+	return NewDecayingSampleWithAlpha(size, DefaultDecayAlpha)
+}
+
+// NewDecayingSampleWithAlpha creates a new empty sample retaining at most size values,
+// where a value's weight falls by a factor of e every 1/alpha seconds
+// Any positive alpha is supported; larger values rescale the stored weights more often
+// Values of size below 1 fall back to the default capacity, non-positive alpha to DefaultDecayAlpha
+func NewDecayingSampleWithAlpha(size int, alpha float64) *DecayingSample {
+	if size < 1 {
+		size = utils.DefaultCapacity
+	}
+	if alpha <= 0 {
+		alpha = DefaultDecayAlpha
+	}
+	s := &DecayingSample{
+		size:    size,
+		alpha:   alpha,
+		samples: make(decayHeap, 0, size),
+		now:     time.Now,
+	}
+	s.landmark = s.now()
+	return s
+}
+
+// Size returns the number of values currently retained
+func (s *DecayingSample) Size() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.samples)
+}
+
+// IsEmpty checks if no value has been recorded
+func (s *DecayingSample) IsEmpty() bool {
+	return s.Size() == 0
+}
+
+// Count returns the number of values recorded since creation or the last Clear
+func (s *DecayingSample) Count() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.count
+}
+
+// Update records value as observed now
+func (s *DecayingSample) Update(value float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if s.alpha*now.Sub(s.landmark).Seconds() >= decayRescaleExponent {
+		s.rescale(now)
+	}
+	weight := math.Exp(s.alpha * now.Sub(s.landmark).Seconds())
+	s.decayedCount += weight
+	s.count++
+
+	sample := decaySample{value: value, weight: weight, priority: weight / (1 - rand.Float64())}
+	if len(s.samples) < s.size {
+		heap.Push(&s.samples, sample)
+	} else if sample.priority > s.samples[0].priority {
+		s.samples[0] = sample
+		heap.Fix(&s.samples, 0)
+	}
+}
+
+// Rate returns the decayed number of updates per second
+// For a steady stream it converges to the true rate after a few multiples of 1/alpha seconds
+func (s *DecayingSample) Rate() float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	age := s.now().Sub(s.landmark).Seconds()
+	return s.alpha * s.decayedCount * math.Exp(-s.alpha*age)
+}
+
+// Mean returns the weighted mean of the retained values
+// Returns zero if no value has been recorded
+func (s *DecayingSample) Mean() float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var sum, weights float64
+	for _, sample := range s.samples {
+		sum += sample.value * sample.weight
+		weights += sample.weight
+	}
+	if weights == 0 {
+		return 0
+	}
+	return sum / weights
+}
+
+// Percentile returns the weighted q-quantile of the retained values, with q clamped to [0, 1]
+// Returns zero if no value has been recorded
+func (s *DecayingSample) Percentile(q float64) float64 {
+	return s.Percentiles(q)[0]
+}
+
+// Percentiles returns the weighted quantiles for each of qs, sorting the sample only once
+func (s *DecayingSample) Percentiles(qs ...float64) []float64 {
+	s.mu.Lock()
+	sorted := slices.Clone(s.samples)
+	s.mu.Unlock()
+
+	result := make([]float64, len(qs))
+	if len(sorted) == 0 {
+		return result
+	}
+	slices.SortFunc(sorted, func(a, b decaySample) int {
+		switch {
+		case a.value < b.value:
+			return -1
+		case a.value > b.value:
+			return 1
+		}
+		return 0
+	})
+
+	// cumulative[i] is the share of the total weight held by values up to and including i
+	cumulative := make([]float64, len(sorted))
+	var total float64
+	for i, sample := range sorted {
+		total += sample.weight
+		cumulative[i] = total
+	}
+	for i, q := range qs {
+		target := min(max(q, 0), 1) * total
+		j, _ := slices.BinarySearch(cumulative, target)
+		result[i] = sorted[min(j, len(sorted)-1)].value
+	}
+	return result
+}
+
+// Clear discards every recorded value and restarts the decay from now
+func (s *DecayingSample) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.samples = s.samples[:0]
+	s.decayedCount = 0
+	s.count = 0
+	s.landmark = s.now()
+}
+
+// rescale moves the landmark to now, shrinking every stored weight by the same factor
+// Relative weights and priorities are unchanged, so the sample stays valid
+func (s *DecayingSample) rescale(now time.Time) {
+	factor := math.Exp(-s.alpha * now.Sub(s.landmark).Seconds())
+	for i := range s.samples {
+		s.samples[i].weight *= factor
+		s.samples[i].priority *= factor
+	}
+	s.decayedCount *= factor
+	s.landmark = now
+}
@@ -0,0 +1,102 @@
+package sketch
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func newTestDecayingSample(size int, alpha float64) (*DecayingSample, *time.Time) {
+	clock := time.Unix(0, 0)
+	s := NewDecayingSampleWithAlpha(size, alpha)
+	s.now = func() time.Time { return clock }
+	s.Clear()
+	return s, &clock
+}
+
+func TestDecayingSampleStatistics(t *testing.T) {
+	s, _ := newTestDecayingSample(1000, 0.015)
+	if s.Mean() != 0 || s.Percentile(0.5) != 0 || !s.IsEmpty() {
+		t.Fatalf("expected zero statistics for an empty sample")
+	}
+	for i := 1; i <= 100; i++ {
+		s.Update(float64(i))
+	}
+
+	if s.Size() != 100 || s.Count() != 100 {
+		t.Fatalf("expected size and count 100 got %d %d", s.Size(), s.Count())
+	}
+	if m := s.Mean(); math.Abs(m-50.5) > 1e-9 {
+		t.Fatalf("expected mean 50.5 got %f", m)
+	}
+	p := s.Percentiles(0, 0.5, 0.99, 2)
+	if p[0] != 1 || p[1] != 50 || p[2] != 99 || p[3] != 100 {
+		t.Fatalf("unexpected percentiles %v", p)
+	}
+}
+
+func TestDecayingSampleFavorsRecent(t *testing.T) {
+	s, clock := newTestDecayingSample(100, 0.1)
+	for i := 0; i < 1000; i++ {
+		s.Update(1)
+	}
+	*clock = clock.Add(2 * time.Minute)
+	for i := 0; i < 1000; i++ {
+		s.Update(100)
+	}
+
+	if s.Size() != 100 || s.Count() != 2000 {
+		t.Fatalf("expected size 100 count 2000 got %d %d", s.Size(), s.Count())
+	}
+	if m := s.Mean(); m < 99 {
+		t.Fatalf("expected mean dominated by recent values got %f", m)
+	}
+	if p := s.Percentile(0.01); p != 100 {
+		t.Fatalf("expected old values to have been replaced got %f", p)
+	}
+}
+
+func TestDecayingSampleRateAndRescale(t *testing.T) {
+	s, clock := newTestDecayingSample(10, 0.05)
+	// Ten updates per second for ten minutes
+	for i := 0; i < 6000; i++ {
+		*clock = clock.Add(100 * time.Millisecond)
+		s.Update(float64(i % 10))
+	}
+	if r := s.Rate(); math.Abs(r-10) > 0.5 {
+		t.Fatalf("expected rate near 10 got %f", r)
+	}
+
+	// Rescaling must not change the observed statistics
+	before := s.Rate()
+	*clock = clock.Add(2 * time.Hour)
+	decayed := s.Rate()
+	if math.Abs(decayed-before*math.Exp(-0.05*7200)) > 1e-9 {
+		t.Fatalf("expected rate to decay got %f", decayed)
+	}
+	s.Update(42)
+	if r := s.Rate(); math.IsInf(r, 0) || math.IsNaN(r) || r < 0.05 {
+		t.Fatalf("expected finite rate after rescale got %f", r)
+	}
+	if p := s.Percentile(1); p != 42 {
+		t.Fatalf("expected the fresh value to dominate got %f", p)
+	}
+}
+
+func TestDecayingSampleLargeAlpha(t *testing.T) {
+	s, clock := newTestDecayingSample(10, 5)
+	// e^(5*t) overflows after about 142 seconds, well within an hour
+	for i := 0; i < 600; i++ {
+		*clock = clock.Add(time.Second)
+		s.Update(float64(i % 10))
+	}
+	for _, v := range append(s.Percentiles(0, 0.5, 1), s.Mean(), s.Rate(), s.decayedCount) {
+		if math.IsInf(v, 0) || math.IsNaN(v) {
+			t.Fatalf("expected finite statistics got %f", v)
+		}
+	}
+	// One update per second decays to a geometric series at the instant of the last update
+	if r, want := s.Rate(), 5/(1-math.Exp(-5)); math.Abs(r-want) > 1e-6 {
+		t.Fatalf("expected rate %f got %f", want, r)
+	}
+}
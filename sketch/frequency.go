@@ -0,0 +1,119 @@
+package sketch
+
+import "math/bits"
+
+const (
+	// frequencyDepth is the number of counter rows a key is counted in
+	frequencyDepth = 4
+	// frequencyMaxCount is the largest value a 4-bit counter holds
+	frequencyMaxCount = 15
+	// frequencySampleFactor sets the sightings recorded per counter of a row before counters are halved
+	frequencySampleFactor = 10
+)
+
+// FrequencySketch estimates how often keys were seen recently in a few bits per key, the frequency
+// half of a TinyLFU cache admission policy
+// A doorkeeper Bloom filter absorbs the first sighting of every key, so keys seen once never reach
+// the 4-bit count-min counters; once ten sightings per counter of a row, at least ten per unit of
+// capacity, have been recorded every counter is halved and the doorkeeper cleared, so old
+// popularity fades
+// Keys are given as 64-bit hashes, so callers hash a key once for both Increment and Estimate
+// It is not safe for concurrent use
+type FrequencySketch struct {
+	counters   []uint64 // frequencyDepth rows of width 4-bit counters, 16 per word
+	width      uint64   // counters per row, a power of two
+	doorkeeper []uint64
+	doorMask   uint64 // doorkeeper bits - 1, the bit count is a power of two
+	additions  int
+	sampleSize int
+}
+
+// NewFrequencySketch creates a new empty sketch sized for the keys of a cache holding capacity entries
+// Values of capacity below 1 fall back to 1
+func NewFrequencySketch(capacity int) *FrequencySketch {
+	capacity = max(capacity, 1)
+	width := uint64(1) << bits.Len64(uint64(max(capacity, 16)-1))
+	return &FrequencySketch{
+		counters:   make([]uint64, frequencyDepth*width/16),
+		width:      width,
+		doorkeeper: make([]uint64, width/8),
+		doorMask:   width*8 - 1,
+		sampleSize: frequencySampleFactor * int(width),
+	}
+}
+
+// Increment records a sighting of the key hashed to h
+func (s *FrequencySketch) Increment(h uint64) {
+	h = mix64(h)
+	if !s.admitDoorkeeper(h) {
+		for row := uint64(0); row < frequencyDepth; row++ {
+			word, shift := s.counter(h, row)
+			if (s.counters[word]>>shift)&frequencyMaxCount < frequencyMaxCount {
+				s.counters[word] += 1 << shift
+			}
+		}
+	}
+	if s.additions++; s.additions >= s.sampleSize {
+		s.age()
+	}
+}
+
+// Estimate returns how often the key hashed to h was seen recently, capped at 16
+// The estimate never undercounts sightings since the last halving, but colliding keys may
+// inflate it
+func (s *FrequencySketch) Estimate(h uint64) int {
+	h = mix64(h)
+	count := uint64(frequencyMaxCount)
+	for row := uint64(0); row < frequencyDepth; row++ {
+		word, shift := s.counter(h, row)
+		count = min(count, (s.counters[word]>>shift)&frequencyMaxCount)
+	}
+	if s.inDoorkeeper(h) {
+		count++
+	}
+	return int(count)
+}
+
+// Clear forgets every sighting
+func (s *FrequencySketch) Clear() {
+	clear(s.counters)
+	clear(s.doorkeeper)
+	s.additions = 0
+}
+
+// counter locates the counter of h in row as a word index and bit shift
+func (s *FrequencySketch) counter(h, row uint64) (int, uint) {
+	index := row*s.width + (mix64(h+row*0x9e3779b97f4a7c15) & (s.width - 1))
+	return int(index / 16), uint(index%16) * 4
+}
+
+// doorkeeperBits returns the two doorkeeper bits of h
+func (s *FrequencySketch) doorkeeperBits(h uint64) (uint64, uint64) {
+	return h & s.doorMask, (h >> 32) & s.doorMask
+}
+
+func (s *FrequencySketch) inDoorkeeper(h uint64) bool {
+	a, b := s.doorkeeperBits(h)
+	return s.doorkeeper[a/64]&(1<<(a%64)) != 0 && s.doorkeeper[b/64]&(1<<(b%64)) != 0
+}
+
+// admitDoorkeeper sets the doorkeeper bits of h
+// Returns true if h was not in the doorkeeper yet
+func (s *FrequencySketch) admitDoorkeeper(h uint64) bool {
+	if s.inDoorkeeper(h) {
+		return false
+	}
+	a, b := s.doorkeeperBits(h)
+	s.doorkeeper[a/64] |= 1 << (a % 64)
+	s.doorkeeper[b/64] |= 1 << (b % 64)
+	return true
+}
+
+// age halves every counter and clears the doorkeeper
+func (s *FrequencySketch) age() {
+	for i := range s.counters {
+		s.counters[i] = (s.counters[i] >> 1) & 0x7777777777777777
+	}
+	clear(s.doorkeeper)
+	s.additions /= 2
+}
@@ -0,0 +1,46 @@
+package sketch
+
+import "testing"
+
+func TestFrequencySketchEstimate(t *testing.T) {
+	s := NewFrequencySketch(100)
+	if s.Estimate(1) != 0 {
+		t.Fatalf("expected 0 for an unseen key got %d", s.Estimate(1))
+	}
+	s.Increment(1)
+	if s.Estimate(1) != 1 {
+		t.Fatalf("expected the doorkeeper to count the first sighting got %d", s.Estimate(1))
+	}
+	for i := 0; i < 5; i++ {
+		s.Increment(2)
+	}
+	if s.Estimate(2) < 5 {
+		t.Fatalf("expected at least 5 got %d", s.Estimate(2))
+	}
+	for i := 0; i < 100; i++ {
+		s.Increment(3)
+	}
+	if s.Estimate(3) != 16 {
+		t.Fatalf("expected the estimate capped at 16 got %d", s.Estimate(3))
+	}
+	s.Clear()
+	if s.Estimate(2) != 0 || s.Estimate(3) != 0 {
+		t.Fatalf("expected cleared estimates got %d %d", s.Estimate(2), s.Estimate(3))
+	}
+}
+
+func TestFrequencySketchAging(t *testing.T) {
+	s := NewFrequencySketch(10)
+	for i := 0; i < 8; i++ {
+		s.Increment(42)
+	}
+	before := s.Estimate(42)
+
+	// 160 sightings of other keys reach the sample size of 10 per counter of a 16-counter row
+	for i := uint64(0); i < 152; i++ {
+		s.Increment(1000 + i)
+	}
+	if after := s.Estimate(42); after >= before || after < before/2-1 {
+		t.Fatalf("expected the estimate of %d halved got %d", before, after)
+	}
+}
@@ -0,0 +1,23 @@
+// Package sketch holds probabilistic data structures that answer membership, frequency and
+// similarity questions about large streams in bounded memory
+package sketch
+
+// hash64 is a seeded FNV-1a hash with a final avalanche step
+func hash64(seed uint64, data []byte) uint64 {
+	h := uint64(14695981039346656037) ^ (seed * 0x9e3779b97f4a7c15)
+	for _, b := range data {
+		h ^= uint64(b)
+		h *= 1099511628211
+	}
+	return mix64(h)
+}
+
+// mix64 scrambles the bits of h so that nearby inputs produce unrelated outputs
+func mix64(h uint64) uint64 {
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	h *= 0xc4ceb9fe1a85ec53
+	h ^= h >> 33
+	return h
+}
@@ -0,0 +1,94 @@
+package sketch
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+)
+
+var (
+	ErrIncompatibleSketch = errors.New("sketches have different parameters")
+)
+
+const defaultMinHashSize = 128
+
+// MinHash is a fixed size signature of a set that estimates Jaccard similarity between sets
+// The standard error of the estimate is about 1/sqrt(Size)
+type MinHash struct {
+	mins []uint64
+}
+
+// NewMinHash creates a new signature of an empty set using 128 hash functions
+func NewMinHash() *MinHash {
+	return NewMinHashWithSize(defaultMinHashSize)
+}
+
+// NewMinHashWithSize creates a new signature of an empty set using size hash functions
+// Values of size below 1 fall back to the default of 128
+func NewMinHashWithSize(size int) *MinHash {
+	if size < 1 {
+		size = defaultMinHashSize
+	}
+	mh := &MinHash{mins: make([]uint64, size)}
+	mh.Clear()
+	return mh
+}
+
+// Size returns the number of hash functions in the signature
+func (mh *MinHash) Size() int {
+	return len(mh.mins)
+}
+
+// IsEmpty checks if no element has been added
+func (mh *MinHash) IsEmpty() bool {
+	return mh.mins[0] == math.MaxUint64
+}
+
+// Add records element as a member of the set
+func (mh *MinHash) Add(element []byte) {
+	h1, h2 := bloomHashes(element)
+	for i := range mh.mins {
+		mh.mins[i] = min(mh.mins[i], mix64(h1+uint64(i)*h2))
+	}
+}
+
+// Jaccard estimates the Jaccard similarity between the sets behind the two signatures
+// Two empty sets are considered identical
+// Returns error if the signatures have different sizes
+func (mh *MinHash) Jaccard(other *MinHash) (float64, error) {
+	if len(mh.mins) != len(other.mins) {
+		return 0, fmt.Errorf("%w: size %d and %d", ErrIncompatibleSketch, len(mh.mins), len(other.mins))
+	}
+	matches := 0
+	for i, v := range mh.mins {
+		if v == other.mins[i] {
+			matches++
+		}
+	}
+	return float64(matches) / float64(len(mh.mins)), nil
+}
+
+// Merge turns this signature into the signature of the union of both sets
+// Returns error if the signatures have different sizes
+func (mh *MinHash) Merge(other *MinHash) error {
+	if len(mh.mins) != len(other.mins) {
+		return fmt.Errorf("%w: size %d and %d", ErrIncompatibleSketch, len(mh.mins), len(other.mins))
+	}
+	for i, v := range other.mins {
+		mh.mins[i] = min(mh.mins[i], v)
+	}
+	return nil
+}
+
+// Signature returns a copy of the minimum hash values
+func (mh *MinHash) Signature() []uint64 {
+	return slices.Clone(mh.mins)
+}
+
+// Clear resets the signature to that of the empty set
+func (mh *MinHash) Clear() {
+	for i := range mh.mins {
+		mh.mins[i] = math.MaxUint64
+	}
+}
@@ -0,0 +1,63 @@
+package sketch
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestMinHashJaccard(t *testing.T) {
+	a := NewMinHashWithSize(256)
+	b := NewMinHashWithSize(256)
+	if !a.IsEmpty() {
+		t.Fatalf("expected empty signature")
+	}
+	// a holds 0..999, b holds 500..1499, so the true similarity is 500/1500
+	for i := 0; i < 1000; i++ {
+		a.Add([]byte(strconv.Itoa(i)))
+		b.Add([]byte(strconv.Itoa(i + 500)))
+	}
+
+	j, err := a.Jaccard(b)
+	if err != nil {
+		t.Fatalf("expected no error got %v", err)
+	}
+	if math.Abs(j-1.0/3) > 0.1 {
+		t.Fatalf("expected similarity near 0.33 got %f", j)
+	}
+	if self, _ := a.Jaccard(a); self != 1 {
+		t.Fatalf("expected self similarity 1 got %f", self)
+	}
+
+	if _, err := a.Jaccard(NewMinHashWithSize(16)); !errors.Is(err, ErrIncompatibleSketch) {
+		t.Fatalf("expected ErrIncompatibleSketch got %v", err)
+	}
+}
+
+func TestMinHashMerge(t *testing.T) {
+	a, b, union := NewMinHash(), NewMinHash(), NewMinHash()
+	for i := 0; i < 100; i++ {
+		a.Add([]byte(strconv.Itoa(i)))
+		union.Add([]byte(strconv.Itoa(i)))
+	}
+	for i := 100; i < 200; i++ {
+		b.Add([]byte(strconv.Itoa(i)))
+		union.Add([]byte(strconv.Itoa(i)))
+	}
+
+	if err := a.Merge(b); err != nil {
+		t.Fatalf("expected no error got %v", err)
+	}
+	if j, _ := a.Jaccard(union); j != 1 {
+		t.Fatalf("expected merged signature to equal the union got %f", j)
+	}
+	if err := a.Merge(NewMinHashWithSize(3)); !errors.Is(err, ErrIncompatibleSketch) {
+		t.Fatalf("expected ErrIncompatibleSketch got %v", err)
+	}
+
+	a.Clear()
+	if !a.IsEmpty() || len(a.Signature()) != 128 {
+		t.Fatalf("expected empty signature of size 128 after Clear")
+	}
+}
@@ -0,0 +1,224 @@
+package sketch
+
+import (
+	"container/heap"
+	"slices"
+
+	"github.com/profoundwu/containers/internal/utils"
+)
+
+// Estimate is the approximate frequency of one monitored item
+// The true count lies between Count-Error and Count
+type Estimate[T comparable] struct {
+	Item  T
+	Count uint64
+	Error uint64
+}
+
+type ssEntry[T comparable] struct {
+	item  T
+	count uint64
+	err   uint64
+	index int
+}
+
+// ssHeap orders monitored entries by count, smallest first
+type ssHeap[T comparable] []*ssEntry[T]
+
+func (h ssHeap[T]) Len() int { return len(h) }
+
+func (h ssHeap[T]) Less(i, j int) bool { return h[i].count < h[j].count }
+
+func (h ssHeap[T]) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *ssHeap[T]) Push(x any) {
+	e := x.(*ssEntry[T])
+	e.index = len(*h)
+	*h = append(*h, e)
+}
+
+func (h *ssHeap[T]) Pop() any {
+	old := *h
+	n := len(old)
+	e := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return e
+}
+
+// SpaceSaving tracks the most frequent items of an unbounded stream using a fixed number of counters
+// When every counter is taken, a new item replaces the least counted one and inherits its count
+// as an error bound, so any item occurring more than N/capacity times is guaranteed to be monitored
+type SpaceSaving[T comparable] struct {
+	capacity int
+	entries  map[T]*ssEntry[T]
+	heap     ssHeap[T]
+	total    uint64
+}
+
+// NewSpaceSaving creates a new empty heavy hitters summary monitoring at most capacity items
+func NewSpaceSaving[T comparable](capacity int) *SpaceSaving[T] {
+	if capacity < 1 {
+		capacity = utils.DefaultCapacity
+	}
+	return &SpaceSaving[T]{
+		capacity: capacity,
+		entries:  make(map[T]*ssEntry[T], capacity),
+		heap:     make(ssHeap[T], 0, capacity),
+	}
+}
+
+// Size returns the number of monitored items
+func (s *SpaceSaving[T]) Size() int {
+	return len(s.heap)
+}
+
+// IsEmpty checks if no item has been added
+func (s *SpaceSaving[T]) IsEmpty() bool {
+	return len(s.heap) == 0
+}
+
+// Capacity returns the maximum number of monitored items
+func (s *SpaceSaving[T]) Capacity() int {
+	return s.capacity
+}
+
+// Total returns the number of occurrences added across all items
+func (s *SpaceSaving[T]) Total() uint64 {
+	return s.total
+}
+
+// Add records one occurrence of item
+func (s *SpaceSaving[T]) Add(item T) {
+	s.AddCount(item, 1)
+}
+
+// AddCount records count occurrences of item
+func (s *SpaceSaving[T]) AddCount(item T, count uint64) {
+	if count == 0 {
+		return
+	}
+	s.total += count
+	s.add(item, count)
+}
+
+// Estimate returns the approximate frequency of item
+// Returns false if the item is not monitored, in which case its true count is at most MinCount
+func (s *SpaceSaving[T]) Estimate(item T) (Estimate[T], bool) {
+	e, ok := s.entries[item]
+	if !ok {
+		return Estimate[T]{Item: item}, false
+	}
+	return Estimate[T]{Item: e.item, Count: e.count, Error: e.err}, true
+}
+
+// MinCount returns the smallest monitored count once every counter is in use, or zero before that
+// It bounds the count of any item that is not monitored
+func (s *SpaceSaving[T]) MinCount() uint64 {
+	if len(s.heap) < s.capacity {
+		return 0
+	}
+	return s.heap[0].count
+}
+
+// Top returns up to k monitored items with the highest estimated counts, most frequent first
+// Ties are broken by the smaller error, which has the higher guaranteed count
+func (s *SpaceSaving[T]) Top(k int) []Estimate[T] {
+	all := make([]Estimate[T], len(s.heap))
+	for i, e := range s.heap {
+		all[i] = Estimate[T]{Item: e.item, Count: e.count, Error: e.err}
+	}
+	slices.SortStableFunc(all, func(a, b Estimate[T]) int {
+		if a.Count != b.Count {
+			if a.Count > b.Count {
+				return -1
+			}
+			return 1
+		}
+		switch {
+		case a.Error < b.Error:
+			return -1
+		case a.Error > b.Error:
+			return 1
+		}
+		return 0
+	})
+	return all[:min(max(k, 0), len(all))]
+}
+
+// Merge folds the counters of other into this summary, keeping this summary's capacity
+// An item missing from one summary is credited with that summary's MinCount as both count and error,
+// so the merged bounds stay valid for the combined stream
+func (s *SpaceSaving[T]) Merge(other *SpaceSaving[T]) {
+	selfMin, otherMin := s.MinCount(), other.MinCount()
+
+	merged := make(map[T]*ssEntry[T], len(s.entries)+len(other.entries))
+	for item, e := range s.entries {
+		merged[item] = &ssEntry[T]{item: item, count: e.count + otherMin, err: e.err + otherMin}
+	}
+	for item, e := range other.entries {
+		if m, ok := merged[item]; ok {
+			m.count += e.count - otherMin
+			m.err += e.err - otherMin
+			continue
+		}
+		merged[item] = &ssEntry[T]{item: item, count: e.count + selfMin, err: e.err + selfMin}
+	}
+
+	// Keep the capacity largest counters
+	candidates := make([]*ssEntry[T], 0, len(merged))
+	for _, e := range merged {
+		candidates = append(candidates, e)
+	}
+	slices.SortFunc(candidates, func(a, b *ssEntry[T]) int {
+		switch {
+		case a.count > b.count:
+			return -1
+		case a.count < b.count:
+			return 1
+		}
+		return 0
+	})
+	candidates = candidates[:min(len(candidates), s.capacity)]
+
+	s.total += other.total
+	s.entries = make(map[T]*ssEntry[T], s.capacity)
+	s.heap = s.heap[:0]
+	for _, e := range candidates {
+		s.entries[e.item] = e
+		heap.Push(&s.heap, e)
+	}
+}
+
+// Clear removes all counters from the summary
+func (s *SpaceSaving[T]) Clear() {
+	clear(s.entries)
+	clear(s.heap)
+	s.heap = s.heap[:0]
+	s.total = 0
+}
+
+// add credits count occurrences to item, evicting the least counted item when the summary is full
+func (s *SpaceSaving[T]) add(item T, count uint64) {
+	if e, ok := s.entries[item]; ok {
+		e.count += count
+		heap.Fix(&s.heap, e.index)
+		return
+	}
+	if len(s.heap) < s.capacity {
+		e := &ssEntry[T]{item: item, count: count}
+		s.entries[item] = e
+		heap.Push(&s.heap, e)
+		return
+	}
+
+	e := s.heap[0]
+	delete(s.entries, e.item)
+	e.item, e.err, e.count = item, e.count, e.count+count
+	s.entries[item] = e
+	heap.Fix(&s.heap, 0)
+}
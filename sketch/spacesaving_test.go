@@ -0,0 +1,90 @@
+package sketch
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestSpaceSavingTop(t *testing.T) {
+	s := NewSpaceSaving[string](3)
+	for _, v := range []string{"a", "b", "a", "c", "a", "b", "d"} {
+		s.Add(v)
+	}
+	if s.Size() != 3 || s.Total() != 7 {
+		t.Fatalf("expected size 3 total 7 got %d %d", s.Size(), s.Total())
+	}
+
+	top := s.Top(2)
+	if len(top) != 2 || top[0].Item != "a" || top[0].Count != 3 || top[0].Error != 0 {
+		t.Fatalf("expected a with count 3 first got %v", top)
+	}
+	// d replaced c, the least counted item, and inherited its count as error
+	if _, ok := s.Estimate("c"); ok {
+		t.Fatalf("expected c to be evicted")
+	}
+	if e, ok := s.Estimate("d"); !ok || e.Count != 2 || e.Error != 1 {
+		t.Fatalf("expected d count 2 error 1 got %v %v", e, ok)
+	}
+	if s.MinCount() != 2 {
+		t.Fatalf("expected min count 2 got %d", s.MinCount())
+	}
+	if len(s.Top(10)) != 3 || len(s.Top(-1)) != 0 {
+		t.Fatalf("unexpected Top lengths")
+	}
+
+	s.Clear()
+	if !s.IsEmpty() || s.Total() != 0 {
+		t.Fatalf("expected empty summary after Clear")
+	}
+}
+
+func TestSpaceSavingBounds(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	s := NewSpaceSaving[int](20)
+	exact := make(map[int]uint64)
+	for i := 0; i < 10000; i++ {
+		// Skewed stream: small values are far more frequent
+		v := int(r.ExpFloat64() * 10)
+		s.Add(v)
+		exact[v]++
+	}
+
+	for _, e := range s.Top(20) {
+		if e.Count < exact[e.Item] || e.Count-e.Error > exact[e.Item] {
+			t.Fatalf("expected %d in [%d, %d] for item %d", exact[e.Item], e.Count-e.Error, e.Count, e.Item)
+		}
+	}
+	for v, n := range exact {
+		if n > s.Total()/20 {
+			if _, ok := s.Estimate(v); !ok {
+				t.Fatalf("expected heavy hitter %d with count %d to be monitored", v, n)
+			}
+		}
+	}
+}
+
+func TestSpaceSavingMerge(t *testing.T) {
+	a := NewSpaceSaving[string](2)
+	b := NewSpaceSaving[string](2)
+	for _, v := range []string{"x", "x", "x", "y", "z"} {
+		a.Add(v)
+	}
+	for _, v := range []string{"x", "w", "w", "w", "w"} {
+		b.Add(v)
+	}
+
+	a.Merge(b)
+	if a.Total() != 10 || a.Size() != 2 {
+		t.Fatalf("expected total 10 size 2 got %d %d", a.Total(), a.Size())
+	}
+	top := a.Top(2)
+	if top[0].Item != "x" && top[0].Item != "w" {
+		t.Fatalf("expected x or w first got %v", top)
+	}
+	for _, e := range top {
+		exact := map[string]uint64{"x": 4, "w": 4}[e.Item]
+		if e.Count < exact || e.Count-e.Error > exact {
+			t.Fatalf("expected %d in [%d, %d] for %s", exact, e.Count-e.Error, e.Count, e.Item)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package sketch
+
+import (
+	"math"
+	"time"
+
+	"github.com/profoundwu/containers/internal/utils"
+)
+
+const (
+	defaultWindowGenerations = 4
+	// windowFalsePositiveRate is the target false positive rate of each generation
+	windowFalsePositiveRate = 0.01
+)
+
+// bloomBits is a fixed size Bloom filter using double hashing
+type bloomBits struct {
+	words  []uint64
+	bits   uint64
+	hashes int
+}
+
+// newBloomBits sizes a Bloom filter for n items at false positive rate p
+func newBloomBits(n int, p float64) bloomBits {
+	bits := uint64(math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2)))
+	bits = max(bits, 64)
+	hashes := max(int(math.Round(float64(bits)/float64(n)*math.Ln2)), 1)
+	return bloomBits{words: make([]uint64, (bits+63)/64), bits: bits, hashes: hashes}
+}
+
+func (b *bloomBits) add(h1, h2 uint64) {
+	for i := 0; i < b.hashes; i++ {
+		bit := (h1 + uint64(i)*h2) % b.bits
+		b.words[bit/64] |= 1 << (bit % 64)
+	}
+}
+
+func (b *bloomBits) contains(h1, h2 uint64) bool {
+	for i := 0; i < b.hashes; i++ {
+		bit := (h1 + uint64(i)*h2) % b.bits
+		if b.words[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// WindowedBloomFilter answers whether an item was seen within a recent time window
+// It keeps a ring of Bloom filter generations, each covering an equal slice of the window,
+// and discards the oldest generation as time moves on, so memory stays fixed
+// Items added within the last window are always reported; items are forgotten at most one
+// slice after they leave the window
+// It is not safe for concurrent use
+type WindowedBloomFilter struct {
+	gens  []bloomBits
+	head  int // index of the generation receiving new items
+	start time.Time
+	slice time.Duration
+
+	now func() time.Time
+}
+
+// NewWindowedBloomFilter creates a new empty filter remembering items for window,
+// sized for capacity distinct items per window
+func NewWindowedBloomFilter(window time.Duration, capacity int) *WindowedBloomFilter {
+	return NewWindowedBloomFilterWithGenerations(window, capacity, defaultWindowGenerations)
+}
+
+// NewWindowedBloomFilterWithGenerations creates a new empty filter that splits window into
+// the given number of generations
+// More generations forget expired items more promptly at the cost of slower lookups
+// Values of generations below 1 fall back to the default of 4
+func NewWindowedBloomFilterWithGenerations(window time.Duration, capacity, generations int) *WindowedBloomFilter {
+	if capacity < 1 {
+		capacity = utils.DefaultCapacity
+	}
+	if generations < 1 {
+		generations = defaultWindowGenerations
+	}
+	// One extra generation keeps the oldest slice of the window alive while the newest fills
+	// Each generation is sized for the whole capacity so that a burst in one slice does not
+	// saturate it
+	gens := make([]bloomBits, generations+1)
+	for i := range gens {
+		gens[i] = newBloomBits(capacity, windowFalsePositiveRate)
+	}
+	f := &WindowedBloomFilter{
+		gens:  gens,
+		slice: max(window/time.Duration(generations), 1),
+		now:   time.Now,
+	}
+	f.start = f.now()
+	return f
+}
+
+// Window returns how long added items are guaranteed to be remembered
+func (f *WindowedBloomFilter) Window() time.Duration {
+	return f.slice * time.Duration(len(f.gens)-1)
+}
+
+// Add records item as seen now
+func (f *WindowedBloomFilter) Add(item []byte) {
+	f.advance()
+	h1, h2 := bloomHashes(item)
+	f.gens[f.head].add(h1, h2)
+}
+
+// Contains checks if item may have been added within the window
+// A false result is definite, a true result is wrong with a small probability
+func (f *WindowedBloomFilter) Contains(item []byte) bool {
+	f.advance()
+	h1, h2 := bloomHashes(item)
+	for i := range f.gens {
+		if f.gens[i].contains(h1, h2) {
+			return true
+		}
+	}
+	return false
+}
+
+// AddIfAbsent records item as seen now
+// Returns true if item was not seen within the window, which makes it convenient for dedup
+func (f *WindowedBloomFilter) AddIfAbsent(item []byte) bool {
+	if f.Contains(item) {
+		return false
+	}
+	h1, h2 := bloomHashes(item)
+	f.gens[f.head].add(h1, h2)
+	return true
+}
+
+// Clear forgets every item
+func (f *WindowedBloomFilter) Clear() {
+	for i := range f.gens {
+		clear(f.gens[i].words)
+	}
+	f.start = f.now()
+}
+
+// advance retires generations whose slice of the window has passed
+func (f *WindowedBloomFilter) advance() {
+	elapsed := int64(f.now().Sub(f.start) / f.slice)
+	if elapsed <= 0 {
+		return
+	}
+	for i := int64(0); i < min(elapsed, int64(len(f.gens))); i++ {
+		f.head = (f.head + 1) % len(f.gens)
+		clear(f.gens[f.head].words)
+	}
+	f.start = f.start.Add(time.Duration(elapsed) * f.slice)
+}
+
+// bloomHashes returns the two base hashes used for double hashing
+func bloomHashes(item []byte) (uint64, uint64) {
+	return hash64(0, item), hash64(1, item) | 1
+}
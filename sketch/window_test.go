@@ -0,0 +1,69 @@
+package sketch
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestWindowedBloomFilterExpiry(t *testing.T) {
+	clock := time.Unix(0, 0)
+	f := NewWindowedBloomFilter(time.Minute, 100)
+	f.now = func() time.Time { return clock }
+	f.Clear()
+
+	if f.Window() != time.Minute {
+		t.Fatalf("expected window 1m got %v", f.Window())
+	}
+	f.Add([]byte("a"))
+	clock = clock.Add(30 * time.Second)
+	f.Add([]byte("b"))
+
+	clock = clock.Add(29 * time.Second)
+	if !f.Contains([]byte("a")) || !f.Contains([]byte("b")) {
+		t.Fatalf("expected both items within the window")
+	}
+
+	// a was added 75s ago, more than one slice past the window
+	clock = clock.Add(16 * time.Second)
+	if f.Contains([]byte("a")) {
+		t.Fatalf("expected a to be forgotten")
+	}
+	if !f.Contains([]byte("b")) {
+		t.Fatalf("expected b to still be remembered")
+	}
+
+	clock = clock.Add(time.Hour)
+	if f.Contains([]byte("b")) {
+		t.Fatalf("expected every item to be forgotten after a long gap")
+	}
+}
+
+func TestWindowedBloomFilterAddIfAbsent(t *testing.T) {
+	clock := time.Unix(0, 0)
+	f := NewWindowedBloomFilterWithGenerations(10*time.Second, 1000, 2)
+	f.now = func() time.Time { return clock }
+	f.Clear()
+
+	if !f.AddIfAbsent([]byte("event")) || f.AddIfAbsent([]byte("event")) {
+		t.Fatalf("expected only the first AddIfAbsent to succeed")
+	}
+	clock = clock.Add(20 * time.Second)
+	if !f.AddIfAbsent([]byte("event")) {
+		t.Fatalf("expected event to be accepted again after the window")
+	}
+
+	f.Clear()
+	for i := 0; i < 1000; i++ {
+		f.Add([]byte(strconv.Itoa(i)))
+	}
+	falsePositives := 0
+	for i := 1000; i < 11000; i++ {
+		if f.Contains([]byte(strconv.Itoa(i))) {
+			falsePositives++
+		}
+	}
+	if rate := float64(falsePositives) / 10000; rate > 0.1 {
+		t.Fatalf("expected false positive rate below 0.1 got %f", rate)
+	}
+}
@@ -0,0 +1,148 @@
+package testutil
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+
+	"github.com/profoundwu/containers/list"
+)
+
+// ListOps returns operations exercising the List interface against a slice model
+// gen produces the element values to insert
+func ListOps[T comparable](gen func(r *rand.Rand) T) []Op[list.List[T], *[]T] {
+	return []Op[list.List[T], *[]T]{
+		{Name: "AddLast", Weight: 4, Apply: func(r *rand.Rand, l list.List[T], m *[]T) error {
+			v := gen(r)
+			l.AddLast(v)
+			*m = append(*m, v)
+			return nil
+		}},
+		{Name: "Add", Weight: 2, Apply: func(r *rand.Rand, l list.List[T], m *[]T) error {
+			index, v := r.Intn(len(*m)+2)-1, gen(r)
+			err := l.Add(index, v)
+			if index < 0 || index > len(*m) {
+				return expectError(err, list.ErrIndexOutOfBounds)
+			}
+			*m = slices.Insert(*m, index, v)
+			return err
+		}},
+		{Name: "Get", Weight: 2, Apply: func(r *rand.Rand, l list.List[T], m *[]T) error {
+			index := r.Intn(len(*m)+2) - 1
+			got, err := l.Get(index)
+			if index < 0 || index >= len(*m) {
+				return expectError(err, list.ErrIndexOutOfBounds)
+			}
+			return expectValue(got, err, (*m)[index])
+		}},
+		{Name: "Set", Apply: func(r *rand.Rand, l list.List[T], m *[]T) error {
+			index, v := r.Intn(len(*m)+2)-1, gen(r)
+			err := l.Set(index, v)
+			if index < 0 || index >= len(*m) {
+				return expectError(err, list.ErrIndexOutOfBounds)
+			}
+			(*m)[index] = v
+			return err
+		}},
+		{Name: "Remove", Weight: 2, Apply: func(r *rand.Rand, l list.List[T], m *[]T) error {
+			index := r.Intn(len(*m)+2) - 1
+			got, err := l.Remove(index)
+			if index < 0 || index >= len(*m) {
+				return expectError(err, list.ErrIndexOutOfBounds)
+			}
+			want := (*m)[index]
+			*m = slices.Delete(*m, index, index+1)
+			return expectValue(got, err, want)
+		}},
+		{Name: "RemoveFirst", Apply: func(r *rand.Rand, l list.List[T], m *[]T) error {
+			got, err := l.RemoveFirst()
+			if len(*m) == 0 {
+				return expectError(err, list.ErrEmptyList)
+			}
+			want := (*m)[0]
+			*m = slices.Delete(*m, 0, 1)
+			return expectValue(got, err, want)
+		}},
+		{Name: "RemoveLast", Apply: func(r *rand.Rand, l list.List[T], m *[]T) error {
+			got, err := l.RemoveLast()
+			if len(*m) == 0 {
+				return expectError(err, list.ErrEmptyList)
+			}
+			want := (*m)[len(*m)-1]
+			*m = (*m)[:len(*m)-1]
+			return expectValue(got, err, want)
+		}},
+		{Name: "RemoveElement", Apply: func(r *rand.Rand, l list.List[T], m *[]T) error {
+			v := gen(r)
+			index := slices.Index(*m, v)
+			if got := l.RemoveElement(v); got != (index >= 0) {
+				return fmt.Errorf("RemoveElement(%v) returned %v", v, got)
+			}
+			if index >= 0 {
+				*m = slices.Delete(*m, index, index+1)
+			}
+			return nil
+		}},
+		{Name: "IndexOf", Apply: func(r *rand.Rand, l list.List[T], m *[]T) error {
+			v := gen(r)
+			if got, want := l.IndexOf(v), slices.Index(*m, v); got != want {
+				return fmt.Errorf("IndexOf(%v) returned %d, model has %d", v, got, want)
+			}
+			return nil
+		}},
+		{Name: "Reverse", Apply: func(r *rand.Rand, l list.List[T], m *[]T) error {
+			l.Reverse()
+			slices.Reverse(*m)
+			return nil
+		}},
+		{Name: "Clear", Apply: func(r *rand.Rand, l list.List[T], m *[]T) error {
+			// Keep clears rare so lists get a chance to grow
+			if r.Intn(10) == 0 {
+				l.Clear()
+				*m = (*m)[:0]
+			}
+			return nil
+		}},
+	}
+}
+
+// CheckList compares the size and contents of l with the model
+func CheckList[T comparable](l list.List[T], model *[]T) error {
+	if l.Size() != len(*model) {
+		return fmt.Errorf("size %d, model has %d", l.Size(), len(*model))
+	}
+	if got := l.ToSlice(); !slices.Equal(got, *model) {
+		return fmt.Errorf("contents %v, model has %v", got, *model)
+	}
+	return nil
+}
+
+// RunList runs ListOps against lists made by newList and checks them with CheckList after every step
+// Returns a *Divergence describing the first mismatch, nil if the list behaved like the model
+func RunList[T comparable](cfg Config, newList func() list.List[T], gen func(r *rand.Rand) T) error {
+	return Run(cfg, newList, func() *[]T { return new([]T) }, ListOps(gen), CheckList[T])
+}
+
+// RunListBytes runs ListOps chosen by data against a list made by newList, for use in fuzz targets
+// Returns a *Divergence describing the first mismatch, nil if the list behaved like the model
+func RunListBytes[T comparable](data []byte, newList func() list.List[T], gen func(r *rand.Rand) T) error {
+	return RunBytes(data, newList, func() *[]T { return new([]T) }, ListOps(gen), CheckList[T])
+}
+
+func expectError(err, want error) error {
+	if !errors.Is(err, want) {
+		return fmt.Errorf("expected error %v, got %v", want, err)
+	}
+	return nil
+}
+
+func expectValue[T comparable](got T, err error, want T) error {
+	if err != nil {
+		return fmt.Errorf("unexpected error %v", err)
+	}
+	if got != want {
+		return fmt.Errorf("returned %v, model has %v", got, want)
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package testutil
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// Map is the keyed container interface the map operations exercise
+// Containers with other method names can be checked through a small adapter
+type Map[K comparable, V comparable] interface {
+	Size() int
+	Put(key K, value V)
+	Get(key K) (V, bool)
+	Remove(key K) bool
+}
+
+// MapOps returns operations exercising the Map interface against a Go map model
+// genKey and genValue produce the keys and values to use; a small key space makes lookups
+// and removals hit existing keys
+func MapOps[K comparable, V comparable](genKey func(r *rand.Rand) K, genValue func(r *rand.Rand) V) []Op[Map[K, V], map[K]V] {
+	return []Op[Map[K, V], map[K]V]{
+		{Name: "Put", Weight: 4, Apply: func(r *rand.Rand, c Map[K, V], m map[K]V) error {
+			k, v := genKey(r), genValue(r)
+			c.Put(k, v)
+			m[k] = v
+			return nil
+		}},
+		{Name: "Get", Weight: 3, Apply: func(r *rand.Rand, c Map[K, V], m map[K]V) error {
+			k := genKey(r)
+			got, ok := c.Get(k)
+			want, wantOK := m[k]
+			if ok != wantOK || (ok && got != want) {
+				return fmt.Errorf("Get(%v) returned %v %v, model has %v %v", k, got, ok, want, wantOK)
+			}
+			return nil
+		}},
+		{Name: "Remove", Weight: 2, Apply: func(r *rand.Rand, c Map[K, V], m map[K]V) error {
+			k := genKey(r)
+			_, want := m[k]
+			if got := c.Remove(k); got != want {
+				return fmt.Errorf("Remove(%v) returned %v, model has %v", k, got, want)
+			}
+			delete(m, k)
+			return nil
+		}},
+	}
+}
+
+// CheckMap compares the size of c with the model and looks up every key of the model
+func CheckMap[K comparable, V comparable](c Map[K, V], model map[K]V) error {
+	if c.Size() != len(model) {
+		return fmt.Errorf("size %d, model has %d", c.Size(), len(model))
+	}
+	for k, want := range model {
+		if got, ok := c.Get(k); !ok || got != want {
+			return fmt.Errorf("Get(%v) returned %v %v, model has %v", k, got, ok, want)
+		}
+	}
+	return nil
+}
+
+// RunMap runs MapOps against maps made by newMap and checks them with CheckMap after every step
+// Returns a *Divergence describing the first mismatch, nil if the map behaved like the model
+func RunMap[K comparable, V comparable](cfg Config, newMap func() Map[K, V], genKey func(r *rand.Rand) K, genValue func(r *rand.Rand) V) error {
+	return Run(cfg, newMap, func() map[K]V { return make(map[K]V) }, MapOps(genKey, genValue), CheckMap[K, V])
+}
+
+// RunMapBytes runs MapOps chosen by data against a map made by newMap, for use in fuzz targets
+// Returns a *Divergence describing the first mismatch, nil if the map behaved like the model
+func RunMapBytes[K comparable, V comparable](data []byte, newMap func() Map[K, V], genKey func(r *rand.Rand) K, genValue func(r *rand.Rand) V) error {
+	return RunBytes(data, newMap, func() map[K]V { return make(map[K]V) }, MapOps(genKey, genValue), CheckMap[K, V])
+}
+
+// Set is the membership container interface the set operations exercise
+type Set[T comparable] interface {
+	Size() int
+	Add(elem T) bool
+	Remove(elem T) bool
+	Contains(elem T) bool
+}
+
+// SetOps returns operations exercising the Set interface against a Go map model
+// gen produces the elements to use
+func SetOps[T comparable](gen func(r *rand.Rand) T) []Op[Set[T], map[T]struct{}] {
+	return []Op[Set[T], map[T]struct{}]{
+		{Name: "Add", Weight: 4, Apply: func(r *rand.Rand, s Set[T], m map[T]struct{}) error {
+			v := gen(r)
+			_, present := m[v]
+			if got := s.Add(v); got == present {
+				return fmt.Errorf("Add(%v) returned %v, model has %v present", v, got, present)
+			}
+			m[v] = struct{}{}
+			return nil
+		}},
+		{Name: "Contains", Weight: 3, Apply: func(r *rand.Rand, s Set[T], m map[T]struct{}) error {
+			v := gen(r)
+			_, want := m[v]
+			if got := s.Contains(v); got != want {
+				return fmt.Errorf("Contains(%v) returned %v, model has %v", v, got, want)
+			}
+			return nil
+		}},
+		{Name: "Remove", Weight: 2, Apply: func(r *rand.Rand, s Set[T], m map[T]struct{}) error {
+			v := gen(r)
+			_, want := m[v]
+			if got := s.Remove(v); got != want {
+				return fmt.Errorf("Remove(%v) returned %v, model has %v", v, got, want)
+			}
+			delete(m, v)
+			return nil
+		}},
+	}
+}
+
+// CheckSet compares the size of s with the model and checks it contains every element of the model
+func CheckSet[T comparable](s Set[T], model map[T]struct{}) error {
+	if s.Size() != len(model) {
+		return fmt.Errorf("size %d, model has %d", s.Size(), len(model))
+	}
+	for v := range model {
+		if !s.Contains(v) {
+			return fmt.Errorf("missing %v", v)
+		}
+	}
+	return nil
+}
+
+// RunSet runs SetOps against sets made by newSet and checks them with CheckSet after every step
+// Returns a *Divergence describing the first mismatch, nil if the set behaved like the model
+func RunSet[T comparable](cfg Config, newSet func() Set[T], gen func(r *rand.Rand) T) error {
+	return Run(cfg, newSet, func() map[T]struct{} { return make(map[T]struct{}) }, SetOps(gen), CheckSet[T])
+}
+
+// RunSetBytes runs SetOps chosen by data against a set made by newSet, for use in fuzz targets
+// Returns a *Divergence describing the first mismatch, nil if the set behaved like the model
+func RunSetBytes[T comparable](data []byte, newSet func() Set[T], gen func(r *rand.Rand) T) error {
+	return RunBytes(data, newSet, func() map[T]struct{} { return make(map[T]struct{}) }, SetOps(gen), CheckSet[T])
+}
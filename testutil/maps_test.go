@@ -0,0 +1,80 @@
+package testutil
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/profoundwu/containers/maps"
+	"github.com/profoundwu/containers/set"
+	"github.com/profoundwu/containers/tree"
+)
+
+// treapMap adapts a treap to the Map interface
+type treapMap struct {
+	*tree.Treap[int, int]
+}
+
+func (m treapMap) Remove(key int) bool {
+	return m.Delete(key)
+}
+
+// sparseSet adapts a sparse set, whose Add also reports ids outside its universe, to the Set interface
+type sparseSet struct {
+	*set.SparseSet
+}
+
+func (s sparseSet) Add(id int) bool {
+	added, _ := s.SparseSet.Add(id)
+	return added
+}
+
+func TestRunMapImplementations(t *testing.T) {
+	cfg := Config{Seed: 3, Steps: 3000}
+	if err := RunMap(cfg, func() Map[int, int] { return treapMap{tree.NewTreap[int, int]()} }, smallInts, smallInts); err != nil {
+		t.Fatalf("treap diverged: %v", err)
+	}
+	if err := RunMap(cfg, func() Map[int, int] { return maps.NewHistoryMap[int, int](2) }, smallInts, smallInts); err != nil {
+		t.Fatalf("history map diverged: %v", err)
+	}
+}
+
+func TestRunSetImplementations(t *testing.T) {
+	cfg := Config{Seed: 5, Steps: 3000}
+	if err := RunSet(cfg, func() Set[int] { return set.NewConcurrentSet[int]() }, smallInts); err != nil {
+		t.Fatalf("concurrent set diverged: %v", err)
+	}
+	if err := RunSet(cfg, func() Set[int] { return sparseSet{set.NewSparseSet(20)} }, smallInts); err != nil {
+		t.Fatalf("sparse set diverged: %v", err)
+	}
+
+	// A universe smaller than the generated ids drops elements and must be caught
+	err := RunSet(cfg, func() Set[int] { return sparseSet{set.NewSparseSet(10)} }, smallInts)
+	var d *Divergence
+	if !errors.As(err, &d) || d.Op != "Add" {
+		t.Fatalf("expected a divergence on Add got %v", err)
+	}
+}
+
+func TestRunBytes(t *testing.T) {
+	data := make([]byte, 2000)
+	rand.New(rand.NewSource(1)).Read(data)
+	if err := RunMapBytes(data, func() Map[int, int] { return treapMap{tree.NewTreap[int, int]()} }, smallInts, smallInts); err != nil {
+		t.Fatalf("treap diverged: %v", err)
+	}
+	if err := RunSetBytes(data, func() Set[int] { return set.NewConcurrentSet[int]() }, smallInts); err != nil {
+		t.Fatalf("concurrent set diverged: %v", err)
+	}
+	err := RunSetBytes(data, func() Set[int] { return sparseSet{set.NewSparseSet(10)} }, smallInts)
+	var d *Divergence
+	if !errors.As(err, &d) || d.Seed != 0 {
+		t.Fatalf("expected a divergence got %v", err)
+	}
+	// The same input replays the same failure, and empty input runs nothing
+	if replay := RunSetBytes(data, func() Set[int] { return sparseSet{set.NewSparseSet(10)} }, smallInts); replay.Error() != err.Error() {
+		t.Fatalf("byte driven runs should be reproducible")
+	}
+	if err := RunSetBytes(nil, func() Set[int] { return sparseSet{set.NewSparseSet(1)} }, smallInts); err != nil {
+		t.Fatalf("expected an empty run got %v", err)
+	}
+}
@@ -0,0 +1,139 @@
+// Package testutil runs randomized operation sequences against a container and a simple reference
+// model side by side, reporting the first divergence with the seed needed to reproduce it
+package testutil
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// DefaultSteps is the number of operations Run performs when Config.Steps is not set
+const DefaultSteps = 1000
+
+// Config controls a randomized run
+type Config struct {
+	// Seed seeds the operation generator, zero picks a time based seed that is reported on failure
+	Seed int64
+	// Steps is the number of operations to perform, values below 1 fall back to DefaultSteps
+	Steps int
+}
+
+// Op is one kind of operation applied to the container under test and its model
+// Apply draws any arguments from r, performs the operation on both and returns an error describing
+// the mismatch if their results disagree
+type Op[S any, M any] struct {
+	Name string
+	// Weight sets how often the op is picked relative to the others, values below 1 count as 1
+	Weight int
+	Apply  func(r *rand.Rand, sut S, model M) error
+}
+
+// Divergence reports the first operation after which the container and the model disagreed
+type Divergence struct {
+	Seed  int64
+	Step  int
+	Op    string
+	Trace []string
+	Err   error
+}
+
+// Error returns a description of the divergence including the seed to replay it
+func (d *Divergence) Error() string {
+	return fmt.Sprintf("step %d (%s) diverged from the model with seed %d: %v\ntrace: %s",
+		d.Step, d.Op, d.Seed, d.Err, strings.Join(d.Trace, ", "))
+}
+
+// Unwrap returns the mismatch reported by the operation or the check
+func (d *Divergence) Unwrap() error {
+	return d.Err
+}
+
+// Run applies cfg.Steps randomly chosen ops to a fresh container and model, calling check after every step
+// Returns a *Divergence for the first op or check that fails, nil if the run completed
+func Run[S any, M any](cfg Config, newSUT func() S, newModel func() M, ops []Op[S, M], check func(sut S, model M) error) error {
+	if len(ops) == 0 {
+		return nil
+	}
+	if cfg.Seed == 0 {
+		cfg.Seed = time.Now().UnixNano()
+	}
+	if cfg.Steps < 1 {
+		cfg.Steps = DefaultSteps
+	}
+	return run(rand.New(rand.NewSource(cfg.Seed)), cfg.Seed, cfg.Steps, nil, newSUT, newModel, ops, check)
+}
+
+// RunBytes applies ops chosen by data instead of a seeded generator, so a fuzz target can hand
+// over its input and let the fuzzer explore operation sequences and arguments
+// Every random draw consumes one byte of data and the run ends once data is used up
+// Returns a *Divergence for the first op or check that fails, with a zero seed, nil if the run completed
+func RunBytes[S any, M any](data []byte, newSUT func() S, newModel func() M, ops []Op[S, M], check func(sut S, model M) error) error {
+	if len(ops) == 0 {
+		return nil
+	}
+	src := &byteSource{data: data}
+	return run(rand.New(src), 0, len(data), src.exhausted, newSUT, newModel, ops, check)
+}
+
+// run performs up to steps ops drawn from r, stopping early once done reports true
+func run[S any, M any](r *rand.Rand, seed int64, steps int, done func() bool, newSUT func() S, newModel func() M, ops []Op[S, M], check func(sut S, model M) error) error {
+	total := 0
+	for _, op := range ops {
+		total += max(op.Weight, 1)
+	}
+
+	sut, model := newSUT(), newModel()
+	trace := make([]string, 0, steps)
+	for step := 0; step < steps && (done == nil || !done()); step++ {
+		op := pick(r, ops, total)
+		trace = append(trace, op.Name)
+		err := op.Apply(r, sut, model)
+		if err == nil && check != nil {
+			err = check(sut, model)
+		}
+		if err != nil {
+			return &Divergence{Seed: seed, Step: step, Op: op.Name, Trace: trace, Err: err}
+		}
+	}
+	return nil
+}
+
+// pick chooses an op with probability proportional to its weight
+func pick[S any, M any](r *rand.Rand, ops []Op[S, M], total int) Op[S, M] {
+	n := r.Intn(total)
+	for _, op := range ops {
+		n -= max(op.Weight, 1)
+		if n < 0 {
+			return op
+		}
+	}
+	return ops[len(ops)-1]
+}
+
+// byteSource is a rand.Source that draws its values from a byte slice, one byte per value,
+// and yields zeros once the slice is used up
+type byteSource struct {
+	data []byte
+	pos  int
+}
+
+func (s *byteSource) Int63() int64 {
+	var b byte
+	if s.pos < len(s.data) {
+		b = s.data[s.pos]
+	}
+	s.pos++
+	// Spread the byte over every bit, since Intn keeps only the high bits of small draws
+	z := uint64(b) + 0x9e3779b97f4a7c15
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return int64((z ^ (z >> 31)) >> 1)
+}
+
+func (s *byteSource) Seed(int64) {}
+
+func (s *byteSource) exhausted() bool {
+	return s.pos >= len(s.data)
+}
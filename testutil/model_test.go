@@ -0,0 +1,100 @@
+package testutil
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/profoundwu/containers/list"
+)
+
+func smallInts(r *rand.Rand) int { return r.Intn(20) }
+
+func TestRunListImplementations(t *testing.T) {
+	cfg := Config{Seed: 42, Steps: 3000}
+	if err := RunList(cfg, func() list.List[int] { return list.NewArrayList[int]() }, smallInts); err != nil {
+		t.Fatalf("array list diverged: %v", err)
+	}
+	if err := RunList(cfg, func() list.List[int] { return list.NewLinkedList[int]() }, smallInts); err != nil {
+		t.Fatalf("linked list diverged: %v", err)
+	}
+	if err := RunList(cfg, func() list.List[int] { return list.NewLinkedListWithArena[int](8) }, smallInts); err != nil {
+		t.Fatalf("arena linked list diverged: %v", err)
+	}
+}
+
+// lossyList drops every element added after the list holds five
+type lossyList struct {
+	*list.ArrayList[int]
+}
+
+func (l lossyList) AddLast(v int) {
+	if l.Size() < 5 {
+		l.ArrayList.AddLast(v)
+	}
+}
+
+func TestRunListReportsDivergence(t *testing.T) {
+	err := RunList(Config{Seed: 7}, func() list.List[int] { return lossyList{list.NewArrayList[int]()} }, smallInts)
+	var d *Divergence
+	if !errors.As(err, &d) {
+		t.Fatalf("expected a divergence got %v", err)
+	}
+	if d.Seed != 7 || d.Op != "AddLast" || len(d.Trace) != d.Step+1 {
+		t.Fatalf("unexpected divergence %+v", d)
+	}
+	if !strings.Contains(err.Error(), "seed 7") {
+		t.Fatalf("error should mention the seed: %v", err)
+	}
+
+	// The same seed replays the same failure
+	replay := RunList(Config{Seed: 7}, func() list.List[int] { return lossyList{list.NewArrayList[int]()} }, smallInts)
+	if replay.Error() != err.Error() {
+		t.Fatalf("seeded runs should be reproducible")
+	}
+}
+
+func TestRunCustomOps(t *testing.T) {
+	counts := make(map[string]int)
+	ops := []Op[*int, *int]{
+		{Name: "inc", Weight: 9, Apply: func(r *rand.Rand, sut, model *int) error {
+			counts["inc"]++
+			*sut++
+			*model++
+			return nil
+		}},
+		{Name: "dec", Apply: func(r *rand.Rand, sut, model *int) error {
+			counts["dec"]++
+			*sut--
+			*model--
+			return nil
+		}},
+	}
+	check := func(sut, model *int) error {
+		if *sut != *model {
+			return fmt.Errorf("%d != %d", *sut, *model)
+		}
+		return nil
+	}
+	if err := Run(Config{Seed: 1}, func() *int { return new(int) }, func() *int { return new(int) }, ops, check); err != nil {
+		t.Fatalf("unexpected divergence: %v", err)
+	}
+	if counts["inc"]+counts["dec"] != DefaultSteps || counts["inc"] < 5*counts["dec"] {
+		t.Fatalf("unexpected op distribution %v", counts)
+	}
+}
+
+func FuzzRunListBytes(f *testing.F) {
+	f.Add([]byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9})
+	f.Add([]byte("add remove reverse clear"))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if err := RunListBytes(data, func() list.List[int] { return list.NewArrayList[int]() }, smallInts); err != nil {
+			t.Fatal(err)
+		}
+		if err := RunListBytes(data, func() list.List[int] { return list.NewLinkedList[int]() }, smallInts); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
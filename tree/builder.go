@@ -0,0 +1,177 @@
+package tree
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+
+	"github.com/profoundwu/containers/compare"
+	"github.com/profoundwu/containers/pair"
+)
+
+// TreapBuilder accumulates entries cheaply and bulk-loads them into a read-only treap in one step, for
+// static data loaded at startup
+// Putting is amortized O(1) and Freeze sorts once and links the nodes in O(n), where putting into
+// a Treap splits and merges on every insertion
+type TreapBuilder[K any, V any] struct {
+	entries []pair.Pair[K, V]
+	cmp     compare.Comparator[K]
+}
+
+// NewTreapBuilder creates a new empty builder for a treap ordered by the natural order of K
+func NewTreapBuilder[K cmp.Ordered, V any]() *TreapBuilder[K, V] {
+	return NewTreapBuilderWithComparator[K, V](compare.Natural[K]())
+}
+
+// NewTreapBuilderWithComparator creates a new empty builder for a treap ordered by comparator
+func NewTreapBuilderWithComparator[K any, V any](comparator compare.Comparator[K]) *TreapBuilder[K, V] {
+	return &TreapBuilder[K, V]{cmp: comparator}
+}
+
+// Size returns the number of entries put since the last Freeze, counting repeated keys
+func (b *TreapBuilder[K, V]) Size() int {
+	return len(b.entries)
+}
+
+// Grow makes room for at least n more entries, so the next n puts do not reallocate
+func (b *TreapBuilder[K, V]) Grow(n int) {
+	if n > 0 {
+		b.entries = slices.Grow(b.entries, n)
+	}
+}
+
+// Put adds value under key in any order; when a key is put more than once the last value wins
+func (b *TreapBuilder[K, V]) Put(key K, value V) {
+	b.entries = append(b.entries, pair.New(key, value))
+}
+
+// Freeze builds a new read-only treap from the entries put and empties the builder
+// Later puts do not affect the treap
+func (b *TreapBuilder[K, V]) Freeze() *FrozenTreap[K, V] {
+	entries := b.entries
+	b.entries = nil
+	slices.SortStableFunc(entries, func(x, y pair.Pair[K, V]) int { return b.cmp(x.Key(), y.Key()) })
+
+	// Collapse repeated keys, keeping the value put last
+	distinct := entries[:0]
+	for _, e := range entries {
+		if last := len(distinct) - 1; last >= 0 && b.cmp(distinct[last].Key(), e.Key()) == 0 {
+			distinct[last] = e
+			continue
+		}
+		distinct = append(distinct, e)
+	}
+
+	t := NewTreapWithComparator[K, V](b.cmp)
+	t.root = t.build(distinct)
+	return &FrozenTreap[K, V]{treap: t}
+}
+
+// build links sorted distinct entries into a treap with random priorities in O(n)
+// Each node is pushed on the right spine, adopting as its left subtree the spine nodes of lower
+// priority it displaces
+func (t *Treap[K, V]) build(entries []pair.Pair[K, V]) *treapNode[K, V] {
+	var spine []*treapNode[K, V]
+	for _, e := range entries {
+		n := t.newNode()
+		*n = treapNode[K, V]{key: e.Key(), value: e.Value(), priority: rand.Uint32(), size: 1}
+		var last *treapNode[K, V]
+		for len(spine) > 0 && spine[len(spine)-1].priority < n.priority {
+			last = spine[len(spine)-1]
+			spine = spine[:len(spine)-1]
+		}
+		n.left = last
+		if len(spine) > 0 {
+			spine[len(spine)-1].right = n
+		}
+		spine = append(spine, n)
+	}
+	if len(spine) == 0 {
+		return nil
+	}
+	root := spine[0]
+	updateSizes(root)
+	return root
+}
+
+// updateSizes recomputes the subtree sizes below and including n
+func updateSizes[K any, V any](n *treapNode[K, V]) {
+	if n == nil {
+		return
+	}
+	updateSizes(n.left)
+	updateSizes(n.right)
+	n.update()
+}
+
+// FrozenTreap is a read-only treap produced by TreapBuilder
+// It has no mutating methods, so it can be shared freely once built; use Thaw for a mutable copy
+type FrozenTreap[K any, V any] struct {
+	treap *Treap[K, V]
+}
+
+// Size returns the number of entries in the treap
+func (ft *FrozenTreap[K, V]) Size() int {
+	return ft.treap.Size()
+}
+
+// IsEmpty checks if the treap is empty
+func (ft *FrozenTreap[K, V]) IsEmpty() bool {
+	return ft.treap.IsEmpty()
+}
+
+// Get returns the value stored for key
+// Returns false if the key is not present
+func (ft *FrozenTreap[K, V]) Get(key K) (V, bool) {
+	return ft.treap.Get(key)
+}
+
+// Contains checks if the treap holds key
+func (ft *FrozenTreap[K, V]) Contains(key K) bool {
+	return ft.treap.Contains(key)
+}
+
+// Select returns the entry with the k-th smallest key, counting from 0
+// Returns error if k is out of bounds
+func (ft *FrozenTreap[K, V]) Select(k int) (K, V, error) {
+	return ft.treap.Select(k)
+}
+
+// Rank returns the number of keys less than key
+func (ft *FrozenTreap[K, V]) Rank(key K) int {
+	return ft.treap.Rank(key)
+}
+
+// Min returns the entry with the smallest key
+// Returns false if the treap is empty
+func (ft *FrozenTreap[K, V]) Min() (K, V, bool) {
+	return ft.treap.Min()
+}
+
+// Max returns the entry with the largest key
+// Returns false if the treap is empty
+func (ft *FrozenTreap[K, V]) Max() (K, V, bool) {
+	return ft.treap.Max()
+}
+
+// Keys returns all keys in ascending order
+func (ft *FrozenTreap[K, V]) Keys() []K {
+	return ft.treap.Keys()
+}
+
+// Entries returns all key-value pairs in ascending key order
+func (ft *FrozenTreap[K, V]) Entries() []pair.Pair[K, V] {
+	return ft.treap.Entries()
+}
+
+// Thaw returns a mutable copy of the treap, rebuilt from its entries in O(n)
+func (ft *FrozenTreap[K, V]) Thaw() *Treap[K, V] {
+	t := NewTreapWithComparator[K, V](ft.treap.cmp)
+	t.root = t.build(ft.treap.Entries())
+	return t
+}
+
+// String returns a string representation of the treap in ascending key order
+func (ft *FrozenTreap[K, V]) String() string {
+	return ft.treap.String()
+}
@@ -0,0 +1,66 @@
+package tree
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/profoundwu/containers/compare"
+)
+
+func TestTreapBuilder(t *testing.T) {
+	b := NewTreapBuilder[int, string]()
+	b.Grow(4)
+	b.Put(3, "c")
+	b.Put(1, "a")
+	b.Put(2, "b")
+	b.Put(1, "A")
+	if b.Size() != 4 {
+		t.Fatalf("expected 4 entries got %d", b.Size())
+	}
+	ft := b.Freeze()
+	if err := ft.treap.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ft.String() != "{1: A, 2: b, 3: c}" || b.Size() != 0 {
+		t.Fatalf("expected the last value per key and an empty builder got %s %d", ft, b.Size())
+	}
+
+	// The frozen treap is independent of the builder, and thawing copies it
+	b.Put(4, "d")
+	tr := ft.Thaw()
+	tr.Put(5, "e")
+	if ft.Contains(4) || ft.Contains(5) || ft.Size() != 3 || tr.Size() != 4 {
+		t.Fatalf("expected the frozen treap unchanged got %s %s", ft, tr)
+	}
+	if err := tr.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if empty := b.Freeze(); empty.Size() != 1 || NewTreapBuilder[int, int]().Freeze().Size() != 0 {
+		t.Fatalf("expected builders to freeze what they hold")
+	}
+}
+
+func TestTreapBuilderMatchesPut(t *testing.T) {
+	b := NewTreapBuilderWithComparator[string, int](compare.Comparator[string](strings.Compare))
+	want := NewTreapWithComparator[string, int](strings.Compare)
+	for i := 0; i < 1000; i++ {
+		key := string(rune('a' + rand.Intn(26)))
+		key += string(rune('a' + rand.Intn(26)))
+		b.Put(key, i)
+		want.Put(key, i)
+	}
+	tr := b.Freeze()
+	if err := tr.treap.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tr.String() != want.String() {
+		t.Fatalf("expected %s got %s", want, tr)
+	}
+	for k := 0; k < tr.Size(); k++ {
+		key, _, _ := tr.Select(k)
+		if tr.Rank(key) != k {
+			t.Fatalf("expected rank %d for %s got %d", k, key, tr.Rank(key))
+		}
+	}
+}
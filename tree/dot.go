@@ -0,0 +1,208 @@
+package tree
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/profoundwu/containers/internal/utils"
+)
+
+// ExportDOT writes the structure of the splay tree to w in Graphviz DOT format, one node per key
+func (t *SplayTree[K, V]) ExportDOT(w io.Writer) error {
+	return t.ExportDOTFunc(w, nil)
+}
+
+// ExportDOTFunc writes the tree like ExportDOT, filling each node with the Graphviz color
+// returned by color, which may return "" to leave a node unstyled
+func (t *SplayTree[K, V]) ExportDOTFunc(w io.Writer, color func(key K, value V) string) error {
+	return t.shape(color).writeDOT(w)
+}
+
+// Pretty renders the structure of the splay tree sideways, the root at the left edge
+func (t *SplayTree[K, V]) Pretty() string {
+	return t.shape(nil).pretty()
+}
+
+func (t *SplayTree[K, V]) shape(color func(key K, value V) string) binaryTree[*splayNode[K, V]] {
+	shape := binaryTree[*splayNode[K, V]]{
+		root:     t.root,
+		children: func(n *splayNode[K, V]) (*splayNode[K, V], *splayNode[K, V]) { return n.left, n.right },
+		label:    func(n *splayNode[K, V]) string { return fmt.Sprint(n.key) },
+	}
+	if color != nil {
+		shape.color = func(n *splayNode[K, V]) string { return color(n.key, n.value) }
+	}
+	return shape
+}
+
+// ExportDOT writes the structure of the treap to w in Graphviz DOT format,
+// labelling each node with its key and heap priority
+func (t *Treap[K, V]) ExportDOT(w io.Writer) error {
+	return t.ExportDOTFunc(w, nil)
+}
+
+// ExportDOTFunc writes the treap like ExportDOT, filling each node with the Graphviz color
+// returned by color, which may return "" to leave a node unstyled
+func (t *Treap[K, V]) ExportDOTFunc(w io.Writer, color func(key K, value V) string) error {
+	return t.shape(color).writeDOT(w)
+}
+
+// Pretty renders the structure of the treap sideways, the root at the left edge
+func (t *Treap[K, V]) Pretty() string {
+	return t.shape(nil).pretty()
+}
+
+func (t *Treap[K, V]) shape(color func(key K, value V) string) binaryTree[*treapNode[K, V]] {
+	shape := binaryTree[*treapNode[K, V]]{
+		root:     t.root,
+		children: func(n *treapNode[K, V]) (*treapNode[K, V], *treapNode[K, V]) { return n.left, n.right },
+		label:    func(n *treapNode[K, V]) string { return fmt.Sprintf("%v p=%d", n.key, n.priority) },
+	}
+	if color != nil {
+		shape.color = func(n *treapNode[K, V]) string { return color(n.key, n.value) }
+	}
+	return shape
+}
+
+// ExportDOT writes the structure of the interval tree to w in Graphviz DOT format,
+// labelling each node with its interval, subtree maximum and AVL height
+func (t *IntervalTree[K, V]) ExportDOT(w io.Writer) error {
+	return t.ExportDOTFunc(w, nil)
+}
+
+// ExportDOTFunc writes the tree like ExportDOT, filling each node with the Graphviz color
+// returned by color, which may return "" to leave a node unstyled
+func (t *IntervalTree[K, V]) ExportDOTFunc(w io.Writer, color func(iv Interval[K, V]) string) error {
+	return t.shape(color).writeDOT(w)
+}
+
+// Pretty renders the structure of the interval tree sideways, the root at the left edge
+func (t *IntervalTree[K, V]) Pretty() string {
+	return t.shape(nil).pretty()
+}
+
+func (t *IntervalTree[K, V]) shape(color func(iv Interval[K, V]) string) binaryTree[*intervalNode[K, V]] {
+	shape := binaryTree[*intervalNode[K, V]]{
+		root:     t.root,
+		children: func(n *intervalNode[K, V]) (*intervalNode[K, V], *intervalNode[K, V]) { return n.left, n.right },
+		label: func(n *intervalNode[K, V]) string {
+			return fmt.Sprintf("[%v, %v] max=%v h=%d", n.interval.Low, n.interval.High, n.max, n.height)
+		},
+	}
+	if color != nil {
+		shape.color = func(n *intervalNode[K, V]) string { return color(n.interval) }
+	}
+	return shape
+}
+
+// ExportDOT writes the structure of the k-d tree to w in Graphviz DOT format, one node per point
+func (t *KDTree[T]) ExportDOT(w io.Writer) error {
+	return t.ExportDOTFunc(w, nil)
+}
+
+// ExportDOTFunc writes the tree like ExportDOT, filling each node with the Graphviz color
+// returned by color, which may return "" to leave a node unstyled
+func (t *KDTree[T]) ExportDOTFunc(w io.Writer, color func(p KDPoint[T]) string) error {
+	return t.shape(color).writeDOT(w)
+}
+
+// Pretty renders the structure of the k-d tree sideways, the root at the left edge
+func (t *KDTree[T]) Pretty() string {
+	return t.shape(nil).pretty()
+}
+
+func (t *KDTree[T]) shape(color func(p KDPoint[T]) string) binaryTree[*kdNode[T]] {
+	shape := binaryTree[*kdNode[T]]{
+		root:     t.root,
+		children: func(n *kdNode[T]) (*kdNode[T], *kdNode[T]) { return n.left, n.right },
+		label:    func(n *kdNode[T]) string { return fmt.Sprint(n.point.Coords) },
+	}
+	if color != nil {
+		shape.color = func(n *kdNode[T]) string { return color(n.point) }
+	}
+	return shape
+}
+
+// binaryTree describes how to walk the nodes of one of the binary trees in this package
+// for Graphviz export, pretty printing and Stats
+type binaryTree[N comparable] struct {
+	root     N
+	children func(n N) (left, right N)
+	label    func(n N) string
+	// color returns a Graphviz color for the node, or "" to leave it unstyled
+	color func(n N) string
+}
+
+// writeDOT renders the tree as a Graphviz digraph
+// A missing child whose sibling exists is drawn as a point so left and right stay apart
+func (t binaryTree[N]) writeDOT(w io.Writer) error {
+	var buf bytes.Buffer
+	buf.WriteString("digraph tree {\n\tnode [shape=box];\n")
+
+	var nilNode N
+	next := 0
+	var walk func(n N) int
+	walk = func(n N) int {
+		id := next
+		next++
+		fmt.Fprintf(&buf, "\tn%d [label=%s", id, utils.DOTQuote(t.label(n)))
+		if t.color != nil {
+			if c := t.color(n); c != "" {
+				fmt.Fprintf(&buf, ", style=filled, fillcolor=%s", utils.DOTQuote(c))
+			}
+		}
+		buf.WriteString("];\n")
+
+		left, right := t.children(n)
+		if left == nilNode && right == nilNode {
+			return id
+		}
+		for _, child := range []N{left, right} {
+			if child == nilNode {
+				fmt.Fprintf(&buf, "\tn%d [shape=point];\n\tn%d -> n%d;\n", next, id, next)
+				next++
+			} else {
+				fmt.Fprintf(&buf, "\tn%d -> n%d;\n", id, walk(child))
+			}
+		}
+		return id
+	}
+	if t.root != nilNode {
+		walk(t.root)
+	}
+
+	buf.WriteString("}\n")
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+// pretty renders the tree sideways with box-drawing branches, the right subtree above each node,
+// so the output reads as the tree rotated a quarter turn counterclockwise
+func (t binaryTree[N]) pretty() string {
+	var sb strings.Builder
+	var nilNode N
+	var walk func(n N, prefix string, isRight bool)
+	walk = func(n N, prefix string, isRight bool) {
+		if n == nilNode {
+			return
+		}
+		left, right := t.children(n)
+		connector, rightPrefix, leftPrefix := "└── ", prefix+"│   ", prefix+"    "
+		if isRight {
+			connector, rightPrefix, leftPrefix = "┌── ", prefix+"    ", prefix+"│   "
+		}
+		walk(right, rightPrefix, true)
+		sb.WriteString(prefix + connector + t.label(n) + "\n")
+		walk(left, leftPrefix, false)
+	}
+
+	if t.root != nilNode {
+		left, right := t.children(t.root)
+		walk(right, "", true)
+		sb.WriteString(t.label(t.root) + "\n")
+		walk(left, "", false)
+	}
+	return sb.String()
+}
@@ -0,0 +1,79 @@
+package tree
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSplayTreeExportDOT(t *testing.T) {
+	st := NewSplayTree[int, string]()
+	var sb strings.Builder
+	if err := st.ExportDOT(&sb); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sb.String() != "digraph tree {\n\tnode [shape=box];\n}\n" {
+		t.Fatalf("unexpected empty tree output %q", sb.String())
+	}
+
+	// Each Put splays the new key to the root, leaving a left spine 3 -> 2 -> 1
+	st.Put(1, "a")
+	st.Put(2, "b")
+	st.Put(3, "c")
+	sb.Reset()
+	err := st.ExportDOTFunc(&sb, func(key int, value string) string {
+		if key == 3 {
+			return "gold"
+		}
+		return ""
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `digraph tree {
+	node [shape=box];
+	n0 [label="3", style=filled, fillcolor="gold"];
+	n1 [label="2"];
+	n2 [label="1"];
+	n1 -> n2;
+	n3 [shape=point];
+	n1 -> n3;
+	n0 -> n1;
+	n4 [shape=point];
+	n0 -> n4;
+}
+`
+	if sb.String() != expected {
+		t.Fatalf("expected %q got %q", expected, sb.String())
+	}
+	if got := st.Pretty(); got != "3\n└── 2\n    └── 1\n" {
+		t.Fatalf("unexpected pretty output %q", got)
+	}
+}
+
+func TestTreePretty(t *testing.T) {
+	it := NewIntervalTree[int, string]()
+	for _, low := range []int{2, 1, 3} {
+		if _, err := it.Insert(low, low+1, ""); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	expected := "┌── [3, 4] max=4 h=1\n[2, 3] max=4 h=2\n└── [1, 2] max=2 h=1\n"
+	if got := it.Pretty(); got != expected {
+		t.Fatalf("expected %q got %q", expected, got)
+	}
+
+	kd, err := NewKDTreeFromPoints(2, []KDPoint[string]{{Coords: []float64{1, 1}}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := kd.Pretty(); got != "[1 1]\n" {
+		t.Fatalf("expected [1 1] got %q", got)
+	}
+
+	tr := NewTreap[int, int]()
+	tr.Put(5, 0)
+	var sb strings.Builder
+	if err := tr.ExportDOT(&sb); err != nil || !strings.Contains(sb.String(), `label="5 p=`) {
+		t.Fatalf("unexpected treap output %q, %v", sb.String(), err)
+	}
+}
@@ -0,0 +1,274 @@
+package tree
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrInvalidInterval = errors.New("interval low bound exceeds high bound")
+)
+
+// Interval is a closed range [Low, High] carrying a value
+type Interval[K cmp.Ordered, V any] struct {
+	Low   K
+	High  K
+	Value V
+}
+
+// Overlaps checks if the interval shares at least one point with [low, high]
+func (iv Interval[K, V]) Overlaps(low, high K) bool {
+	return iv.Low <= high && low <= iv.High
+}
+
+type intervalNode[K cmp.Ordered, V any] struct {
+	interval Interval[K, V]
+	max      K
+	height   int
+	left     *intervalNode[K, V]
+	right    *intervalNode[K, V]
+}
+
+// IntervalTree is an AVL tree of intervals ordered by (low, high), where every
+// node also tracks the largest high bound in its subtree to prune overlap queries
+type IntervalTree[K cmp.Ordered, V any] struct {
+	root *intervalNode[K, V]
+	size int
+}
+
+// NewIntervalTree creates a new empty interval tree
+func NewIntervalTree[K cmp.Ordered, V any]() *IntervalTree[K, V] {
+	return &IntervalTree[K, V]{}
+}
+
+// Size returns the number of intervals in the tree
+func (t *IntervalTree[K, V]) Size() int {
+	return t.size
+}
+
+// IsEmpty checks if the tree is empty
+func (t *IntervalTree[K, V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Insert stores value under the interval [low, high], replacing the value of an identical interval
+// Returns true if the interval was new
+// Returns error if low is greater than high
+func (t *IntervalTree[K, V]) Insert(low, high K, value V) (bool, error) {
+	if low > high {
+		return false, fmt.Errorf("%w: [%v, %v]", ErrInvalidInterval, low, high)
+	}
+	var added bool
+	t.root = t.insert(t.root, Interval[K, V]{Low: low, High: high, Value: value}, &added)
+	if added {
+		t.size++
+	}
+	return added, nil
+}
+
+// Get returns the value stored for the interval [low, high]
+// Returns false if the interval is not in the tree
+func (t *IntervalTree[K, V]) Get(low, high K) (V, bool) {
+	n := t.root
+	for n != nil {
+		switch c := compareInterval(low, high, n.interval); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n.interval.Value, true
+		}
+	}
+	var zero V
+	return zero, false
+}
+
+// Delete removes the interval [low, high]
+// Returns true if the interval was found and removed, false otherwise
+func (t *IntervalTree[K, V]) Delete(low, high K) bool {
+	var removed bool
+	t.root = t.delete(t.root, low, high, &removed)
+	if removed {
+		t.size--
+	}
+	return removed
+}
+
+// Query returns every interval containing point, ordered by (low, high)
+func (t *IntervalTree[K, V]) Query(point K) []Interval[K, V] {
+	return t.QueryRange(point, point)
+}
+
+// QueryRange returns every interval overlapping [low, high], ordered by (low, high)
+func (t *IntervalTree[K, V]) QueryRange(low, high K) []Interval[K, V] {
+	var result []Interval[K, V]
+	t.collect(t.root, low, high, &result)
+	return result
+}
+
+// Clear removes all intervals from the tree
+func (t *IntervalTree[K, V]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+// ToSlice returns every interval ordered by (low, high)
+func (t *IntervalTree[K, V]) ToSlice() []Interval[K, V] {
+	result := make([]Interval[K, V], 0, t.size)
+	var walk func(n *intervalNode[K, V])
+	walk = func(n *intervalNode[K, V]) {
+		if n == nil {
+			return
+		}
+		walk(n.left)
+		result = append(result, n.interval)
+		walk(n.right)
+	}
+	walk(t.root)
+	return result
+}
+
+// String returns a string representation of the tree's intervals
+func (t *IntervalTree[K, V]) String() string {
+	var sb strings.Builder
+	sb.WriteString("[")
+
+	intervals := t.ToSlice()
+	for i, iv := range intervals {
+		sb.WriteString(fmt.Sprintf("[%v, %v]", iv.Low, iv.High))
+		if i < len(intervals)-1 {
+			sb.WriteString(", ")
+		}
+	}
+
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// collect appends the intervals of n's subtree that overlap [low, high]
+func (t *IntervalTree[K, V]) collect(n *intervalNode[K, V], low, high K, result *[]Interval[K, V]) {
+	if n == nil || n.max < low {
+		return
+	}
+	t.collect(n.left, low, high, result)
+	if n.interval.Overlaps(low, high) {
+		*result = append(*result, n.interval)
+	}
+	// Every interval on the right starts at or after this one
+	if n.interval.Low <= high {
+		t.collect(n.right, low, high, result)
+	}
+}
+
+func (t *IntervalTree[K, V]) insert(n *intervalNode[K, V], iv Interval[K, V], added *bool) *intervalNode[K, V] {
+	if n == nil {
+		*added = true
+		return &intervalNode[K, V]{interval: iv, max: iv.High, height: 1}
+	}
+	switch c := compareInterval(iv.Low, iv.High, n.interval); {
+	case c < 0:
+		n.left = t.insert(n.left, iv, added)
+	case c > 0:
+		n.right = t.insert(n.right, iv, added)
+	default:
+		n.interval.Value = iv.Value
+		return n
+	}
+	return rebalanceInterval(n)
+}
+
+func (t *IntervalTree[K, V]) delete(n *intervalNode[K, V], low, high K, removed *bool) *intervalNode[K, V] {
+	if n == nil {
+		return nil
+	}
+	switch c := compareInterval(low, high, n.interval); {
+	case c < 0:
+		n.left = t.delete(n.left, low, high, removed)
+	case c > 0:
+		n.right = t.delete(n.right, low, high, removed)
+	default:
+		*removed = true
+		if n.left == nil {
+			return n.right
+		}
+		if n.right == nil {
+			return n.left
+		}
+		// Replace with the in-order successor
+		succ := n.right
+		for succ.left != nil {
+			succ = succ.left
+		}
+		n.interval = succ.interval
+		var ignored bool
+		n.right = t.delete(n.right, succ.interval.Low, succ.interval.High, &ignored)
+	}
+	return rebalanceInterval(n)
+}
+
+// compareInterval orders [low, high] against an interval by low bound, then high bound
+func compareInterval[K cmp.Ordered, V any](low, high K, iv Interval[K, V]) int {
+	if c := cmp.Compare(low, iv.Low); c != 0 {
+		return c
+	}
+	return cmp.Compare(high, iv.High)
+}
+
+func intervalHeight[K cmp.Ordered, V any](n *intervalNode[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// update recomputes the height and subtree max of n from its children
+func (n *intervalNode[K, V]) update() {
+	n.height = 1 + max(intervalHeight(n.left), intervalHeight(n.right))
+	n.max = n.interval.High
+	if n.left != nil && n.left.max > n.max {
+		n.max = n.left.max
+	}
+	if n.right != nil && n.right.max > n.max {
+		n.max = n.right.max
+	}
+}
+
+func rotateIntervalLeft[K cmp.Ordered, V any](n *intervalNode[K, V]) *intervalNode[K, V] {
+	r := n.right
+	n.right = r.left
+	r.left = n
+	n.update()
+	r.update()
+	return r
+}
+
+func rotateIntervalRight[K cmp.Ordered, V any](n *intervalNode[K, V]) *intervalNode[K, V] {
+	l := n.left
+	n.left = l.right
+	l.right = n
+	n.update()
+	l.update()
+	return l
+}
+
+// rebalanceInterval restores the AVL balance of n after one of its subtrees changed
+func rebalanceInterval[K cmp.Ordered, V any](n *intervalNode[K, V]) *intervalNode[K, V] {
+	n.update()
+	balance := intervalHeight(n.left) - intervalHeight(n.right)
+	switch {
+	case balance > 1:
+		if intervalHeight(n.left.left) < intervalHeight(n.left.right) {
+			n.left = rotateIntervalLeft(n.left)
+		}
+		return rotateIntervalRight(n)
+	case balance < -1:
+		if intervalHeight(n.right.right) < intervalHeight(n.right.left) {
+			n.right = rotateIntervalRight(n.right)
+		}
+		return rotateIntervalLeft(n)
+	}
+	return n
+}
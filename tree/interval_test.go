@@ -0,0 +1,138 @@
+package tree
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestNewIntervalTree(t *testing.T) {
+	it := NewIntervalTree[int, string]()
+	if it.Size() != 0 || !it.IsEmpty() {
+		t.Fatalf("expected empty interval tree")
+	}
+	if it.String() != "[]" || len(it.Query(1)) != 0 {
+		t.Fatalf("expected no intervals")
+	}
+}
+
+func TestIntervalTreeInsertAndGet(t *testing.T) {
+	it := NewIntervalTree[int, string]()
+	if added, err := it.Insert(1, 5, "a"); err != nil || !added {
+		t.Fatalf("expected new interval got added=%v err=%v", added, err)
+	}
+	if added, _ := it.Insert(1, 5, "b"); added {
+		t.Fatalf("identical interval should replace, not add")
+	}
+	if v, ok := it.Get(1, 5); !ok || v != "b" {
+		t.Fatalf("expected replaced value b got %s ok=%v", v, ok)
+	}
+	if _, ok := it.Get(1, 4); ok {
+		t.Fatalf("unexpected hit for absent interval")
+	}
+	if _, err := it.Insert(5, 1, "bad"); !errors.Is(err, ErrInvalidInterval) {
+		t.Fatalf("expected ErrInvalidInterval got %v", err)
+	}
+	if it.Size() != 1 {
+		t.Fatalf("expected size 1 got %d", it.Size())
+	}
+}
+
+func TestIntervalTreeQuery(t *testing.T) {
+	it := NewIntervalTree[int, string]()
+	it.Insert(9, 10, "standup")
+	it.Insert(10, 12, "review")
+	it.Insert(13, 14, "lunch")
+	it.Insert(8, 17, "office")
+
+	hits := it.Query(10)
+	if len(hits) != 3 || hits[0].Value != "office" || hits[1].Value != "standup" || hits[2].Value != "review" {
+		t.Fatalf("unexpected point query result %v", hits)
+	}
+	hits = it.QueryRange(12, 13)
+	if len(hits) != 3 || hits[2].Value != "lunch" {
+		t.Fatalf("unexpected range query result %v", hits)
+	}
+	if len(it.QueryRange(18, 20)) != 0 {
+		t.Fatalf("expected no overlap after the last interval")
+	}
+	if it.String() != "[[8, 17], [9, 10], [10, 12], [13, 14]]" {
+		t.Fatalf("unexpected string %s", it)
+	}
+}
+
+func TestIntervalTreeDelete(t *testing.T) {
+	it := NewIntervalTree[int, int]()
+	for i := 0; i < 10; i++ {
+		it.Insert(i, i+2, i)
+	}
+	if !it.Delete(4, 6) || it.Delete(4, 6) {
+		t.Fatalf("expected a single successful delete")
+	}
+	for _, iv := range it.Query(5) {
+		if iv.Low == 4 {
+			t.Fatalf("deleted interval still returned")
+		}
+	}
+	if it.Size() != 9 {
+		t.Fatalf("expected size 9 got %d", it.Size())
+	}
+	it.Clear()
+	if !it.IsEmpty() || len(it.ToSlice()) != 0 {
+		t.Fatalf("expected empty tree after clear")
+	}
+}
+
+func TestIntervalTreeMatchesBruteForce(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	it := NewIntervalTree[int, int]()
+	model := make(map[[2]int]int)
+	for i := 0; i < 2000; i++ {
+		low := r.Intn(200)
+		high := low + r.Intn(20)
+		if r.Intn(3) == 0 {
+			_, inModel := model[[2]int{low, high}]
+			if it.Delete(low, high) != inModel {
+				t.Fatalf("delete mismatch for [%d, %d]", low, high)
+			}
+			delete(model, [2]int{low, high})
+		} else {
+			it.Insert(low, high, i)
+			model[[2]int{low, high}] = i
+		}
+	}
+	if it.Size() != len(model) {
+		t.Fatalf("size mismatch got %d want %d", it.Size(), len(model))
+	}
+	if h := intervalHeight(it.root); h > 2*bitLength(it.Size())+1 {
+		t.Fatalf("tree too tall: height %d for %d nodes", h, it.Size())
+	}
+
+	for q := 0; q < 100; q++ {
+		low := r.Intn(220)
+		high := low + r.Intn(10)
+		want := 0
+		for k := range model {
+			if k[0] <= high && low <= k[1] {
+				want++
+			}
+		}
+		got := it.QueryRange(low, high)
+		if len(got) != want {
+			t.Fatalf("query [%d, %d] got %d intervals want %d", low, high, len(got), want)
+		}
+		for _, iv := range got {
+			if model[[2]int{iv.Low, iv.High}] != iv.Value {
+				t.Fatalf("value mismatch for [%d, %d]", iv.Low, iv.High)
+			}
+		}
+	}
+}
+
+func bitLength(n int) int {
+	bits := 0
+	for ; n > 0; n >>= 1 {
+		bits++
+	}
+	return bits
+}
@@ -0,0 +1,275 @@
+package tree
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+var (
+	ErrDimensionMismatch = errors.New("point dimension does not match the tree")
+)
+
+// KDPoint is a point in k-dimensional space carrying a value
+type KDPoint[T any] struct {
+	Coords []float64
+	Value  T
+}
+
+type kdNode[T any] struct {
+	point KDPoint[T]
+	left  *kdNode[T]
+	right *kdNode[T]
+}
+
+// KDTree partitions k-dimensional points by cycling through the axes, answering nearest
+// neighbor and bounding box queries without scanning every point
+// Insert does not rebalance, so trees built from sorted input should use NewKDTreeFromPoints
+type KDTree[T any] struct {
+	root *kdNode[T]
+	dims int
+	size int
+}
+
+// NewKDTree creates a new empty tree over points with dims coordinates
+func NewKDTree[T any](dims int) *KDTree[T] {
+	return &KDTree[T]{dims: dims}
+}
+
+// NewKDTreeFromPoints builds a balanced tree from points by splitting at the median of each axis
+// Returns error if a point does not have dims coordinates
+func NewKDTreeFromPoints[T any](dims int, points []KDPoint[T]) (*KDTree[T], error) {
+	t := NewKDTree[T](dims)
+	for _, p := range points {
+		if err := t.checkDims(p.Coords); err != nil {
+			return nil, err
+		}
+	}
+	t.root = t.build(slices.Clone(points), 0)
+	t.size = len(points)
+	return t, nil
+}
+
+// Dims returns the number of coordinates of every point in the tree
+func (t *KDTree[T]) Dims() int {
+	return t.dims
+}
+
+// Size returns the number of points in the tree
+func (t *KDTree[T]) Size() int {
+	return t.size
+}
+
+// IsEmpty checks if the tree is empty
+func (t *KDTree[T]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Insert adds a point with the given coordinates and value
+// Returns error if coords does not have Dims coordinates
+func (t *KDTree[T]) Insert(coords []float64, value T) error {
+	if err := t.checkDims(coords); err != nil {
+		return err
+	}
+	n := &kdNode[T]{point: KDPoint[T]{Coords: slices.Clone(coords), Value: value}}
+	t.size++
+	if t.root == nil {
+		t.root = n
+		return nil
+	}
+	cur := t.root
+	for depth := 0; ; depth++ {
+		axis := depth % t.dims
+		if coords[axis] < cur.point.Coords[axis] {
+			if cur.left == nil {
+				cur.left = n
+				return nil
+			}
+			cur = cur.left
+		} else {
+			if cur.right == nil {
+				cur.right = n
+				return nil
+			}
+			cur = cur.right
+		}
+	}
+}
+
+// NearestNeighbor returns the point closest to target by Euclidean distance
+// Returns false if the tree is empty or target does not have Dims coordinates
+func (t *KDTree[T]) NearestNeighbor(target []float64) (KDPoint[T], bool) {
+	nearest := t.KNearest(target, 1)
+	if len(nearest) == 0 {
+		return KDPoint[T]{}, false
+	}
+	return nearest[0], true
+}
+
+// KNearest returns up to k points closest to target, nearest first
+// Returns nil if k is below 1 or target does not have Dims coordinates
+func (t *KDTree[T]) KNearest(target []float64, k int) []KDPoint[T] {
+	if k < 1 || t.checkDims(target) != nil {
+		return nil
+	}
+	best := &kdMaxHeap[T]{}
+	t.nearest(t.root, target, k, 0, best)
+
+	result := make([]KDPoint[T], best.Len())
+	for i := len(result) - 1; i >= 0; i-- {
+		result[i] = heap.Pop(best).(kdCandidate[T]).node.point
+	}
+	return result
+}
+
+// RangeSearch returns every point inside the axis aligned box [low, high], bounds included
+// Returns nil if low or high does not have Dims coordinates
+func (t *KDTree[T]) RangeSearch(low, high []float64) []KDPoint[T] {
+	if t.checkDims(low) != nil || t.checkDims(high) != nil {
+		return nil
+	}
+	var result []KDPoint[T]
+	t.rangeSearch(t.root, low, high, 0, &result)
+	return result
+}
+
+// Clear removes all points from the tree
+func (t *KDTree[T]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+// ToSlice returns every point in the tree in pre-order
+func (t *KDTree[T]) ToSlice() []KDPoint[T] {
+	result := make([]KDPoint[T], 0, t.size)
+	var walk func(n *kdNode[T])
+	walk = func(n *kdNode[T]) {
+		if n == nil {
+			return
+		}
+		result = append(result, n.point)
+		walk(n.left)
+		walk(n.right)
+	}
+	walk(t.root)
+	return result
+}
+
+func (t *KDTree[T]) checkDims(coords []float64) error {
+	if len(coords) != t.dims {
+		return fmt.Errorf("%w: got %d coordinates, tree has %d", ErrDimensionMismatch, len(coords), t.dims)
+	}
+	return nil
+}
+
+func (t *KDTree[T]) build(points []KDPoint[T], depth int) *kdNode[T] {
+	if len(points) == 0 {
+		return nil
+	}
+	axis := depth % t.dims
+	slices.SortFunc(points, func(a, b KDPoint[T]) int {
+		switch {
+		case a.Coords[axis] < b.Coords[axis]:
+			return -1
+		case a.Coords[axis] > b.Coords[axis]:
+			return 1
+		}
+		return 0
+	})
+	mid := len(points) / 2
+	// Equal coordinates belong on the right, matching Insert
+	for mid > 0 && points[mid-1].Coords[axis] == points[mid].Coords[axis] {
+		mid--
+	}
+	point := points[mid]
+	point.Coords = slices.Clone(point.Coords)
+	return &kdNode[T]{
+		point: point,
+		left:  t.build(points[:mid], depth+1),
+		right: t.build(points[mid+1:], depth+1),
+	}
+}
+
+func (t *KDTree[T]) nearest(n *kdNode[T], target []float64, k, depth int, best *kdMaxHeap[T]) {
+	if n == nil {
+		return
+	}
+	dist := squaredDistance(n.point.Coords, target)
+	if best.Len() < k {
+		heap.Push(best, kdCandidate[T]{node: n, dist: dist})
+	} else if dist < (*best)[0].dist {
+		(*best)[0] = kdCandidate[T]{node: n, dist: dist}
+		heap.Fix(best, 0)
+	}
+
+	axis := depth % t.dims
+	diff := target[axis] - n.point.Coords[axis]
+	near, far := n.left, n.right
+	if diff >= 0 {
+		near, far = far, near
+	}
+	t.nearest(near, target, k, depth+1, best)
+	// The far side can only help if the splitting plane is closer than the current worst candidate
+	if best.Len() < k || diff*diff < (*best)[0].dist {
+		t.nearest(far, target, k, depth+1, best)
+	}
+}
+
+func (t *KDTree[T]) rangeSearch(n *kdNode[T], low, high []float64, depth int, result *[]KDPoint[T]) {
+	if n == nil {
+		return
+	}
+	inside := true
+	for i, c := range n.point.Coords {
+		if c < low[i] || c > high[i] {
+			inside = false
+			break
+		}
+	}
+	if inside {
+		*result = append(*result, n.point)
+	}
+
+	axis := depth % t.dims
+	if low[axis] < n.point.Coords[axis] {
+		t.rangeSearch(n.left, low, high, depth+1, result)
+	}
+	if high[axis] >= n.point.Coords[axis] {
+		t.rangeSearch(n.right, low, high, depth+1, result)
+	}
+}
+
+func squaredDistance(a, b []float64) float64 {
+	var sum float64
+	for i := range a {
+		d := a[i] - b[i]
+		sum += d * d
+	}
+	return sum
+}
+
+type kdCandidate[T any] struct {
+	node *kdNode[T]
+	dist float64
+}
+
+// kdMaxHeap keeps the current k best candidates with the farthest on top
+type kdMaxHeap[T any] []kdCandidate[T]
+
+func (h kdMaxHeap[T]) Len() int           { return len(h) }
+func (h kdMaxHeap[T]) Less(i, j int) bool { return h[i].dist > h[j].dist }
+func (h kdMaxHeap[T]) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *kdMaxHeap[T]) Push(x any) {
+	*h = append(*h, x.(kdCandidate[T]))
+}
+
+func (h *kdMaxHeap[T]) Pop() any {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = kdCandidate[T]{}
+	*h = old[:n-1]
+	return item
+}
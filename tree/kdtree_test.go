@@ -0,0 +1,119 @@
+package tree
+
+import (
+	"errors"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestKDTreeInsertAndNearest(t *testing.T) {
+	kd := NewKDTree[string](2)
+	if _, ok := kd.NearestNeighbor([]float64{0, 0}); ok {
+		t.Fatalf("empty tree should have no nearest neighbor")
+	}
+	for name, p := range map[string][]float64{
+		"origin": {0, 0}, "east": {10, 0}, "north": {0, 10}, "far": {50, 50},
+	} {
+		if err := kd.Insert(p, name); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if err := kd.Insert([]float64{1}, "bad"); !errors.Is(err, ErrDimensionMismatch) {
+		t.Fatalf("expected ErrDimensionMismatch got %v", err)
+	}
+	if kd.Size() != 4 || kd.Dims() != 2 {
+		t.Fatalf("expected 4 points in 2 dims got %d in %d", kd.Size(), kd.Dims())
+	}
+
+	if p, ok := kd.NearestNeighbor([]float64{8, 1}); !ok || p.Value != "east" {
+		t.Fatalf("expected east got %v", p.Value)
+	}
+	nearest := kd.KNearest([]float64{1, 1}, 3)
+	if len(nearest) != 3 || nearest[0].Value != "origin" || nearest[2].Value == "far" {
+		t.Fatalf("unexpected k nearest %v", nearest)
+	}
+	if len(kd.KNearest([]float64{1, 1}, 10)) != 4 {
+		t.Fatalf("k larger than the tree should return every point")
+	}
+	if kd.KNearest([]float64{1}, 1) != nil {
+		t.Fatalf("mismatched target should return nil")
+	}
+}
+
+func TestKDTreeRangeSearch(t *testing.T) {
+	kd, err := NewKDTreeFromPoints(2, []KDPoint[int]{
+		{Coords: []float64{1, 1}, Value: 1},
+		{Coords: []float64{2, 5}, Value: 2},
+		{Coords: []float64{5, 2}, Value: 3},
+		{Coords: []float64{5, 5}, Value: 4},
+		{Coords: []float64{9, 9}, Value: 5},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	hits := kd.RangeSearch([]float64{2, 2}, []float64{5, 5})
+	values := make([]int, len(hits))
+	for i, p := range hits {
+		values[i] = p.Value
+	}
+	sort.Ints(values)
+	if len(values) != 3 || values[0] != 2 || values[1] != 3 || values[2] != 4 {
+		t.Fatalf("unexpected range result %v", values)
+	}
+	if _, err := NewKDTreeFromPoints(3, []KDPoint[int]{{Coords: []float64{1}}}); !errors.Is(err, ErrDimensionMismatch) {
+		t.Fatalf("expected ErrDimensionMismatch got %v", err)
+	}
+	kd.Clear()
+	if !kd.IsEmpty() || len(kd.ToSlice()) != 0 {
+		t.Fatalf("expected empty tree after clear")
+	}
+}
+
+func TestKDTreeMatchesBruteForce(t *testing.T) {
+	r := rand.New(rand.NewSource(21))
+	points := make([]KDPoint[int], 500)
+	for i := range points {
+		// Coarse coordinates produce plenty of ties on each axis
+		points[i] = KDPoint[int]{Coords: []float64{float64(r.Intn(50)), float64(r.Intn(50)), float64(r.Intn(50))}, Value: i}
+	}
+	built, _ := NewKDTreeFromPoints(3, points)
+	inserted := NewKDTree[int](3)
+	for _, p := range points {
+		inserted.Insert(p.Coords, p.Value)
+	}
+
+	for q := 0; q < 100; q++ {
+		target := []float64{r.Float64() * 50, r.Float64() * 50, r.Float64() * 50}
+		dists := make([]float64, len(points))
+		for i, p := range points {
+			dists[i] = squaredDistance(p.Coords, target)
+		}
+		sort.Float64s(dists)
+
+		for _, kd := range []*KDTree[int]{built, inserted} {
+			got := kd.KNearest(target, 5)
+			for i, p := range got {
+				if squaredDistance(p.Coords, target) != dists[i] {
+					t.Fatalf("query %d: neighbor %d at distance %v want %v", q, i, squaredDistance(p.Coords, target), dists[i])
+				}
+			}
+		}
+
+		low := []float64{target[0] - 10, target[1] - 10, target[2] - 10}
+		high := []float64{target[0] + 10, target[1] + 10, target[2] + 10}
+		want := 0
+		for _, p := range points {
+			if p.Coords[0] >= low[0] && p.Coords[0] <= high[0] && p.Coords[1] >= low[1] &&
+				p.Coords[1] <= high[1] && p.Coords[2] >= low[2] && p.Coords[2] <= high[2] {
+				want++
+			}
+		}
+		if got := len(built.RangeSearch(low, high)); got != want {
+			t.Fatalf("query %d: range got %d points want %d", q, got, want)
+		}
+		if got := len(inserted.RangeSearch(low, high)); got != want {
+			t.Fatalf("query %d: range got %d points want %d", q, got, want)
+		}
+	}
+}
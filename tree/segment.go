@@ -0,0 +1,215 @@
+package tree
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/profoundwu/containers/internal/utils"
+)
+
+var (
+	ErrIndexOutOfBounds        = errors.New("index out of bounds")
+	ErrInvalidRange            = errors.New("invalid range")
+	ErrRangeUpdateNotSupported = errors.New("range updates need a lazy segment tree")
+)
+
+// SegmentTree answers range queries over a fixed-length sequence using an associative merge
+// function such as sum, min or max, with O(log n) queries and updates
+type SegmentTree[T any] struct {
+	n     int
+	nodes []T
+	merge func(a, b T) T
+
+	// Lazy propagation state, only set up by NewLazySegmentTree
+	apply   func(value T, update T, length int) T
+	compose func(older, newer T) T
+	lazy    []T
+	pending []bool
+}
+
+// NewSegmentTree builds a segment tree over a copy of values combined with merge
+func NewSegmentTree[T any](values []T, merge func(a, b T) T) *SegmentTree[T] {
+	st := &SegmentTree[T]{
+		n:     len(values),
+		nodes: make([]T, 4*max(len(values), 1)),
+		merge: merge,
+	}
+	if st.n > 0 {
+		st.build(1, 0, st.n-1, values)
+	}
+	return st
+}
+
+// NewLazySegmentTree builds a segment tree that also supports RangeUpdate
+// apply folds an update into the merged value of a segment covering length elements
+// and compose folds a newer pending update into an older one
+// For range add over sums: apply(v, u, n) = v + u*n and compose(a, b) = a + b
+func NewLazySegmentTree[T any](values []T, merge func(a, b T) T,
+	apply func(value T, update T, length int) T, compose func(older, newer T) T) *SegmentTree[T] {
+	st := NewSegmentTree(values, merge)
+	st.apply = apply
+	st.compose = compose
+	st.lazy = make([]T, len(st.nodes))
+	st.pending = make([]bool, len(st.nodes))
+	return st
+}
+
+// Size returns the number of elements covered by the tree
+func (st *SegmentTree[T]) Size() int {
+	return st.n
+}
+
+// Query returns the merge of the elements in the inclusive range [l, r]
+// Returns error if the range is empty or out of bounds
+func (st *SegmentTree[T]) Query(l, r int) (T, error) {
+	if err := st.checkRange(l, r); err != nil {
+		var zero T
+		return zero, err
+	}
+	return st.query(1, 0, st.n-1, l, r), nil
+}
+
+// Get returns the element at the specified index position
+// Returns error if index is out of bounds
+func (st *SegmentTree[T]) Get(index int) (T, error) {
+	if index < 0 || index >= st.n {
+		var zero T
+		return zero, fmt.Errorf("%w: %d, tree size: %d", ErrIndexOutOfBounds, index, st.n)
+	}
+	return st.query(1, 0, st.n-1, index, index), nil
+}
+
+// MustQuery returns the merge of the elements in [l, r] like Query, panicking if the range is invalid
+func (st *SegmentTree[T]) MustQuery(l, r int) T {
+	v, err := st.Query(l, r)
+	return utils.Must("MustQuery", v, err)
+}
+
+// MustGet returns the element at the specified index position, panicking if it is out of bounds
+func (st *SegmentTree[T]) MustGet(index int) T {
+	v, err := st.Get(index)
+	return utils.Must("MustGet", v, err)
+}
+
+// Update replaces the element at the specified index position
+// Returns error if index is out of bounds
+func (st *SegmentTree[T]) Update(index int, value T) error {
+	if index < 0 || index >= st.n {
+		return fmt.Errorf("%w: %d, tree size: %d", ErrIndexOutOfBounds, index, st.n)
+	}
+	st.update(1, 0, st.n-1, index, value)
+	return nil
+}
+
+// RangeUpdate applies update to every element in the inclusive range [l, r]
+// Returns error if the tree was not built with NewLazySegmentTree or the range is invalid
+func (st *SegmentTree[T]) RangeUpdate(l, r int, update T) error {
+	if st.apply == nil {
+		return ErrRangeUpdateNotSupported
+	}
+	if err := st.checkRange(l, r); err != nil {
+		return err
+	}
+	st.rangeUpdate(1, 0, st.n-1, l, r, update)
+	return nil
+}
+
+// ToSlice returns the current elements in index order
+func (st *SegmentTree[T]) ToSlice() []T {
+	slice := make([]T, st.n)
+	for i := range slice {
+		slice[i] = st.query(1, 0, st.n-1, i, i)
+	}
+	return slice
+}
+
+func (st *SegmentTree[T]) checkRange(l, r int) error {
+	if l < 0 || r >= st.n || l > r {
+		return fmt.Errorf("%w: [%d, %d], tree size: %d", ErrInvalidRange, l, r, st.n)
+	}
+	return nil
+}
+
+func (st *SegmentTree[T]) build(node, lo, hi int, values []T) {
+	if lo == hi {
+		st.nodes[node] = values[lo]
+		return
+	}
+	mid := lo + (hi-lo)/2
+	st.build(2*node, lo, mid, values)
+	st.build(2*node+1, mid+1, hi, values)
+	st.nodes[node] = st.merge(st.nodes[2*node], st.nodes[2*node+1])
+}
+
+func (st *SegmentTree[T]) query(node, lo, hi, l, r int) T {
+	if l <= lo && hi <= r {
+		return st.nodes[node]
+	}
+	st.pushDown(node, lo, hi)
+	mid := lo + (hi-lo)/2
+	switch {
+	case r <= mid:
+		return st.query(2*node, lo, mid, l, r)
+	case l > mid:
+		return st.query(2*node+1, mid+1, hi, l, r)
+	}
+	return st.merge(st.query(2*node, lo, mid, l, r), st.query(2*node+1, mid+1, hi, l, r))
+}
+
+func (st *SegmentTree[T]) update(node, lo, hi, index int, value T) {
+	if lo == hi {
+		st.nodes[node] = value
+		return
+	}
+	st.pushDown(node, lo, hi)
+	mid := lo + (hi-lo)/2
+	if index <= mid {
+		st.update(2*node, lo, mid, index, value)
+	} else {
+		st.update(2*node+1, mid+1, hi, index, value)
+	}
+	st.nodes[node] = st.merge(st.nodes[2*node], st.nodes[2*node+1])
+}
+
+func (st *SegmentTree[T]) rangeUpdate(node, lo, hi, l, r int, update T) {
+	if l <= lo && hi <= r {
+		st.applyTo(node, lo, hi, update)
+		return
+	}
+	st.pushDown(node, lo, hi)
+	mid := lo + (hi-lo)/2
+	if l <= mid {
+		st.rangeUpdate(2*node, lo, mid, l, r, update)
+	}
+	if r > mid {
+		st.rangeUpdate(2*node+1, mid+1, hi, l, r, update)
+	}
+	st.nodes[node] = st.merge(st.nodes[2*node], st.nodes[2*node+1])
+}
+
+// applyTo folds update into the segment [lo, hi] and records it for the children
+func (st *SegmentTree[T]) applyTo(node, lo, hi int, update T) {
+	st.nodes[node] = st.apply(st.nodes[node], update, hi-lo+1)
+	if lo == hi {
+		return
+	}
+	if st.pending[node] {
+		st.lazy[node] = st.compose(st.lazy[node], update)
+	} else {
+		st.lazy[node] = update
+		st.pending[node] = true
+	}
+}
+
+// pushDown hands a pending update of node to its children
+func (st *SegmentTree[T]) pushDown(node, lo, hi int) {
+	if st.pending == nil || !st.pending[node] {
+		return
+	}
+	mid := lo + (hi-lo)/2
+	st.applyTo(2*node, lo, mid, st.lazy[node])
+	st.applyTo(2*node+1, mid+1, hi, st.lazy[node])
+	var zero T
+	st.lazy[node] = zero
+	st.pending[node] = false
+}
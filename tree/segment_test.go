@@ -0,0 +1,133 @@
+package tree
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func sumInts(a, b int) int { return a + b }
+
+func TestSegmentTreeQuery(t *testing.T) {
+	st := NewSegmentTree([]int{5, 3, 8, 1, 9, 2}, sumInts)
+	if st.Size() != 6 {
+		t.Fatalf("expected size 6 got %d", st.Size())
+	}
+	if v, err := st.Query(0, 5); err != nil || v != 28 {
+		t.Fatalf("expected total 28 got %d err=%v", v, err)
+	}
+	if v, _ := st.Query(1, 3); v != 12 {
+		t.Fatalf("expected 12 got %d", v)
+	}
+
+	minTree := NewSegmentTree([]int{5, 3, 8, 1, 9, 2}, func(a, b int) int { return min(a, b) })
+	if v, _ := minTree.Query(2, 4); v != 1 {
+		t.Fatalf("expected min 1 got %d", v)
+	}
+	if _, err := st.Query(3, 2); !errors.Is(err, ErrInvalidRange) {
+		t.Fatalf("expected ErrInvalidRange got %v", err)
+	}
+	if _, err := st.Query(0, 6); !errors.Is(err, ErrInvalidRange) {
+		t.Fatalf("expected ErrInvalidRange got %v", err)
+	}
+}
+
+func TestSegmentTreeUpdate(t *testing.T) {
+	st := NewSegmentTree([]int{1, 2, 3, 4}, sumInts)
+	if err := st.Update(2, 10); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v, _ := st.Query(0, 3); v != 17 {
+		t.Fatalf("expected 17 got %d", v)
+	}
+	if v, _ := st.Get(2); v != 10 {
+		t.Fatalf("expected 10 got %d", v)
+	}
+	if err := st.Update(4, 0); !errors.Is(err, ErrIndexOutOfBounds) {
+		t.Fatalf("expected ErrIndexOutOfBounds got %v", err)
+	}
+	if _, err := st.Get(-1); !errors.Is(err, ErrIndexOutOfBounds) {
+		t.Fatalf("expected ErrIndexOutOfBounds got %v", err)
+	}
+	if err := st.RangeUpdate(0, 1, 5); !errors.Is(err, ErrRangeUpdateNotSupported) {
+		t.Fatalf("expected ErrRangeUpdateNotSupported got %v", err)
+	}
+}
+
+func TestSegmentTreeMust(t *testing.T) {
+	st := NewSegmentTree([]int{3, 1, 2}, func(a, b int) int { return a + b })
+	if st.MustQuery(0, 2) != 6 || st.MustGet(1) != 1 {
+		t.Fatalf("unexpected Must results")
+	}
+	defer func() {
+		if err, _ := recover().(error); !errors.Is(err, ErrIndexOutOfBounds) {
+			t.Fatalf("expected panic with ErrIndexOutOfBounds got %v", err)
+		}
+	}()
+	st.MustGet(3)
+}
+
+func TestSegmentTreeEmpty(t *testing.T) {
+	st := NewSegmentTree([]int{}, sumInts)
+	if _, err := st.Query(0, 0); !errors.Is(err, ErrInvalidRange) {
+		t.Fatalf("expected ErrInvalidRange got %v", err)
+	}
+	if len(st.ToSlice()) != 0 {
+		t.Fatalf("expected no elements")
+	}
+}
+
+func TestLazySegmentTreeRangeAdd(t *testing.T) {
+	values := make([]int, 50)
+	st := NewLazySegmentTree(values, sumInts,
+		func(v, u, n int) int { return v + u*n },
+		func(a, b int) int { return a + b })
+
+	r := rand.New(rand.NewSource(1))
+	model := make([]int, len(values))
+	for step := 0; step < 500; step++ {
+		l := r.Intn(len(model))
+		h := l + r.Intn(len(model)-l)
+		switch r.Intn(3) {
+		case 0:
+			delta := r.Intn(21) - 10
+			if err := st.RangeUpdate(l, h, delta); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for i := l; i <= h; i++ {
+				model[i] += delta
+			}
+		case 1:
+			v := r.Intn(100)
+			st.Update(l, v)
+			model[l] = v
+		default:
+			want := 0
+			for i := l; i <= h; i++ {
+				want += model[i]
+			}
+			if got, _ := st.Query(l, h); got != want {
+				t.Fatalf("step %d: query [%d, %d] got %d want %d", step, l, h, got, want)
+			}
+		}
+	}
+	got := st.ToSlice()
+	for i := range model {
+		if got[i] != model[i] {
+			t.Fatalf("element %d got %d want %d", i, got[i], model[i])
+		}
+	}
+}
+
+func TestLazySegmentTreeRangeAssignMax(t *testing.T) {
+	st := NewLazySegmentTree([]int{1, 7, 3, 4, 5}, func(a, b int) int { return max(a, b) },
+		func(_, u, _ int) int { return u },
+		func(_, b int) int { return b })
+	st.RangeUpdate(1, 3, 2)
+	if v, _ := st.Query(0, 4); v != 5 {
+		t.Fatalf("expected max 5 got %d", v)
+	}
+	if v, _ := st.Query(1, 3); v != 2 {
+		t.Fatalf("expected max 2 after assignment got %d", v)
+	}
+}
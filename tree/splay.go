@@ -0,0 +1,250 @@
+package tree
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+
+	"github.com/profoundwu/containers/compare"
+	"github.com/profoundwu/containers/pair"
+)
+
+type splayNode[K any, V any] struct {
+	key   K
+	value V
+	left  *splayNode[K, V]
+	right *splayNode[K, V]
+}
+
+// SplayTree is a self-adjusting binary search tree that moves every accessed key to the root
+// Operations are amortized O(log n) and repeated access to a small hot set is close to O(1)
+type SplayTree[K any, V any] struct {
+	root *splayNode[K, V]
+	size int
+	cmp  compare.Comparator[K]
+}
+
+// NewSplayTree creates a new empty splay tree ordered by the natural order of K
+func NewSplayTree[K cmp.Ordered, V any]() *SplayTree[K, V] {
+	return NewSplayTreeWithComparator[K, V](compare.Natural[K]())
+}
+
+// NewSplayTreeWithComparator creates a new empty splay tree ordered by comparator
+func NewSplayTreeWithComparator[K any, V any](comparator compare.Comparator[K]) *SplayTree[K, V] {
+	return &SplayTree[K, V]{cmp: comparator}
+}
+
+// Size returns the number of entries in the tree
+func (t *SplayTree[K, V]) Size() int {
+	return t.size
+}
+
+// IsEmpty checks if the tree is empty
+func (t *SplayTree[K, V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Put stores value under key and splays key to the root
+func (t *SplayTree[K, V]) Put(key K, value V) {
+	if t.root == nil {
+		t.root = &splayNode[K, V]{key: key, value: value}
+		t.size++
+		return
+	}
+
+	t.splay(key)
+	c := t.cmp(key, t.root.key)
+	if c == 0 {
+		t.root.value = value
+		return
+	}
+
+	n := &splayNode[K, V]{key: key, value: value}
+	if c < 0 {
+		n.left = t.root.left
+		n.right = t.root
+		t.root.left = nil
+	} else {
+		n.right = t.root.right
+		n.left = t.root
+		t.root.right = nil
+	}
+	t.root = n
+	t.size++
+}
+
+// Get returns the value stored for key and splays the closest key to the root
+// Returns false if the key is not present
+func (t *SplayTree[K, V]) Get(key K) (V, bool) {
+	if t.root == nil {
+		var zero V
+		return zero, false
+	}
+	t.splay(key)
+	if t.cmp(key, t.root.key) != 0 {
+		var zero V
+		return zero, false
+	}
+	return t.root.value, true
+}
+
+// Contains checks if the tree holds key, splaying it like Get
+func (t *SplayTree[K, V]) Contains(key K) bool {
+	_, ok := t.Get(key)
+	return ok
+}
+
+// Delete removes key from the tree
+// Returns true if the key was found and removed, false otherwise
+func (t *SplayTree[K, V]) Delete(key K) bool {
+	if t.root == nil {
+		return false
+	}
+	t.splay(key)
+	if t.cmp(key, t.root.key) != 0 {
+		return false
+	}
+
+	if t.root.left == nil {
+		t.root = t.root.right
+	} else {
+		right := t.root.right
+		t.root = t.root.left
+		// key is larger than everything left, so this lifts the maximum with an empty right child
+		t.splay(key)
+		t.root.right = right
+	}
+	t.size--
+	return true
+}
+
+// Top returns the entry at the root, which is the most recently accessed key
+// Returns false if the tree is empty
+func (t *SplayTree[K, V]) Top() (K, V, bool) {
+	if t.root == nil {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+	return t.root.key, t.root.value, true
+}
+
+// Clear removes all entries from the tree
+func (t *SplayTree[K, V]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+// Keys returns all keys in ascending order without splaying
+func (t *SplayTree[K, V]) Keys() []K {
+	keys := make([]K, 0, t.size)
+	var walk func(n *splayNode[K, V])
+	walk = func(n *splayNode[K, V]) {
+		if n == nil {
+			return
+		}
+		walk(n.left)
+		keys = append(keys, n.key)
+		walk(n.right)
+	}
+	walk(t.root)
+	return keys
+}
+
+// Entries returns all key-value pairs in ascending key order without splaying
+func (t *SplayTree[K, V]) Entries() []pair.Pair[K, V] {
+	entries := make([]pair.Pair[K, V], 0, t.size)
+	var walk func(n *splayNode[K, V])
+	walk = func(n *splayNode[K, V]) {
+		if n == nil {
+			return
+		}
+		walk(n.left)
+		entries = append(entries, pair.New(n.key, n.value))
+		walk(n.right)
+	}
+	walk(t.root)
+	return entries
+}
+
+// String returns a string representation of the tree in ascending key order
+func (t *SplayTree[K, V]) String() string {
+	var sb strings.Builder
+	sb.WriteString("{")
+
+	var walk func(n *splayNode[K, V])
+	first := true
+	walk = func(n *splayNode[K, V]) {
+		if n == nil {
+			return
+		}
+		walk(n.left)
+		if !first {
+			sb.WriteString(", ")
+		}
+		first = false
+		sb.WriteString(fmt.Sprintf("%v: %v", n.key, n.value))
+		walk(n.right)
+	}
+	walk(t.root)
+
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// splay moves key, or the last node on its search path, to the root using top-down splaying
+func (t *SplayTree[K, V]) splay(key K) {
+	var header splayNode[K, V]
+	left, right := &header, &header
+	n := t.root
+
+	for {
+		c := t.cmp(key, n.key)
+		if c < 0 {
+			if n.left == nil {
+				break
+			}
+			if t.cmp(key, n.left.key) < 0 {
+				// Zig-zig: rotate right
+				y := n.left
+				n.left = y.right
+				y.right = n
+				n = y
+				if n.left == nil {
+					break
+				}
+			}
+			// Link n into the right tree
+			right.left = n
+			right = n
+			n = n.left
+		} else if c > 0 {
+			if n.right == nil {
+				break
+			}
+			if t.cmp(key, n.right.key) > 0 {
+				// Zag-zag: rotate left
+				y := n.right
+				n.right = y.left
+				y.left = n
+				n = y
+				if n.right == nil {
+					break
+				}
+			}
+			// Link n into the left tree
+			left.right = n
+			left = n
+			n = n.right
+		} else {
+			break
+		}
+	}
+
+	// Reassemble
+	left.right = n.left
+	right.left = n.right
+	n.left = header.right
+	n.right = header.left
+	t.root = n
+}
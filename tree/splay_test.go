@@ -0,0 +1,133 @@
+package tree
+
+import (
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestNewSplayTree(t *testing.T) {
+	st := NewSplayTree[int, string]()
+	if st.Size() != 0 || !st.IsEmpty() {
+		t.Fatalf("expected empty splay tree")
+	}
+	if _, _, ok := st.Top(); ok {
+		t.Fatalf("empty tree should have no top")
+	}
+	if _, ok := st.Get(1); ok || st.Delete(1) {
+		t.Fatalf("empty tree should not find keys")
+	}
+}
+
+func TestSplayTreePutGet(t *testing.T) {
+	st := NewSplayTree[int, string]()
+	st.Put(5, "five")
+	st.Put(1, "one")
+	st.Put(9, "nine")
+	st.Put(5, "FIVE")
+	if st.Size() != 3 {
+		t.Fatalf("expected size 3 got %d", st.Size())
+	}
+	if v, ok := st.Get(5); !ok || v != "FIVE" {
+		t.Fatalf("expected FIVE got %s ok=%v", v, ok)
+	}
+	if k, v, _ := st.Top(); k != 5 || v != "FIVE" {
+		t.Fatalf("accessed key should be at the root got %d", k)
+	}
+	st.Get(1)
+	if k, _, _ := st.Top(); k != 1 {
+		t.Fatalf("expected 1 at root got %d", k)
+	}
+	if st.Contains(7) {
+		t.Fatalf("unexpected key 7")
+	}
+	if st.String() != "{1: one, 5: FIVE, 9: nine}" {
+		t.Fatalf("unexpected string %s", st)
+	}
+}
+
+func TestSplayTreeDelete(t *testing.T) {
+	st := NewSplayTree[int, int]()
+	for _, k := range []int{5, 3, 8, 1, 4, 7, 9} {
+		st.Put(k, k)
+	}
+	if !st.Delete(5) || st.Delete(5) {
+		t.Fatalf("expected a single successful delete")
+	}
+	if !st.Delete(1) || !st.Delete(9) {
+		t.Fatalf("expected leaf deletions to succeed")
+	}
+	keys := st.Keys()
+	expected := []int{3, 4, 7, 8}
+	for i, k := range expected {
+		if keys[i] != k {
+			t.Fatalf("keys mismatch got %v want %v", keys, expected)
+		}
+	}
+	st.Clear()
+	if !st.IsEmpty() || len(st.Keys()) != 0 {
+		t.Fatalf("expected empty tree after clear")
+	}
+}
+
+func TestSplayTreeWithComparator(t *testing.T) {
+	st := NewSplayTreeWithComparator[string, int](func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	st.Put("Apple", 1)
+	st.Put("apple", 2)
+	if st.Size() != 1 {
+		t.Fatalf("comparator should treat keys case-insensitively")
+	}
+	if v, _ := st.Get("APPLE"); v != 2 {
+		t.Fatalf("expected 2 got %d", v)
+	}
+}
+
+func TestSplayTreeMatchesModel(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	st := NewSplayTree[int, int]()
+	model := make(map[int]int)
+	for i := 0; i < 5000; i++ {
+		k := r.Intn(300)
+		switch r.Intn(3) {
+		case 0:
+			st.Put(k, i)
+			model[k] = i
+		case 1:
+			_, inModel := model[k]
+			if st.Delete(k) != inModel {
+				t.Fatalf("delete mismatch for %d", k)
+			}
+			delete(model, k)
+		default:
+			v, ok := st.Get(k)
+			mv, mok := model[k]
+			if ok != mok || v != mv {
+				t.Fatalf("get mismatch for %d got %d/%v want %d/%v", k, v, ok, mv, mok)
+			}
+		}
+	}
+	if st.Size() != len(model) {
+		t.Fatalf("size mismatch got %d want %d", st.Size(), len(model))
+	}
+	keys := st.Keys()
+	if !sort.IntsAreSorted(keys) || len(keys) != len(model) {
+		t.Fatalf("keys not sorted or wrong length")
+	}
+}
+
+func TestSplayTreeEntries(t *testing.T) {
+	st := NewSplayTree[int, string]()
+	st.Put(2, "b")
+	st.Put(1, "a")
+	st.Get(2)
+	entries := st.Entries()
+	if len(entries) != 2 || entries[0].Key() != 1 || entries[1].Value() != "b" {
+		t.Fatalf("unexpected entries %v", entries)
+	}
+	if k, _, _ := st.Top(); k != 2 {
+		t.Fatalf("Entries should not splay, root is %d", k)
+	}
+}
@@ -0,0 +1,60 @@
+package tree
+
+import "unsafe"
+
+// Stats describes the shape of a tree and how much memory it holds on to
+type Stats struct {
+	// Size is the number of entries
+	Size int
+	// Nodes is the number of allocated nodes
+	Nodes int
+	// Height is the number of nodes on the longest path from the root, 0 for an empty tree
+	Height int
+	// Bytes approximates the memory retained by the tree itself; memory that keys and
+	// values point to is not counted
+	Bytes int
+}
+
+// Stats reports the size, height and approximate footprint of the splay tree
+func (t *SplayTree[K, V]) Stats() Stats {
+	var n splayNode[K, V]
+	return binaryStats(int(unsafe.Sizeof(*t)), int(unsafe.Sizeof(n)), t.shape(nil))
+}
+
+// Stats reports the size, height and approximate footprint of the treap
+func (t *Treap[K, V]) Stats() Stats {
+	var n treapNode[K, V]
+	return binaryStats(int(unsafe.Sizeof(*t)), int(unsafe.Sizeof(n)), t.shape(nil))
+}
+
+// Stats reports the size, height and approximate footprint of the interval tree
+func (t *IntervalTree[K, V]) Stats() Stats {
+	var n intervalNode[K, V]
+	return binaryStats(int(unsafe.Sizeof(*t)), int(unsafe.Sizeof(n)), t.shape(nil))
+}
+
+// Stats reports the size, height and approximate footprint of the k-d tree, including the
+// coordinates of every point
+func (t *KDTree[T]) Stats() Stats {
+	var n kdNode[T]
+	var coord float64
+	nodeBytes := int(unsafe.Sizeof(n)) + t.dims*int(unsafe.Sizeof(coord))
+	return binaryStats(int(unsafe.Sizeof(*t)), nodeBytes, t.shape(nil))
+}
+
+// binaryStats walks a tree whose nodes each hold one entry and take nodeBytes
+func binaryStats[N comparable](treeBytes, nodeBytes int, t binaryTree[N]) Stats {
+	var nilNode N
+	var walk func(n N, depth int) (nodes, height int)
+	walk = func(n N, depth int) (int, int) {
+		if n == nilNode {
+			return 0, depth
+		}
+		left, right := t.children(n)
+		ln, lh := walk(left, depth+1)
+		rn, rh := walk(right, depth+1)
+		return ln + rn + 1, max(lh, rh)
+	}
+	nodes, height := walk(t.root, 0)
+	return Stats{Size: nodes, Nodes: nodes, Height: height, Bytes: treeBytes + nodes*nodeBytes}
+}
@@ -0,0 +1,35 @@
+package tree
+
+import "testing"
+
+func TestTreeStats(t *testing.T) {
+	st := NewSplayTree[int, int]()
+	if stats := st.Stats(); stats.Size != 0 || stats.Height != 0 || stats.Bytes <= 0 {
+		t.Fatalf("unexpected empty stats %+v", stats)
+	}
+	// Ascending inserts into a splay tree leave a left spine
+	for i := 0; i < 5; i++ {
+		st.Put(i, i)
+	}
+	stats := st.Stats()
+	if stats.Size != 5 || stats.Nodes != 5 || stats.Height != 5 {
+		t.Fatalf("expected 5 nodes of height 5 got %+v", stats)
+	}
+
+	it := NewIntervalTree[int, string]()
+	for i := 0; i < 7; i++ {
+		it.Insert(i, i+1, "")
+	}
+	if stats := it.Stats(); stats.Size != 7 || stats.Height != 3 {
+		t.Fatalf("expected a balanced tree of height 3 got %+v", stats)
+	}
+
+	kd := NewKDTree[int](3)
+	kd.Insert([]float64{1, 2, 3}, 0)
+	small := kd.Stats()
+	kd4 := NewKDTree[int](4)
+	kd4.Insert([]float64{1, 2, 3, 4}, 0)
+	if kd4.Stats().Bytes <= small.Bytes {
+		t.Fatalf("expected coordinates to count towards the footprint")
+	}
+}
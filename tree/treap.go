@@ -0,0 +1,387 @@
+package tree
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/profoundwu/containers/arena"
+	"github.com/profoundwu/containers/compare"
+	"github.com/profoundwu/containers/pair"
+)
+
+var (
+	ErrOverlappingKeys = errors.New("merged treap keys must all be greater than the receiver's keys")
+)
+
+type treapNode[K any, V any] struct {
+	key      K
+	value    V
+	priority uint32
+	size     int
+	left     *treapNode[K, V]
+	right    *treapNode[K, V]
+}
+
+// Treap is a binary search tree balanced by random heap priorities, giving expected
+// O(log n) operations, order statistics through Select and Rank, and cheap Split and Merge of
+// whole key ranges
+type Treap[K any, V any] struct {
+	root *treapNode[K, V]
+	cmp  compare.Comparator[K]
+	// alloc hands out nodes when they come from an allocator
+	alloc func() *treapNode[K, V]
+}
+
+// NewTreap creates a new empty treap ordered by the natural order of K
+func NewTreap[K cmp.Ordered, V any]() *Treap[K, V] {
+	return NewTreapWithComparator[K, V](compare.Natural[K]())
+}
+
+// NewTreapWithComparator creates a new empty treap ordered by comparator
+func NewTreapWithComparator[K any, V any](comparator compare.Comparator[K]) *Treap[K, V] {
+	return &Treap[K, V]{cmp: comparator}
+}
+
+// NewTreapWithAllocator creates a new empty treap ordered by comparator that allocates its nodes
+// from a, which other containers may share
+// Deleted nodes are not reclaimed individually; they are released when the allocator is, such as
+// by resetting an arena.Arena once every container using it is discarded
+func NewTreapWithAllocator[K any, V any](comparator compare.Comparator[K], a arena.Allocator) *Treap[K, V] {
+	return &Treap[K, V]{cmp: comparator, alloc: arena.Allocate[treapNode[K, V]](a)}
+}
+
+// Size returns the number of entries in the treap
+func (t *Treap[K, V]) Size() int {
+	return treapSize(t.root)
+}
+
+// IsEmpty checks if the treap is empty
+func (t *Treap[K, V]) IsEmpty() bool {
+	return t.root == nil
+}
+
+// Put stores value under key, replacing the value of an existing key
+func (t *Treap[K, V]) Put(key K, value V) {
+	if n := t.find(key); n != nil {
+		n.value = value
+		return
+	}
+	less, rest := t.split(t.root, key)
+	n := t.newNode()
+	*n = treapNode[K, V]{key: key, value: value, priority: rand.Uint32(), size: 1}
+	t.root = mergeTreap(mergeTreap(less, n), rest)
+}
+
+// Get returns the value stored for key
+// Returns false if the key is not present
+func (t *Treap[K, V]) Get(key K) (V, bool) {
+	if n := t.find(key); n != nil {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Contains checks if the treap holds key
+func (t *Treap[K, V]) Contains(key K) bool {
+	return t.find(key) != nil
+}
+
+// Select returns the entry with the k-th smallest key, counting from 0, in expected O(log n)
+// Returns error if k is out of bounds
+func (t *Treap[K, V]) Select(k int) (K, V, error) {
+	if k < 0 || k >= t.Size() {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, fmt.Errorf("%w: %d, treap size: %d", ErrIndexOutOfBounds, k, t.Size())
+	}
+	n := t.root
+	for {
+		left := treapSize(n.left)
+		switch {
+		case k < left:
+			n = n.left
+		case k > left:
+			k -= left + 1
+			n = n.right
+		default:
+			return n.key, n.value, nil
+		}
+	}
+}
+
+// Rank returns the number of keys less than key in expected O(log n), which is the index Select
+// finds key at when it is present
+func (t *Treap[K, V]) Rank(key K) int {
+	rank := 0
+	for n := t.root; n != nil; {
+		switch c := t.cmp(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			rank += treapSize(n.left) + 1
+			n = n.right
+		default:
+			return rank + treapSize(n.left)
+		}
+	}
+	return rank
+}
+
+// Min returns the entry with the smallest key in expected O(log n)
+// Returns false if the treap is empty
+func (t *Treap[K, V]) Min() (K, V, bool) {
+	if t.root == nil {
+		return entryOf[K, V](nil)
+	}
+	return entryOf(treapMin(t.root))
+}
+
+// Max returns the entry with the largest key in expected O(log n)
+// Returns false if the treap is empty
+func (t *Treap[K, V]) Max() (K, V, bool) {
+	if t.root == nil {
+		return entryOf[K, V](nil)
+	}
+	return entryOf(treapMax(t.root))
+}
+
+// PopMin removes and returns the entry with the smallest key, so the treap can serve as a priority
+// queue keyed by K
+// Returns false if the treap is empty
+func (t *Treap[K, V]) PopMin() (K, V, bool) {
+	key, value, ok := t.Min()
+	if ok {
+		t.Delete(key)
+	}
+	return key, value, ok
+}
+
+// PopMax removes and returns the entry with the largest key
+// Returns false if the treap is empty
+func (t *Treap[K, V]) PopMax() (K, V, bool) {
+	key, value, ok := t.Max()
+	if ok {
+		t.Delete(key)
+	}
+	return key, value, ok
+}
+
+// Delete removes key from the treap
+// Returns true if the key was found and removed, false otherwise
+func (t *Treap[K, V]) Delete(key K) bool {
+	var removed bool
+	t.root = t.delete(t.root, key, &removed)
+	return removed
+}
+
+// DeleteRange removes every entry with a key in the half-open range [from, to) in expected
+// O(log n), by splitting the range out and merging what is left
+// Returns the number of entries removed
+func (t *Treap[K, V]) DeleteRange(from, to K) int {
+	less, rest := t.split(t.root, from)
+	removed, greater := t.split(rest, to)
+	t.root = mergeTreap(less, greater)
+	return treapSize(removed)
+}
+
+// Split moves every entry with a key less than key into a new treap and every other entry
+// into a second one, leaving the receiver empty
+func (t *Treap[K, V]) Split(key K) (*Treap[K, V], *Treap[K, V]) {
+	less, rest := t.split(t.root, key)
+	t.root = nil
+	return &Treap[K, V]{root: less, cmp: t.cmp, alloc: t.alloc}, &Treap[K, V]{root: rest, cmp: t.cmp, alloc: t.alloc}
+}
+
+// Merge moves every entry of other into the receiver, leaving other empty
+// Returns error if other holds a key that is not greater than every key of the receiver
+func (t *Treap[K, V]) Merge(other *Treap[K, V]) error {
+	if t == other || other.root == nil {
+		return nil
+	}
+	if t.root != nil {
+		last, first := treapMax(t.root), treapMin(other.root)
+		if t.cmp(last.key, first.key) >= 0 {
+			return fmt.Errorf("%w: %v >= %v", ErrOverlappingKeys, last.key, first.key)
+		}
+	}
+	t.root = mergeTreap(t.root, other.root)
+	other.root = nil
+	return nil
+}
+
+// Clear removes all entries from the treap
+func (t *Treap[K, V]) Clear() {
+	t.root = nil
+}
+
+// Keys returns all keys in ascending order
+func (t *Treap[K, V]) Keys() []K {
+	keys := make([]K, 0, t.Size())
+	var walk func(n *treapNode[K, V])
+	walk = func(n *treapNode[K, V]) {
+		if n == nil {
+			return
+		}
+		walk(n.left)
+		keys = append(keys, n.key)
+		walk(n.right)
+	}
+	walk(t.root)
+	return keys
+}
+
+// Entries returns all key-value pairs in ascending key order
+func (t *Treap[K, V]) Entries() []pair.Pair[K, V] {
+	entries := make([]pair.Pair[K, V], 0, t.Size())
+	var walk func(n *treapNode[K, V])
+	walk = func(n *treapNode[K, V]) {
+		if n == nil {
+			return
+		}
+		walk(n.left)
+		entries = append(entries, pair.New(n.key, n.value))
+		walk(n.right)
+	}
+	walk(t.root)
+	return entries
+}
+
+// String returns a string representation of the treap in ascending key order
+func (t *Treap[K, V]) String() string {
+	var sb strings.Builder
+	sb.WriteString("{")
+
+	var walk func(n *treapNode[K, V])
+	first := true
+	walk = func(n *treapNode[K, V]) {
+		if n == nil {
+			return
+		}
+		walk(n.left)
+		if !first {
+			sb.WriteString(", ")
+		}
+		first = false
+		sb.WriteString(fmt.Sprintf("%v: %v", n.key, n.value))
+		walk(n.right)
+	}
+	walk(t.root)
+
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// newNode allocates a node from the allocator when one is configured
+func (t *Treap[K, V]) newNode() *treapNode[K, V] {
+	if t.alloc != nil {
+		return t.alloc()
+	}
+	return new(treapNode[K, V])
+}
+
+func (t *Treap[K, V]) find(key K) *treapNode[K, V] {
+	n := t.root
+	for n != nil {
+		switch c := t.cmp(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+// split divides the subtree at n into keys less than key and keys greater than or equal to it
+func (t *Treap[K, V]) split(n *treapNode[K, V], key K) (*treapNode[K, V], *treapNode[K, V]) {
+	if n == nil {
+		return nil, nil
+	}
+	if t.cmp(n.key, key) < 0 {
+		less, rest := t.split(n.right, key)
+		n.right = less
+		n.update()
+		return n, rest
+	}
+	less, rest := t.split(n.left, key)
+	n.left = rest
+	n.update()
+	return less, n
+}
+
+func (t *Treap[K, V]) delete(n *treapNode[K, V], key K, removed *bool) *treapNode[K, V] {
+	if n == nil {
+		return nil
+	}
+	switch c := t.cmp(key, n.key); {
+	case c < 0:
+		n.left = t.delete(n.left, key, removed)
+	case c > 0:
+		n.right = t.delete(n.right, key, removed)
+	default:
+		*removed = true
+		return mergeTreap(n.left, n.right)
+	}
+	n.update()
+	return n
+}
+
+// mergeTreap joins two subtrees where every key of left is less than every key of right
+func mergeTreap[K any, V any](left, right *treapNode[K, V]) *treapNode[K, V] {
+	if left == nil {
+		return right
+	}
+	if right == nil {
+		return left
+	}
+	if left.priority > right.priority {
+		left.right = mergeTreap(left.right, right)
+		left.update()
+		return left
+	}
+	right.left = mergeTreap(left, right.left)
+	right.update()
+	return right
+}
+
+// entryOf returns the key and value of n, or false if n is nil
+func entryOf[K any, V any](n *treapNode[K, V]) (K, V, bool) {
+	if n == nil {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+	return n.key, n.value, true
+}
+
+func treapSize[K any, V any](n *treapNode[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func treapMin[K any, V any](n *treapNode[K, V]) *treapNode[K, V] {
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+func treapMax[K any, V any](n *treapNode[K, V]) *treapNode[K, V] {
+	for n.right != nil {
+		n = n.right
+	}
+	return n
+}
+
+// update recomputes the subtree size of n from its children
+func (n *treapNode[K, V]) update() {
+	n.size = 1 + treapSize(n.left) + treapSize(n.right)
+}
@@ -0,0 +1,250 @@
+package tree
+
+import (
+	"errors"
+	"math/rand"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/profoundwu/containers/arena"
+	"github.com/profoundwu/containers/compare"
+	"github.com/profoundwu/containers/list"
+)
+
+func TestNewTreap(t *testing.T) {
+	tr := NewTreap[int, string]()
+	if tr.Size() != 0 || !tr.IsEmpty() {
+		t.Fatalf("expected empty treap")
+	}
+	if tr.String() != "{}" || tr.Delete(1) {
+		t.Fatalf("expected no entries")
+	}
+}
+
+func TestTreapPutGetDelete(t *testing.T) {
+	tr := NewTreap[int, string]()
+	tr.Put(3, "c")
+	tr.Put(1, "a")
+	tr.Put(2, "b")
+	tr.Put(3, "C")
+	if tr.Size() != 3 {
+		t.Fatalf("expected size 3 got %d", tr.Size())
+	}
+	if v, ok := tr.Get(3); !ok || v != "C" {
+		t.Fatalf("expected C got %s ok=%v", v, ok)
+	}
+	if tr.String() != "{1: a, 2: b, 3: C}" {
+		t.Fatalf("unexpected string %s", tr)
+	}
+	if !tr.Delete(2) || tr.Delete(2) || tr.Contains(2) {
+		t.Fatalf("expected a single successful delete")
+	}
+	tr.Clear()
+	if !tr.IsEmpty() {
+		t.Fatalf("expected empty treap after clear")
+	}
+}
+
+func TestTreapSplitMerge(t *testing.T) {
+	tr := NewTreap[int, int]()
+	for i := 0; i < 10; i++ {
+		tr.Put(i, i*i)
+	}
+	low, high := tr.Split(4)
+	if !tr.IsEmpty() {
+		t.Fatalf("split should leave the receiver empty")
+	}
+	if low.Size() != 4 || high.Size() != 6 {
+		t.Fatalf("expected sizes 4 and 6 got %d and %d", low.Size(), high.Size())
+	}
+	if low.Contains(4) || !high.Contains(4) {
+		t.Fatalf("split key should go to the upper treap")
+	}
+
+	if err := high.Merge(low); !errors.Is(err, ErrOverlappingKeys) {
+		t.Fatalf("expected ErrOverlappingKeys got %v", err)
+	}
+	if err := low.Merge(high); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if low.Size() != 10 || !high.IsEmpty() {
+		t.Fatalf("merge should move every entry got %d and %d", low.Size(), high.Size())
+	}
+	if v, _ := low.Get(9); v != 81 {
+		t.Fatalf("expected 81 got %d", v)
+	}
+}
+
+func TestTreapMatchesModel(t *testing.T) {
+	r := rand.New(rand.NewSource(5))
+	tr := NewTreap[int, int]()
+	model := make(map[int]int)
+	for i := 0; i < 5000; i++ {
+		k := r.Intn(300)
+		switch r.Intn(3) {
+		case 0:
+			tr.Put(k, i)
+			model[k] = i
+		case 1:
+			_, inModel := model[k]
+			if tr.Delete(k) != inModel {
+				t.Fatalf("delete mismatch for %d", k)
+			}
+			delete(model, k)
+		default:
+			v, ok := tr.Get(k)
+			mv, mok := model[k]
+			if ok != mok || v != mv {
+				t.Fatalf("get mismatch for %d", k)
+			}
+		}
+	}
+	if tr.Size() != len(model) {
+		t.Fatalf("size mismatch got %d want %d", tr.Size(), len(model))
+	}
+	keys := tr.Keys()
+	if !sort.IntsAreSorted(keys) || len(keys) != len(model) {
+		t.Fatalf("keys not sorted or wrong length")
+	}
+}
+
+func TestTreapEntries(t *testing.T) {
+	tr := NewTreap[string, int]()
+	tr.Put("b", 2)
+	tr.Put("a", 1)
+	entries := tr.Entries()
+	if len(entries) != 2 || entries[0].String() != "(a, 1)" || entries[1].String() != "(b, 2)" {
+		t.Fatalf("unexpected entries %v", entries)
+	}
+}
+
+func TestTreapSelectRank(t *testing.T) {
+	tr := NewTreap[int, int]()
+	if _, _, err := tr.Select(0); !errors.Is(err, ErrIndexOutOfBounds) {
+		t.Fatalf("expected ErrIndexOutOfBounds got %v", err)
+	}
+	keys := rand.Perm(200)
+	for _, k := range keys {
+		tr.Put(k*2, k)
+	}
+	for i := 0; i < 200; i++ {
+		k, v, err := tr.Select(i)
+		if err != nil || k != i*2 || v != i {
+			t.Fatalf("expected key %d at %d got %d %d %v", i*2, i, k, v, err)
+		}
+		if r := tr.Rank(i * 2); r != i {
+			t.Fatalf("expected rank %d for %d got %d", i, i*2, r)
+		}
+		// Absent keys rank after every smaller key
+		if r := tr.Rank(i*2 + 1); r != i+1 {
+			t.Fatalf("expected rank %d for %d got %d", i+1, i*2+1, r)
+		}
+	}
+	if r := tr.Rank(-5); r != 0 {
+		t.Fatalf("expected rank 0 got %d", r)
+	}
+	if _, _, err := tr.Select(200); !errors.Is(err, ErrIndexOutOfBounds) {
+		t.Fatalf("expected ErrIndexOutOfBounds got %v", err)
+	}
+
+	tr.Delete(0)
+	tr.Delete(100)
+	if k, _, _ := tr.Select(0); k != 2 {
+		t.Fatalf("expected 2 got %d", k)
+	}
+	if r := tr.Rank(102); r != 49 {
+		t.Fatalf("expected rank 49 got %d", r)
+	}
+}
+
+func TestTreapWithAllocator(t *testing.T) {
+	// One arena serves a treap and a linked list built together
+	a := arena.New(8)
+	tr := NewTreapWithAllocator[int, string](compare.Natural[int](), a)
+	ll := list.NewLinkedListWithAllocator[int](a)
+	for i := 0; i < 20; i++ {
+		tr.Put(i, "v")
+		ll.AddLast(i)
+	}
+	if a.Len() != 40 {
+		t.Fatalf("expected 40 arena values got %d", a.Len())
+	}
+	tr.Delete(3)
+	less, rest := tr.Split(10)
+	if less.Size() != 9 || rest.Size() != 10 {
+		t.Fatalf("expected sizes 9 and 10 got %d %d", less.Size(), rest.Size())
+	}
+	rest.Put(30, "w")
+	if err := rest.Validate(); err != nil || a.Len() != 41 {
+		t.Fatalf("expected split treaps to keep the allocator got %d %v", a.Len(), err)
+	}
+	if ll.Size() != 20 {
+		t.Fatalf("expected the list to keep 20 elements got %d", ll.Size())
+	}
+}
+
+func TestTreapExtremes(t *testing.T) {
+	tr := NewTreap[int, string]()
+	if _, _, ok := tr.Min(); ok {
+		t.Fatalf("expected no minimum in an empty treap")
+	}
+	if _, _, ok := tr.PopMax(); ok {
+		t.Fatalf("expected nothing to pop from an empty treap")
+	}
+	for _, k := range []int{5, 1, 4, 2, 3} {
+		tr.Put(k, strconv.Itoa(k))
+	}
+	if k, v, _ := tr.Min(); k != 1 || v != "1" {
+		t.Fatalf("expected minimum 1 got %d %s", k, v)
+	}
+	if k, _, _ := tr.Max(); k != 5 {
+		t.Fatalf("expected maximum 5 got %d", k)
+	}
+	if k, _, _ := tr.PopMin(); k != 1 {
+		t.Fatalf("expected to pop 1 got %d", k)
+	}
+	if k, _, _ := tr.PopMax(); k != 5 {
+		t.Fatalf("expected to pop 5 got %d", k)
+	}
+	if tr.Size() != 3 || tr.Contains(1) || tr.Contains(5) {
+		t.Fatalf("expected 3 entries left got %s", tr)
+	}
+	if err := tr.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestTreapDeleteRange(t *testing.T) {
+	tr := NewTreap[int, int]()
+	for i := 0; i < 100; i++ {
+		tr.Put(i, i*i)
+	}
+	if n := tr.DeleteRange(20, 50); n != 30 {
+		t.Fatalf("expected 30 removed got %d", n)
+	}
+	if tr.Size() != 70 || tr.Contains(20) || tr.Contains(49) || !tr.Contains(19) || !tr.Contains(50) {
+		t.Fatalf("expected keys 20 to 49 removed got size %d", tr.Size())
+	}
+	if r := tr.Rank(50); r != 20 {
+		t.Fatalf("expected 50 to rank 20 got %d", r)
+	}
+	if k, v, _ := tr.Select(20); k != 50 || v != 2500 {
+		t.Fatalf("expected entry 50 at rank 20 got %d %d", k, v)
+	}
+	keys := tr.Keys()
+	if !sort.IntsAreSorted(keys) {
+		t.Fatalf("expected keys in order got %v", keys)
+	}
+	if err := tr.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Empty, reversed and out of range bounds remove nothing
+	if tr.DeleteRange(20, 50) != 0 || tr.DeleteRange(60, 55) != 0 || tr.DeleteRange(200, 300) != 0 {
+		t.Fatalf("expected nothing removed")
+	}
+	if n := tr.DeleteRange(-10, 1000); n != 70 || !tr.IsEmpty() {
+		t.Fatalf("expected every entry removed got %d", n)
+	}
+}
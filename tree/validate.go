@@ -0,0 +1,148 @@
+package tree
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrInvariantViolated = errors.New("tree invariant violated")
+)
+
+// Validate checks the internal invariants of the treap: keys are in search tree order,
+// priorities form a max heap and every node's size counts its subtree
+// It is meant for tests and debugging; a treap only changed through its methods always passes
+// Returns error describing the first violated invariant
+func (t *Treap[K, V]) Validate() error {
+	visited := 0
+	var prev *treapNode[K, V]
+	var check func(n *treapNode[K, V]) (int, error)
+	check = func(n *treapNode[K, V]) (int, error) {
+		if n == nil {
+			return 0, nil
+		}
+		if visited++; visited > t.root.size {
+			return 0, fmt.Errorf("%w: more than %d entries reachable from the root", ErrInvariantViolated, t.root.size)
+		}
+		for _, child := range [2]*treapNode[K, V]{n.left, n.right} {
+			if child != nil && child.priority > n.priority {
+				return 0, fmt.Errorf("%w: key %v has a higher priority than its parent %v",
+					ErrInvariantViolated, child.key, n.key)
+			}
+		}
+		left, err := check(n.left)
+		if err != nil {
+			return 0, err
+		}
+		if prev != nil && t.cmp(prev.key, n.key) >= 0 {
+			return 0, fmt.Errorf("%w: key %v is not after %v", ErrInvariantViolated, n.key, prev.key)
+		}
+		prev = n
+		right, err := check(n.right)
+		if err != nil {
+			return 0, err
+		}
+		if n.size != left+right+1 {
+			return 0, fmt.Errorf("%w: key %v records size %d, subtree holds %d",
+				ErrInvariantViolated, n.key, n.size, left+right+1)
+		}
+		return n.size, nil
+	}
+	_, err := check(t.root)
+	return err
+}
+
+// Validate checks the internal invariants of the splay tree: keys are in search tree order
+// and the tree holds size entries
+// It is meant for tests and debugging; a tree only changed through its methods always passes
+// Returns error describing the first violated invariant
+func (t *SplayTree[K, V]) Validate() error {
+	count := 0
+	var prev *splayNode[K, V]
+	var check func(n *splayNode[K, V]) error
+	check = func(n *splayNode[K, V]) error {
+		if n == nil {
+			return nil
+		}
+		if count++; count > t.size {
+			return fmt.Errorf("%w: more than %d entries reachable from the root", ErrInvariantViolated, t.size)
+		}
+		if err := check(n.left); err != nil {
+			return err
+		}
+		if prev != nil && t.cmp(prev.key, n.key) >= 0 {
+			return fmt.Errorf("%w: key %v is not after %v", ErrInvariantViolated, n.key, prev.key)
+		}
+		prev = n
+		return check(n.right)
+	}
+	if err := check(t.root); err != nil {
+		return err
+	}
+	if count != t.size {
+		return fmt.Errorf("%w: %d entries reachable from the root, size %d", ErrInvariantViolated, count, t.size)
+	}
+	return nil
+}
+
+// Validate checks the internal invariants of the interval tree: intervals are well formed and in
+// search tree order, every node's height and subtree max are current, the tree is AVL balanced
+// and it holds size intervals
+// It is meant for tests and debugging; a tree only changed through its methods always passes
+// Returns error describing the first violated invariant
+func (t *IntervalTree[K, V]) Validate() error {
+	count := 0
+	var prev *intervalNode[K, V]
+	var check func(n *intervalNode[K, V]) error
+	check = func(n *intervalNode[K, V]) error {
+		if n == nil {
+			return nil
+		}
+		if count++; count > t.size {
+			return fmt.Errorf("%w: more than %d intervals reachable from the root", ErrInvariantViolated, t.size)
+		}
+		iv := n.interval
+		if err := check(n.left); err != nil {
+			return err
+		}
+		if iv.Low > iv.High {
+			return fmt.Errorf("%w: interval [%v, %v] is inverted", ErrInvariantViolated, iv.Low, iv.High)
+		}
+		if prev != nil && compareInterval(prev.interval.Low, prev.interval.High, iv) >= 0 {
+			return fmt.Errorf("%w: interval [%v, %v] is not after [%v, %v]",
+				ErrInvariantViolated, iv.Low, iv.High, prev.interval.Low, prev.interval.High)
+		}
+		prev = n
+		if err := check(n.right); err != nil {
+			return err
+		}
+
+		hl, hr := intervalHeight(n.left), intervalHeight(n.right)
+		if n.height != 1+max(hl, hr) {
+			return fmt.Errorf("%w: interval [%v, %v] records height %d, subtree has %d",
+				ErrInvariantViolated, iv.Low, iv.High, n.height, 1+max(hl, hr))
+		}
+		if hl-hr > 1 || hr-hl > 1 {
+			return fmt.Errorf("%w: interval [%v, %v] has subtrees of heights %d and %d",
+				ErrInvariantViolated, iv.Low, iv.High, hl, hr)
+		}
+		want := iv.High
+		for _, child := range [2]*intervalNode[K, V]{n.left, n.right} {
+			if child != nil && child.max > want {
+				want = child.max
+			}
+		}
+		if n.max != want {
+			return fmt.Errorf("%w: interval [%v, %v] records max %v, subtree has %v",
+				ErrInvariantViolated, iv.Low, iv.High, n.max, want)
+		}
+		return nil
+	}
+	if err := check(t.root); err != nil {
+		return err
+	}
+	if count != t.size {
+		return fmt.Errorf("%w: %d intervals reachable from the root, size %d", ErrInvariantViolated, count, t.size)
+	}
+	return nil
+}
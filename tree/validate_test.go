@@ -0,0 +1,41 @@
+package tree
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestTreeValidate(t *testing.T) {
+	r := rand.New(rand.NewSource(9))
+	treap := NewTreap[int, int]()
+	splay := NewSplayTree[int, int]()
+	intervals := NewIntervalTree[int, int]()
+	for step := 0; step < 1000; step++ {
+		k := r.Intn(100)
+		if r.Intn(3) == 0 {
+			treap.Delete(k)
+			splay.Delete(k)
+			intervals.Delete(k, k+r.Intn(5))
+		} else {
+			treap.Put(k, step)
+			splay.Put(k, step)
+			intervals.Insert(k, k+r.Intn(5), step)
+		}
+		splay.Get(r.Intn(100))
+		for _, v := range []interface{ Validate() error }{treap, splay, intervals} {
+			if err := v.Validate(); err != nil {
+				t.Fatalf("%T step %d: %v", v, step, err)
+			}
+		}
+	}
+
+	treap.root.size++
+	splay.size--
+	intervals.root.max = -1
+	for _, v := range []interface{ Validate() error }{treap, splay, intervals} {
+		if err := v.Validate(); !errors.Is(err, ErrInvariantViolated) {
+			t.Fatalf("%T: expected ErrInvariantViolated got %v", v, err)
+		}
+	}
+}
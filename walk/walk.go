@@ -0,0 +1,129 @@
+// Package walk visits every value inside arbitrarily nested containers, such as lists of maps of
+// slices, reporting the path to each one
+package walk
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+var (
+	// SkipChildren can be returned by a Visitor to stop descending into the current value
+	SkipChildren = errors.New("skip children")
+)
+
+// Visitor is called for every value reached by Walk, parents before their children
+// path describes how the value was reached from the root, e.g. `$.Orders[2]["sku"]`
+// Returning SkipChildren prunes the value's children, any other error stops the walk
+type Visitor func(path string, value any) error
+
+// Walk calls visit for root and everything nested inside it
+// Slices, arrays, maps, structs, pointers and interfaces are descended into, as is any value with a
+// ToSlice method returning a slice, which covers the list containers of this module
+// Map entries are visited in the order of their formatted keys, unexported struct fields are skipped
+// and pointers already on the current path are not followed again
+// Returns the first error returned by visit other than SkipChildren
+func Walk(root any, visit Visitor) error {
+	w := &walker{visit: visit, active: make(map[uintptr]bool)}
+	return w.walk("$", reflect.ValueOf(root))
+}
+
+type walker struct {
+	visit  Visitor
+	active map[uintptr]bool
+}
+
+func (w *walker) walk(path string, v reflect.Value) error {
+	var value any
+	if v.IsValid() && v.CanInterface() {
+		value = v.Interface()
+	}
+	if err := w.visit(path, value); err != nil {
+		if errors.Is(err, SkipChildren) {
+			return nil
+		}
+		return err
+	}
+	if !v.IsValid() {
+		return nil
+	}
+	return w.descend(path, v)
+}
+
+// descend walks the children of v, looking through pointers and interfaces without visiting them twice
+func (w *walker) descend(path string, v reflect.Value) error {
+	// Mark pointers before calling ToSlice, so a container holding itself is not expanded again
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() || w.active[v.Pointer()] {
+			return nil
+		}
+		w.active[v.Pointer()] = true
+		defer delete(w.active, v.Pointer())
+	}
+	if items, ok := toSlice(v); ok {
+		return w.walkSequence(path, items)
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		return w.descend(path, v.Elem())
+	case reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return w.descend(path, v.Elem())
+	case reflect.Slice, reflect.Array:
+		return w.walkSequence(path, v)
+	case reflect.Map:
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j])
+		})
+		for _, k := range keys {
+			if err := w.walk(path+formatKey(k), v.MapIndex(k)); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			if !t.Field(i).IsExported() {
+				continue
+			}
+			if err := w.walk(path+"."+t.Field(i).Name, v.Field(i)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (w *walker) walkSequence(path string, v reflect.Value) error {
+	for i := 0; i < v.Len(); i++ {
+		if err := w.walk(fmt.Sprintf("%s[%d]", path, i), v.Index(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// toSlice calls a ToSlice method on v if it has one returning a slice
+func toSlice(v reflect.Value) (reflect.Value, bool) {
+	if v.Kind() == reflect.Pointer && v.IsNil() {
+		return reflect.Value{}, false
+	}
+	m := v.MethodByName("ToSlice")
+	if !m.IsValid() || m.Type().NumIn() != 0 || m.Type().NumOut() != 1 || m.Type().Out(0).Kind() != reflect.Slice {
+		return reflect.Value{}, false
+	}
+	return m.Call(nil)[0], true
+}
+
+func formatKey(k reflect.Value) string {
+	if k.Kind() == reflect.String {
+		return fmt.Sprintf("[%q]", k.String())
+	}
+	return fmt.Sprintf("[%v]", k)
+}
@@ -0,0 +1,115 @@
+package walk
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/profoundwu/containers/list"
+)
+
+type order struct {
+	ID    int
+	Items *list.ArrayList[string]
+	Tags  map[string]int
+	notes string
+}
+
+// leaves returns "path=value" for every int and string reached by Walk
+func leaves(t *testing.T, root any) string {
+	t.Helper()
+	var lines []string
+	err := Walk(root, func(path string, value any) error {
+		switch value.(type) {
+		case int, string:
+			lines = append(lines, fmt.Sprintf("%s=%v", path, value))
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return strings.Join(lines, " ")
+}
+
+func TestWalkNested(t *testing.T) {
+	items := list.NewArrayList[string]()
+	items.AddLast("apple")
+	items.AddLast("pear")
+	orders := []*order{{ID: 7, Items: items, Tags: map[string]int{"b": 2, "a": 1}, notes: "hidden"}}
+
+	got := leaves(t, map[string]any{"orders": orders})
+	want := `$["orders"][0].ID=7 $["orders"][0].Items[0]=apple $["orders"][0].Items[1]=pear ` +
+		`$["orders"][0].Tags["a"]=1 $["orders"][0].Tags["b"]=2`
+	if got != want {
+		t.Fatalf("unexpected walk\ngot  %s\nwant %s", got, want)
+	}
+}
+
+func TestWalkLinkedListAndScalars(t *testing.T) {
+	ll := list.NewLinkedList[int]()
+	ll.AddLast(1)
+	ll.AddLast(2)
+	if got := leaves(t, [][]any{{ll}, {"x", nil}}); got != "$[0][0][0]=1 $[0][0][1]=2 $[1][0]=x" {
+		t.Fatalf("unexpected walk %s", got)
+	}
+	if got := leaves(t, 5); got != "$=5" {
+		t.Fatalf("unexpected walk %s", got)
+	}
+}
+
+func TestWalkSkipAndStop(t *testing.T) {
+	data := map[string][]int{"keep": {1}, "skip": {2, 3}}
+	var paths []string
+	err := Walk(data, func(path string, value any) error {
+		paths = append(paths, path)
+		if path == `$["skip"]` {
+			return SkipChildren
+		}
+		return nil
+	})
+	if err != nil || strings.Join(paths, " ") != `$ $["keep"] $["keep"][0] $["skip"]` {
+		t.Fatalf("unexpected paths %v err=%v", paths, err)
+	}
+
+	stop := errors.New("stop")
+	visits := 0
+	err = Walk(data, func(path string, value any) error {
+		visits++
+		if visits == 2 {
+			return stop
+		}
+		return nil
+	})
+	if !errors.Is(err, stop) || visits != 2 {
+		t.Fatalf("expected the walk to stop after 2 visits got %d err=%v", visits, err)
+	}
+}
+
+type cyclic struct {
+	Name string
+	Next *cyclic
+}
+
+func TestWalkCycle(t *testing.T) {
+	a := &cyclic{Name: "a"}
+	a.Next = &cyclic{Name: "b", Next: a}
+	if got := leaves(t, a); got != "$.Name=a $.Next.Name=b" {
+		t.Fatalf("unexpected walk %s", got)
+	}
+}
+
+func TestWalkSelfContainingList(t *testing.T) {
+	l := list.NewArrayList[any]()
+	l.AddLast("x")
+	l.AddLast(l)
+	var paths []string
+	err := Walk(l, func(path string, value any) error {
+		paths = append(paths, path)
+		return nil
+	})
+	if err != nil || strings.Join(paths, " ") != "$ $[0] $[1]" {
+		t.Fatalf("expected the list to be expanded once got %v %v", paths, err)
+	}
+}